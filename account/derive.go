@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package account
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"strconv"
+	"strings"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+// SLIP-0010 parameters for ed25519, which only has hardened children
+const (
+	hardenedOffset = 0x80000000
+	masterHMACKey  = "ed25519 seed"
+)
+
+// Path - hardened derivation path, each element is the index
+// without the hardened offset
+type Path []uint32
+
+// ParsePath - convert path text: m/44'/0'/1' (h or H may be used in
+// place of ')
+func ParsePath(s string) (Path, error) {
+	elements := strings.Split(strings.TrimSpace(s), "/")
+	if "m" != elements[0] {
+		return nil, fault.InvalidDerivationPath
+	}
+
+	path := make(Path, 0, len(elements)-1)
+	for _, e := range elements[1:] {
+		n := len(e)
+		if n < 2 || !strings.ContainsAny(e[n-1:], "'hH") {
+			return nil, fault.InvalidDerivationPath
+		}
+		index, err := strconv.ParseUint(e[:n-1], 10, 32)
+		if err != nil || index >= hardenedOffset {
+			return nil, fault.InvalidDerivationPath
+		}
+		path = append(path, uint32(index))
+	}
+	return path, nil
+}
+
+// String - path text using ' for hardened
+func (path Path) String() string {
+	s := "m"
+	for _, index := range path {
+		s += "/" + strconv.FormatUint(uint64(index), 10) + "'"
+	}
+	return s
+}
+
+// Child - extend the path by one element
+func (path Path) Child(index uint32) Path {
+	child := make(Path, len(path), len(path)+1)
+	copy(child, path)
+	return append(child, index)
+}
+
+// DerivePrivateKeyFromBase58Seed - derive the private key at a path
+// below a seed
+//
+// the seed's own key pair is unchanged, the derivation starts from
+// the same ed25519 seed that produces it
+func DerivePrivateKeyFromBase58Seed(seedBase58Encoded string, path Path) (*PrivateKey, error) {
+
+	ed25519Seed, testnet, err := ed25519SeedFromBase58Seed(seedBase58Encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return privateKeyFromED25519Seed(deriveKey(ed25519Seed, path), testnet)
+}
+
+// SLIP-0010 hardened derivation, returns the child key
+func deriveKey(seed []byte, path Path) []byte {
+	mac := hmac.New(sha512.New, []byte(masterHMACKey))
+	mac.Write(seed)
+	digest := mac.Sum(nil)
+	key, chainCode := digest[:32], digest[32:]
+
+	data := make([]byte, 1+32+4)
+	for _, index := range path {
+		data[0] = 0x00
+		copy(data[1:33], key)
+		binary.BigEndian.PutUint32(data[33:], index+hardenedOffset)
+
+		mac := hmac.New(sha512.New, chainCode)
+		mac.Write(data)
+		digest := mac.Sum(nil)
+		key, chainCode = digest[:32], digest[32:]
+	}
+	return key
+}
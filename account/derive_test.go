@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package account_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+func TestParsePath(t *testing.T) {
+	items := []struct {
+		text   string
+		path   account.Path
+		canon  string
+		reject bool
+	}{
+		{text: "m", path: account.Path{}, canon: "m"},
+		{text: "m/0'", path: account.Path{0}, canon: "m/0'"},
+		{text: " m/44h/731H/7' ", path: account.Path{44, 731, 7}, canon: "m/44'/731'/7'"},
+		{text: "m/2147483647'", path: account.Path{2147483647}, canon: "m/2147483647'"},
+		{text: "", reject: true},
+		{text: "0'/1'", reject: true},
+		{text: "m/0", reject: true},
+		{text: "m/'", reject: true},
+		{text: "m/-1'", reject: true},
+		{text: "m/2147483648'", reject: true},
+		{text: "m/1'/", reject: true},
+	}
+
+	for _, item := range items {
+		path, err := account.ParsePath(item.text)
+		if item.reject {
+			if fault.InvalidDerivationPath != err {
+				t.Errorf("%q: actual error: %v, expected: %s", item.text, err, fault.InvalidDerivationPath)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: actual error: %s, expected no error", item.text, err)
+			continue
+		}
+		if !reflect.DeepEqual(item.path, path) {
+			t.Errorf("%q: actual path: %v, expected: %v", item.text, path, item.path)
+		}
+		if item.canon != path.String() {
+			t.Errorf("%q: actual text: %s, expected: %s", item.text, path, item.canon)
+		}
+	}
+}
+
+func TestPathChild(t *testing.T) {
+	parent := account.Path{44, 731}
+	a := parent.Child(1)
+	b := parent.Child(2)
+	if "m/44'/731'/1'" != a.String() || "m/44'/731'/2'" != b.String() {
+		t.Errorf("actual children: %s %s", a, b)
+	}
+}
+
+func TestDerivePrivateKeyFromBase58Seed(t *testing.T) {
+	items := []struct {
+		seed    string
+		path    string
+		account string
+	}{
+		{"9J877LVjhr3Xxd2nGzRVRVNUZpSKJF4TH", "m", "fcRDXowqbM4oS9vGn5FkBRyWyBeWAmRcQB3J1TboLqge76C1MA"},
+		{"9J877LVjhr3Xxd2nGzRVRVNUZpSKJF4TH", "m/0'", "fHFhuELbKkRLXeCt28mpofJpyEMRyMcvPuBzpxNW4wDX4qi4p3"},
+		{"9J877LVjhr3Xxd2nGzRVRVNUZpSKJF4TH", "m/44'/1'", "efQo9NDqNfAUC38vUkbJPrbNzR8XhUj1dYJ8zyFApvemUjnDWm"},
+		{"5XEECqhR7QBkJezUJiUJBmHaSmffDfVN5atuLnQBHnvfxbsWHuBfQLw", "m/0'", "a8GosZumTEXU2Ja1RCPLh1ovjrLRemDv574T6HxpjwR8u51rVn"},
+		{"5XEECqhR7QBkJezUJiUJBmHaSmffDfVN5atuLnQBHnvfxbsWHuBfQLw", "m/44'/1'", "aWLKeNshdWtrteSuG2ZGh1szdffcW4RYepNpMsKNzd2uykRw1b"},
+	}
+
+	for _, item := range items {
+		path, err := account.ParsePath(item.path)
+		if err != nil {
+			t.Fatalf("parse path error: %s", err)
+		}
+
+		privateKey, err := account.DerivePrivateKeyFromBase58Seed(item.seed, path)
+		if err != nil {
+			t.Errorf("%s: actual error: %s, expected no error", item.path, err)
+			continue
+		}
+		if item.account != privateKey.Account().String() {
+			t.Errorf("%s: actual account: %s, expected: %s", item.path, privateKey.Account(), item.account)
+		}
+	}
+
+	_, err := account.DerivePrivateKeyFromBase58Seed("9J877LVjhr3Xxd2nGzRVRVNUZpSKJF4TG", account.Path{0})
+	if fault.ChecksumMismatch != err {
+		t.Errorf("actual error: %v, expected: %s", err, fault.ChecksumMismatch)
+	}
+}
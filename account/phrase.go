@@ -6,7 +6,12 @@
 package account
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/util"
+	"golang.org/x/crypto/sha3"
 )
 
 const (
@@ -68,3 +73,63 @@ func Base58EncodedSeedToPhrase(encodedSeed string) ([]string, error) {
 
 	return phrase, nil
 }
+
+// PhraseToBase58EncodedSeed - convert recovery phrase to base58 seed
+func PhraseToBase58EncodedSeed(phrase []string) (string, error) {
+
+	var header []byte
+	var skLength int
+
+	switch len(phrase) {
+	case phraseV1Length:
+		header = seedHeaderV1
+		skLength = seedPrefixLength + secretKeyV1Length // includes network byte
+	case phraseV2Length:
+		header = seedHeaderV2
+		skLength = secretKeyV2Length
+	default:
+		return "", fault.InvalidRecoveryPhraseLength
+	}
+
+	sk := make([]byte, 0, skLength)
+	accumulator := 0
+	bits := 0
+
+	for _, word := range phrase {
+		word = strings.ToLower(word)
+		index := sort.SearchStrings(bip39, word)
+		if index >= len(bip39) || bip39[index] != word {
+			return "", fault.InvalidRecoveryPhraseWord
+		}
+		accumulator = accumulator<<11 + index
+		bits += 11
+		for bits >= 8 {
+			bits -= 8
+			sk = append(sk, byte(accumulator>>uint(bits)))
+			accumulator &= masks[bits]
+		}
+	}
+
+	// remaining bits are the high bits of the last byte
+	if bits > 0 {
+		sk = append(sk, byte(accumulator<<uint(8-bits)))
+	}
+
+	if skLength != len(sk) {
+		return "", fault.InvalidRecoveryPhraseLength
+	}
+
+	seed := append([]byte{}, header...)
+	seed = append(seed, sk...)
+	digest := sha3.Sum256(seed)
+	seed = append(seed, digest[:seedChecksumLength]...)
+
+	base58EncodedSeed := util.ToBase58(seed)
+
+	// ensure the network flags are valid
+	_, _, err := parseBase58Seed(base58EncodedSeed)
+	if err != nil {
+		return "", err
+	}
+	return base58EncodedSeed, nil
+}
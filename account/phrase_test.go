@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/fault"
 )
 
 type item struct {
@@ -50,3 +51,30 @@ func TestValidBase58EncodedSeedToPhrase(t *testing.T) {
 		}
 	}
 }
+
+func TestPhraseToBase58EncodedSeed(t *testing.T) {
+	for _, item := range validItems {
+		seed, err := account.PhraseToBase58EncodedSeed(strings.Split(item.phrase, " "))
+		if err != nil {
+			t.Errorf("actual error: %s, expected no error", err)
+		}
+		if item.base58Seed != seed {
+			t.Errorf("actual seed: %s, expected: %s", seed, item.base58Seed)
+		}
+	}
+}
+
+func TestInvalidPhraseToBase58EncodedSeed(t *testing.T) {
+	phrase := strings.Split(validItems[0].phrase, " ")
+
+	_, err := account.PhraseToBase58EncodedSeed(phrase[1:])
+	if fault.InvalidRecoveryPhraseLength != err {
+		t.Errorf("actual error: %v, expected: %s", err, fault.InvalidRecoveryPhraseLength)
+	}
+
+	phrase[3] = "bitmark"
+	_, err = account.PhraseToBase58EncodedSeed(phrase)
+	if fault.InvalidRecoveryPhraseWord != err {
+		t.Errorf("actual error: %v, expected: %s", err, fault.InvalidRecoveryPhraseWord)
+	}
+}
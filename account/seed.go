@@ -56,11 +56,24 @@ const (
 // interface type to allow individual methods to be called.
 func PrivateKeyFromBase58Seed(seedBase58Encoded string) (*PrivateKey, error) {
 
-	sk, testnet, err := parseBase58Seed(seedBase58Encoded)
+	ed25519Seed, testnet, err := ed25519SeedFromBase58Seed(seedBase58Encoded)
 	if err != nil {
 		return nil, err
 	}
 
+	return privateKeyFromED25519Seed(ed25519Seed, testnet)
+}
+
+// expand the secret key of a seed into the ed25519 seed of its key pair
+//
+// return the ed25519 seed, testnet and the error
+func ed25519SeedFromBase58Seed(seedBase58Encoded string) ([]byte, bool, error) {
+
+	sk, testnet, err := parseBase58Seed(seedBase58Encoded)
+	if err != nil {
+		return nil, false, err
+	}
+
 	skLength := len(sk)
 
 	var ed25519Seed []byte // ed25519 seed to generate key pair
@@ -78,27 +91,32 @@ func PrivateKeyFromBase58Seed(seedBase58Encoded string) (*PrivateKey, error) {
 		for i := 0; i < 4; i++ {
 			n, err := hash.Write(sk)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 			if secretKeyV2Length != n {
-				return nil, fault.CannotDecodeSeed
+				return nil, false, fault.CannotDecodeSeed
 			}
 		}
 
 		ed25519Seed = make([]byte, ed25519.SeedSize)
 		n, err := hash.Read(ed25519Seed)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		if ed25519.SeedSize != n {
-			return nil, fault.CannotDecodeSeed
+			return nil, false, fault.CannotDecodeSeed
 		}
 
 	default:
-		return nil, fault.InvalidSeedHeader
+		return nil, false, fault.InvalidSeedHeader
 	}
 
-	// generate key pair from encrypted secret key
+	return ed25519Seed, testnet, nil
+}
+
+// generate the key pair from an ed25519 seed
+func privateKeyFromED25519Seed(ed25519Seed []byte, testnet bool) (*PrivateKey, error) {
+
 	_, priv, err := ed25519.GenerateKey(bytes.NewBuffer(ed25519Seed))
 	if err != nil {
 		return nil, err
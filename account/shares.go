@@ -0,0 +1,562 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package account
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"sort"
+	"strings"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/util"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/sha3"
+)
+
+// SLIP-0039 parameters
+const (
+	shareRadixBits         = 10
+	shareMetadataWords     = 4 // identifier, extendable flag, iteration exponent, group and member parameters
+	shareChecksumWords     = 3
+	shareMinimumWords      = shareMetadataWords + (8*shareMinimumSecret+shareRadixBits-1)/shareRadixBits + shareChecksumWords
+	shareMinimumSecret     = 16
+	shareMaximumCount      = 16
+	shareDigestLength      = 4
+	shareDigestIndex       = 254
+	shareSecretIndex       = 255
+	shareBaseIterations    = 10000
+	shareRounds            = 4
+	shareIterationExponent = 1
+)
+
+var (
+	shareCustomisation           = []byte("shamir")
+	shareCustomisationExtendable = []byte("shamir_extendable")
+
+	// RS1024 checksum generator
+	shareGenerator = [10]uint32{
+		0x00e0e040, 0x01c1c080, 0x03838100, 0x07070200, 0x0e0e0009,
+		0x1c0c2412, 0x38086c24, 0x3090fc48, 0x21b1f890, 0x03f3f120,
+	}
+
+	// GF(256) with the Rijndael polynomial x^8 + x^4 + x^3 + x + 1
+	gfExp, gfLog = gfTables()
+)
+
+// one decoded mnemonic share
+type share struct {
+	identifier      int
+	extendable      bool
+	exponent        int
+	groupIndex      int
+	groupThreshold  int
+	groupCount      int
+	memberIndex     int
+	memberThreshold int
+	value           []byte
+}
+
+// a point on the sharing polynomials
+type sharePoint struct {
+	x byte
+	y []byte
+}
+
+// Base58EncodedSeedToShares - split a base58 seed into count SLIP-0039
+// mnemonic shares, any threshold of which recover the seed
+//
+// the shared secret is the seed without its checksum so both seed
+// versions are supported, the passphrase may be empty
+func Base58EncodedSeedToShares(encodedSeed string, threshold int, count int, passphrase string) ([][]string, error) {
+
+	_, _, err := parseBase58Seed(encodedSeed)
+	if err != nil {
+		return nil, err
+	}
+
+	seed := util.FromBase58(encodedSeed)
+	return SplitMasterSecret(seed[:len(seed)-seedChecksumLength], threshold, count, passphrase)
+}
+
+// SharesToBase58EncodedSeed - recover a base58 seed from its mnemonic
+// shares
+func SharesToBase58EncodedSeed(shares [][]string, passphrase string) (string, error) {
+
+	secret, err := CombineShares(shares, passphrase)
+	if err != nil {
+		return "", err
+	}
+
+	// a wrong passphrase still decrypts, but not to a seed
+	if !bytes.HasPrefix(secret, seedHeaderV1) && !bytes.HasPrefix(secret, seedHeaderV2) {
+		return "", fault.IncorrectPassphrase
+	}
+
+	digest := sha3.Sum256(secret)
+	seed := append(secret, digest[:seedChecksumLength]...)
+	base58EncodedSeed := util.ToBase58(seed)
+
+	_, _, err = parseBase58Seed(base58EncodedSeed)
+	if err != nil {
+		return "", fault.IncorrectPassphrase
+	}
+	return base58EncodedSeed, nil
+}
+
+// SplitMasterSecret - split a secret into count SLIP-0039 mnemonic
+// shares of a single group, any threshold of which recover it
+func SplitMasterSecret(secret []byte, threshold int, count int, passphrase string) ([][]string, error) {
+
+	if len(secret) < shareMinimumSecret || 0 != len(secret)%2 {
+		return nil, fault.InvalidSecretKeyLength
+	}
+
+	// one of one is the only sharing with a threshold of one
+	if threshold < 1 || threshold > count || count > shareMaximumCount || (1 == threshold && count > 1) {
+		return nil, fault.InvalidShareThreshold
+	}
+
+	if !validPassphrase(passphrase) {
+		return nil, fault.InvalidPassphrase
+	}
+
+	id := make([]byte, 2)
+	_, err := rand.Read(id)
+	if err != nil {
+		return nil, err
+	}
+	identifier := (int(id[0])<<8 | int(id[1])) & 0x7fff
+
+	encrypted := shareCipher(secret, passphrase, shareIterationExponent, shareSalt(identifier, true), false)
+
+	points, err := splitSecret(threshold, count, encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonics := make([][]string, len(points))
+	for i, p := range points {
+		s := share{
+			identifier:      identifier,
+			extendable:      true,
+			exponent:        shareIterationExponent,
+			groupIndex:      0,
+			groupThreshold:  1,
+			groupCount:      1,
+			memberIndex:     int(p.x),
+			memberThreshold: threshold,
+			value:           p.y,
+		}
+		mnemonics[i] = s.words()
+	}
+	return mnemonics, nil
+}
+
+// CombineShares - recover a secret from SLIP-0039 mnemonic shares
+//
+// any passphrase produces a secret, only the one used to split it
+// produces the original
+func CombineShares(mnemonics [][]string, passphrase string) ([]byte, error) {
+
+	if 0 == len(mnemonics) {
+		return nil, fault.InsufficientShares
+	}
+
+	if !validPassphrase(passphrase) {
+		return nil, fault.InvalidPassphrase
+	}
+
+	var first *share
+	groups := make(map[int][]*share)
+
+loop:
+	for _, mnemonic := range mnemonics {
+		s, err := decodeShare(mnemonic)
+		if err != nil {
+			return nil, err
+		}
+
+		if nil == first {
+			first = s
+		} else if s.identifier != first.identifier ||
+			s.extendable != first.extendable ||
+			s.exponent != first.exponent ||
+			s.groupThreshold != first.groupThreshold ||
+			s.groupCount != first.groupCount ||
+			len(s.value) != len(first.value) {
+			return nil, fault.InvalidShare
+		}
+
+		members := groups[s.groupIndex]
+		for _, m := range members {
+			if m.memberThreshold != s.memberThreshold {
+				return nil, fault.InvalidShare
+			}
+			if m.memberIndex == s.memberIndex {
+				if !bytes.Equal(m.value, s.value) {
+					return nil, fault.InvalidShare
+				}
+				continue loop
+			}
+		}
+		groups[s.groupIndex] = append(members, s)
+	}
+
+	indices := make([]int, 0, len(groups))
+	for index := range groups {
+		indices = append(indices, index)
+	}
+	sort.Ints(indices)
+
+	// recover each group that has enough members
+	groupPoints := make([]sharePoint, 0, first.groupThreshold)
+	for _, index := range indices {
+		members := groups[index]
+		threshold := members[0].memberThreshold
+		if len(members) < threshold {
+			continue
+		}
+
+		points := make([]sharePoint, threshold)
+		for i, m := range members[:threshold] {
+			points[i] = sharePoint{x: byte(m.memberIndex), y: m.value}
+		}
+		groupSecret, err := recoverSecret(threshold, points)
+		if err != nil {
+			return nil, err
+		}
+		groupPoints = append(groupPoints, sharePoint{x: byte(index), y: groupSecret})
+
+		if len(groupPoints) == first.groupThreshold {
+			break
+		}
+	}
+
+	if len(groupPoints) < first.groupThreshold {
+		return nil, fault.InsufficientShares
+	}
+
+	encrypted, err := recoverSecret(first.groupThreshold, groupPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := shareSalt(first.identifier, first.extendable)
+	return shareCipher(encrypted, passphrase, first.exponent, salt, true), nil
+}
+
+// only printable ASCII is allowed
+func validPassphrase(passphrase string) bool {
+	for _, c := range []byte(passphrase) {
+		if c < 32 || c > 126 {
+			return false
+		}
+	}
+	return true
+}
+
+// encode a share as mnemonic words
+func (s *share) words() []string {
+
+	extendable := 0
+	if s.extendable {
+		extendable = 1
+	}
+
+	// 15 bit identifier, extendable flag and 4 bits for each other value
+	metadata := uint64(s.identifier)<<25 |
+		uint64(extendable)<<24 |
+		uint64(s.exponent)<<20 |
+		uint64(s.groupIndex)<<16 |
+		uint64(s.groupThreshold-1)<<12 |
+		uint64(s.groupCount-1)<<8 |
+		uint64(s.memberIndex)<<4 |
+		uint64(s.memberThreshold-1)
+
+	valueWords := (8*len(s.value) + shareRadixBits - 1) / shareRadixBits
+	indices := make([]int, 0, shareMetadataWords+valueWords+shareChecksumWords)
+
+	for i := shareMetadataWords - 1; i >= 0; i-- {
+		indices = append(indices, int(metadata>>uint(shareRadixBits*i))&masks[shareRadixBits])
+	}
+
+	// the value is padded with leading zero bits
+	accumulator := 0
+	bits := valueWords*shareRadixBits - 8*len(s.value)
+	for _, b := range s.value {
+		accumulator = accumulator<<8 + int(b)
+		bits += 8
+		if bits >= shareRadixBits {
+			bits -= shareRadixBits
+			indices = append(indices, accumulator>>uint(bits))
+			accumulator &= masks[bits]
+		}
+	}
+
+	indices = append(indices, shareChecksum(indices, s.extendable)...)
+
+	words := make([]string, len(indices))
+	for i, index := range indices {
+		words[i] = slip39[index]
+	}
+	return words
+}
+
+// decode and verify the mnemonic words of a share
+func decodeShare(words []string) (*share, error) {
+
+	if len(words) < shareMinimumWords {
+		return nil, fault.InvalidRecoveryPhraseLength
+	}
+
+	valueWords := len(words) - shareMetadataWords - shareChecksumWords
+	padding := shareRadixBits * valueWords % 16
+	if padding > 8 {
+		return nil, fault.InvalidRecoveryPhraseLength
+	}
+
+	indices := make([]int, len(words))
+	for i, word := range words {
+		word = strings.ToLower(word)
+		index := sort.SearchStrings(slip39, word)
+		if index >= len(slip39) || slip39[index] != word {
+			return nil, fault.InvalidRecoveryPhraseWord
+		}
+		indices[i] = index
+	}
+
+	metadata := uint64(0)
+	for _, index := range indices[:shareMetadataWords] {
+		metadata = metadata<<shareRadixBits | uint64(index)
+	}
+
+	s := &share{
+		identifier:      int(metadata >> 25),
+		extendable:      1 == metadata>>24&1,
+		exponent:        int(metadata >> 20 & 15),
+		groupIndex:      int(metadata >> 16 & 15),
+		groupThreshold:  int(metadata>>12&15) + 1,
+		groupCount:      int(metadata>>8&15) + 1,
+		memberIndex:     int(metadata >> 4 & 15),
+		memberThreshold: int(metadata&15) + 1,
+	}
+
+	if 1 != sharePolymod(append(shareCustomisationValues(s.extendable), indices...)) {
+		return nil, fault.ChecksumMismatch
+	}
+
+	if s.groupThreshold > s.groupCount {
+		return nil, fault.InvalidShare
+	}
+
+	// padding bits must be zero
+	first := indices[shareMetadataWords]
+	if 0 != first>>uint(shareRadixBits-padding) {
+		return nil, fault.InvalidShare
+	}
+
+	value := make([]byte, 0, (valueWords*shareRadixBits-padding)/8)
+	accumulator := 0
+	bits := -padding
+	for _, index := range indices[shareMetadataWords : len(indices)-shareChecksumWords] {
+		accumulator = accumulator<<shareRadixBits + index
+		bits += shareRadixBits
+		for bits >= 8 {
+			bits -= 8
+			value = append(value, byte(accumulator>>uint(bits)))
+			accumulator &= masks[bits]
+		}
+	}
+	s.value = value
+
+	return s, nil
+}
+
+// RS1024 checksum words of the share data
+func shareChecksum(indices []int, extendable bool) []int {
+	values := append(shareCustomisationValues(extendable), indices...)
+	values = append(values, make([]int, shareChecksumWords)...)
+	polymod := sharePolymod(values) ^ 1
+
+	checksum := make([]int, shareChecksumWords)
+	for i := range checksum {
+		checksum[i] = int(polymod>>uint(shareRadixBits*(shareChecksumWords-1-i))) & masks[shareRadixBits]
+	}
+	return checksum
+}
+
+func sharePolymod(values []int) uint32 {
+	chk := uint32(1)
+	for _, v := range values {
+		b := chk >> 20
+		chk = (chk&0xfffff)<<shareRadixBits ^ uint32(v)
+		for i, g := range shareGenerator {
+			if 0 != (b>>uint(i))&1 {
+				chk ^= g
+			}
+		}
+	}
+	return chk
+}
+
+func shareCustomisationValues(extendable bool) []int {
+	customisation := shareCustomisation
+	if extendable {
+		customisation = shareCustomisationExtendable
+	}
+	values := make([]int, len(customisation))
+	for i, c := range customisation {
+		values[i] = int(c)
+	}
+	return values
+}
+
+// salt for the encryption, extendable shares do not depend on the
+// identifier
+func shareSalt(identifier int, extendable bool) []byte {
+	if extendable {
+		return nil
+	}
+	return append(append([]byte{}, shareCustomisation...), byte(identifier>>8), byte(identifier))
+}
+
+// four round Feistel network with PBKDF2-HMAC-SHA256 as the round
+// function, decryption runs the rounds in reverse
+func shareCipher(data []byte, passphrase string, exponent int, salt []byte, decrypt bool) []byte {
+
+	half := len(data) / 2
+	l := append([]byte{}, data[:half]...)
+	r := append([]byte{}, data[half:]...)
+	iterations := (shareBaseIterations << uint(exponent)) / shareRounds
+
+	for n := 0; n < shareRounds; n++ {
+		round := n
+		if decrypt {
+			round = shareRounds - 1 - n
+		}
+		password := append([]byte{byte(round)}, passphrase...)
+		f := pbkdf2.Key(password, append(append([]byte{}, salt...), r...), iterations, len(r), sha256.New)
+		for i := range l {
+			l[i] ^= f[i]
+		}
+		l, r = r, l
+	}
+	return append(r, l...)
+}
+
+// Shamir split with a digest share so that recovery can be verified
+func splitSecret(threshold int, count int, secret []byte) ([]sharePoint, error) {
+
+	points := make([]sharePoint, 0, count)
+
+	if 1 == threshold {
+		for i := 0; i < count; i++ {
+			points = append(points, sharePoint{x: byte(i), y: append([]byte{}, secret...)})
+		}
+		return points, nil
+	}
+
+	randomCount := threshold - 2
+	for i := 0; i < randomCount; i++ {
+		y := make([]byte, len(secret))
+		_, err := rand.Read(y)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, sharePoint{x: byte(i), y: y})
+	}
+
+	randomPart := make([]byte, len(secret)-shareDigestLength)
+	_, err := rand.Read(randomPart)
+	if err != nil {
+		return nil, err
+	}
+
+	base := make([]sharePoint, 0, threshold)
+	base = append(base, points...)
+	base = append(base,
+		sharePoint{x: shareDigestIndex, y: append(shareDigest(randomPart, secret), randomPart...)},
+		sharePoint{x: shareSecretIndex, y: secret},
+	)
+
+	for i := randomCount; i < count; i++ {
+		points = append(points, sharePoint{x: byte(i), y: interpolate(base, byte(i))})
+	}
+	return points, nil
+}
+
+// recover and verify the secret from threshold points
+func recoverSecret(threshold int, points []sharePoint) ([]byte, error) {
+
+	if 1 == threshold {
+		return points[0].y, nil
+	}
+
+	secret := interpolate(points, shareSecretIndex)
+	digestShare := interpolate(points, shareDigestIndex)
+
+	if !hmac.Equal(digestShare[:shareDigestLength], shareDigest(digestShare[shareDigestLength:], secret)) {
+		return nil, fault.ShareDigestMismatch
+	}
+	return secret, nil
+}
+
+func shareDigest(randomPart []byte, secret []byte) []byte {
+	mac := hmac.New(sha256.New, randomPart)
+	mac.Write(secret)
+	return mac.Sum(nil)[:shareDigestLength]
+}
+
+// Lagrange interpolation of the points at x, the x values must be
+// distinct
+func interpolate(points []sharePoint, x byte) []byte {
+
+	for _, p := range points {
+		if p.x == x {
+			return append([]byte{}, p.y...)
+		}
+	}
+
+	logProduct := 0
+	for _, p := range points {
+		logProduct += int(gfLog[p.x^x])
+	}
+
+	result := make([]byte, len(points[0].y))
+	for i, p := range points {
+		logBasis := logProduct - int(gfLog[p.x^x])
+		for j, q := range points {
+			if i != j {
+				logBasis -= int(gfLog[p.x^q.x])
+			}
+		}
+		logBasis = (logBasis%255 + 255) % 255
+
+		for k, v := range p.y {
+			if 0 != v {
+				result[k] ^= gfExp[(int(gfLog[v])+logBasis)%255]
+			}
+		}
+	}
+	return result
+}
+
+// exponent and logarithm tables for the generator x + 1
+func gfTables() (exp [255]byte, log [256]byte) {
+	poly := 1
+	for i := 0; i < 255; i++ {
+		exp[i] = byte(poly)
+		log[poly] = byte(i)
+		poly = poly<<1 ^ poly
+		if 0 != poly&0x100 {
+			poly ^= 0x11b
+		}
+	}
+	return exp, log
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package account_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+// from the SLIP-0039 test vectors, passphrase "TREZOR"
+func TestCombineSharesVectors(t *testing.T) {
+	items := []struct {
+		shares []string
+		secret string
+		err    error
+	}{
+		{
+			shares: []string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard"},
+			secret: "bb54aac4b89dc868ba37d9cc21b2cece",
+		},
+		{
+			shares: []string{"duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision kidney"},
+			err:    fault.ChecksumMismatch,
+		},
+		{
+			shares: []string{"theory painting academic academic armed sweater year military elder discuss acne wildlife boring employer fused large satoshi bundle carbon diagnose anatomy hamster leaves tracks paces beyond phantom capital marvel lips brave detect luck"},
+			secret: "989baf9dcaad5b10ca33dfd8cc75e42477025dce88ae83e75a230086a0e00e92",
+		},
+	}
+
+	for i, item := range items {
+		shares := make([][]string, len(item.shares))
+		for j, s := range item.shares {
+			shares[j] = strings.Split(s, " ")
+		}
+
+		secret, err := account.CombineShares(shares, "TREZOR")
+		if item.err != err {
+			t.Errorf("%d: actual error: %v, expected: %v", i, err, item.err)
+			continue
+		}
+		if nil == err && item.secret != hex.EncodeToString(secret) {
+			t.Errorf("%d: actual secret: %x, expected: %s", i, secret, item.secret)
+		}
+	}
+}
+
+func TestSplitMasterSecret(t *testing.T) {
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	shares, err := account.SplitMasterSecret(secret, 3, 5, "passphrase")
+	if err != nil {
+		t.Fatalf("actual error: %s, expected no error", err)
+	}
+	if 5 != len(shares) {
+		t.Fatalf("actual share count: %d, expected: 5", len(shares))
+	}
+
+	// every combination of three shares
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			for k := j + 1; k < 5; k++ {
+				actual, err := account.CombineShares([][]string{shares[k], shares[i], shares[j]}, "passphrase")
+				if err != nil {
+					t.Errorf("shares: %d, %d, %d: actual error: %s, expected no error", i, j, k, err)
+				} else if !bytes.Equal(secret, actual) {
+					t.Errorf("shares: %d, %d, %d: actual secret: %x, expected: %x", i, j, k, actual, secret)
+				}
+			}
+		}
+	}
+
+	_, err = account.CombineShares(shares[:2], "passphrase")
+	if fault.InsufficientShares != err {
+		t.Errorf("actual error: %v, expected: %s", err, fault.InsufficientShares)
+	}
+
+	_, err = account.CombineShares([][]string{shares[0], shares[0], shares[1]}, "passphrase")
+	if fault.InsufficientShares != err {
+		t.Errorf("duplicate share: actual error: %v, expected: %s", err, fault.InsufficientShares)
+	}
+
+	actual, err := account.CombineShares(shares[1:4], "wrong")
+	if err != nil {
+		t.Errorf("actual error: %s, expected no error", err)
+	} else if bytes.Equal(secret, actual) {
+		t.Errorf("wrong passphrase recovered the secret")
+	}
+
+	other, err := account.SplitMasterSecret(secret, 3, 5, "passphrase")
+	if err != nil {
+		t.Fatalf("actual error: %s, expected no error", err)
+	}
+	_, err = account.CombineShares([][]string{shares[0], shares[1], other[2]}, "passphrase")
+	if fault.InvalidShare != err {
+		t.Errorf("mixed shares: actual error: %v, expected: %s", err, fault.InvalidShare)
+	}
+}
+
+func TestInvalidSplitMasterSecret(t *testing.T) {
+	items := []struct {
+		secret     []byte
+		threshold  int
+		count      int
+		passphrase string
+		err        error
+	}{
+		{make([]byte, 14), 2, 3, "", fault.InvalidSecretKeyLength},
+		{make([]byte, 17), 2, 3, "", fault.InvalidSecretKeyLength},
+		{make([]byte, 16), 0, 3, "", fault.InvalidShareThreshold},
+		{make([]byte, 16), 4, 3, "", fault.InvalidShareThreshold},
+		{make([]byte, 16), 1, 3, "", fault.InvalidShareThreshold},
+		{make([]byte, 16), 2, 17, "", fault.InvalidShareThreshold},
+		{make([]byte, 16), 2, 3, "tab\t", fault.InvalidPassphrase},
+	}
+
+	for i, item := range items {
+		_, err := account.SplitMasterSecret(item.secret, item.threshold, item.count, item.passphrase)
+		if item.err != err {
+			t.Errorf("%d: actual error: %v, expected: %s", i, err, item.err)
+		}
+	}
+}
+
+func TestBase58EncodedSeedToShares(t *testing.T) {
+	for _, item := range validItems {
+		shares, err := account.Base58EncodedSeedToShares(item.base58Seed, 2, 3, "")
+		if err != nil {
+			t.Errorf("actual error: %s, expected no error", err)
+			continue
+		}
+
+		// 20 byte V2 and 36 byte V1 secrets
+		expectedWords := 23
+		if strings.HasPrefix(item.base58Seed, "5X") {
+			expectedWords = 36
+		}
+		if expectedWords != len(shares[0]) {
+			t.Errorf("actual share length: %d, expected: %d", len(shares[0]), expectedWords)
+		}
+
+		seed, err := account.SharesToBase58EncodedSeed([][]string{shares[2], shares[0]}, "")
+		if err != nil {
+			t.Errorf("actual error: %s, expected no error", err)
+		} else if item.base58Seed != seed {
+			t.Errorf("actual seed: %s, expected: %s", seed, item.base58Seed)
+		}
+
+		_, err = account.SharesToBase58EncodedSeed(shares[1:], "wrong")
+		if fault.IncorrectPassphrase != err {
+			t.Errorf("actual error: %v, expected: %s", err, fault.IncorrectPassphrase)
+		}
+	}
+
+	_, err := account.Base58EncodedSeedToShares("9J879ykQwWijwsrQbGop819AiLqk1Jf1a", 2, 3, "")
+	if nil == err {
+		t.Errorf("invalid seed split without error")
+	}
+}
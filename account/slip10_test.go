@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package account
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// SLIP-0010 ed25519 test vector 1, seed 000102..0f
+func TestDeriveKeyVector1(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+
+	items := []struct {
+		path Path
+		key  string
+	}{
+		{Path{}, "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7"},
+		{Path{0}, "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3"},
+		{Path{0, 1}, "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2"},
+		{Path{0, 1, 2}, "92a5b23c0b8a99e37d07df3fb9966917f5d06e02ddbd909c7e184371463e9fc9"},
+		{Path{0, 1, 2, 2}, "30d1dc7e5fc04c31219ab25a27ae00b50f6fd66622f6e9c913253d6511d1e662"},
+		{Path{0, 1, 2, 2, 1000000000}, "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793"},
+	}
+
+	for _, item := range items {
+		key := hex.EncodeToString(deriveKey(seed, item.path))
+		if item.key != key {
+			t.Errorf("%s: key: %s  expected: %s", item.path, key, item.key)
+		}
+	}
+}
@@ -25,7 +25,7 @@ func TestInitialise(t *testing.T) {
 
 	f := func(_ string) ([]string, error) { return []string{}, nil }
 
-	err := announce.Initialise("domain.not.exist", "cache", f)
+	err := announce.Initialise("domain.not.exist", "cache", false, f)
 
 	// make sure background jobs already finish first round, so
 	// no logger will be called
@@ -43,9 +43,9 @@ func TestInitialiseWhenSecondTime(t *testing.T) {
 
 	f := func(_ string) ([]string, error) { return []string{}, nil }
 
-	_ = announce.Initialise("domain.not.exist", "cache", f)
+	_ = announce.Initialise("domain.not.exist", "cache", false, f)
 
-	err := announce.Initialise("domain.not.exist", "cache", f)
+	err := announce.Initialise("domain.not.exist", "cache", false, f)
 
 	// make sure background jobs already finish first round, so
 	// no logger will be called
@@ -62,7 +62,7 @@ func TestFinalise(t *testing.T) {
 
 	f := func(_ string) ([]string, error) { return []string{}, nil }
 
-	err := announce.Initialise("domain.not.exist", "cache", f)
+	err := announce.Initialise("domain.not.exist", "cache", false, f)
 	assert.Nil(t, err, "wrong Initialise")
 
 	// make sure background jobs already finish first round, so
@@ -24,7 +24,7 @@ func TestSendRegistration(t *testing.T) {
 
 	f := func(_ string) ([]string, error) { return []string{}, nil }
 
-	_ = announce.Initialise("domain.not.exist", "cache", f)
+	_ = announce.Initialise("domain.not.exist", "cache", false, f)
 	defer announce.Finalise()
 
 	// make sure background jobs already finish first round, so
@@ -24,6 +24,7 @@ type broadcast struct {
 	rpcs               rpc.RPC
 	initialiseInterval time.Duration
 	pollingInterval    time.Duration
+	propagate          bool // false for a private network
 }
 
 // Run - background process interface
@@ -70,13 +71,15 @@ func (b *broadcast) process() {
 	binary.BigEndian.PutUint64(timestamp, uint64(time.Now().Unix()))
 
 	// announce this nodes IP and ports to other peers
-	if b.rpcs.IsInitialised() {
+	if !b.propagate {
+		log.Debug("announcements disabled")
+	} else if b.rpcs.IsInitialised() {
 		fin := b.rpcs.ID()
 		log.Debugf("send rpc: %x", fin)
 		messagebus.Bus.Broadcast.Send("rpc", fin[:], b.rpcs.Self(), timestamp)
 	}
 
-	if b.receptors.IsInitialised() {
+	if b.propagate && b.receptors.IsInitialised() {
 		log.Debugf("send peer: %x", b.receptors.ID())
 		messagebus.Bus.Broadcast.Send("peer", b.receptors.ID(), b.receptors.SelfListener(), timestamp)
 	}
@@ -91,12 +94,16 @@ func (b *broadcast) process() {
 }
 
 // New - return interface for background processing
-func New(log *logger.L, receptors receptor.Receptor, rpcs rpc.RPC, initialiseInterval, pollingInterval time.Duration) background.Process {
+//
+// propagate is false for a node of a private network, which must not
+// announce itself
+func New(log *logger.L, receptors receptor.Receptor, rpcs rpc.RPC, initialiseInterval, pollingInterval time.Duration, propagate bool) background.Process {
 	return &broadcast{
 		log:                log,
 		receptors:          receptors,
 		rpcs:               rpcs,
 		initialiseInterval: initialiseInterval,
 		pollingInterval:    pollingInterval,
+		propagate:          propagate,
 	}
 }
@@ -32,7 +32,7 @@ func TestRunWhenSendingShutdown(t *testing.T) {
 	defer fixtures.TeardownTestLogger()
 
 	log := logger.New(fixtures.LogCategory)
-	b := broadcast.New(log, receptor.New(log), rpc.New(), parameter.InitialiseInterval, parameter.PollingInterval)
+	b := broadcast.New(log, receptor.New(log), rpc.New(), parameter.InitialiseInterval, parameter.PollingInterval, true)
 
 	ch := make(chan messagebus.Message)
 	shutdown := make(chan struct{})
@@ -75,6 +75,7 @@ func TestRunWhenConnectedReceptorsChanged(t *testing.T) {
 		rpcs,
 		time.Millisecond,
 		time.Minute,
+		true,
 	)
 
 	shutdown := make(chan struct{})
@@ -133,6 +134,7 @@ func TestRunWhenRPCIsSet(t *testing.T) {
 		rpcs,
 		time.Millisecond,
 		time.Minute,
+		true,
 	)
 
 	bus := messagebus.Bus.Broadcast.Chan(-1)
@@ -195,6 +197,7 @@ func TestRunWhenReceptorIsSet(t *testing.T) {
 		rpcs,
 		time.Millisecond,
 		time.Minute,
+		true,
 	)
 
 	bus := messagebus.Bus.Broadcast.Chan(5)
@@ -215,3 +218,46 @@ func TestRunWhenReceptorIsSet(t *testing.T) {
 	shutdown <- struct{}{}
 	wg.Wait()
 }
+
+func TestRunWhenPrivate(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+	defer messagebus.Bus.Broadcast.Release()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	receptors := mocks.NewMockReceptor(ctl)
+	rpcs := mocks.NewMockRPC(ctl)
+
+	rpcs.EXPECT().Expire().Return().Times(1)
+
+	receptors.EXPECT().Expire().Return().Times(1)
+	receptors.EXPECT().Changed().Return(false).Times(1)
+
+	b := broadcast.New(
+		logger.New(fixtures.LogCategory),
+		receptors,
+		rpcs,
+		time.Millisecond,
+		time.Minute,
+		false,
+	)
+
+	bus := messagebus.Bus.Broadcast.Chan(5)
+	shutdown := make(chan struct{})
+	ch := make(chan messagebus.Message)
+	wg := new(sync.WaitGroup)
+	wg.Add(1)
+
+	go func(ch <-chan messagebus.Message, shutdown <-chan struct{}, wg *sync.WaitGroup) {
+		b.Run(ch, shutdown)
+		wg.Done()
+	}(ch, shutdown, wg)
+
+	time.Sleep(5 * time.Millisecond)
+	shutdown <- struct{}{}
+	wg.Wait()
+
+	assert.Equal(t, 0, len(bus), "private node announced")
+}
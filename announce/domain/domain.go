@@ -35,6 +35,13 @@ type domain struct {
 
 // Run - background processing interface
 func (d *domain) Run(_ interface{}, shutdown <-chan struct{}) {
+
+	// nothing to look up
+	if d.domainName == "" {
+		<-shutdown
+		return
+	}
+
 	timer := time.After(interval(d.domainName, d.log))
 
 loop:
@@ -145,6 +152,12 @@ func New(log *logger.L, domainName string, receptors receptor.Receptor, f func(s
 		lookuper:   NewLookuper(log, f),
 	}
 
+	// nodes are disabled or this is a private network
+	if domainName == "" {
+		log.Info("no node domain: lookup disabled")
+		return d, nil
+	}
+
 	txts, err := d.lookuper.Lookup(d.domainName)
 	if err != nil {
 		return nil, err
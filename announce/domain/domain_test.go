@@ -49,6 +49,33 @@ func TestNewDomainWhenLookupError(t *testing.T) {
 	assert.Equal(t, fmt.Errorf("error"), err, "wrong NewDomain")
 }
 
+func TestNewDomainWhenNone(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	r := mocks.NewMockReceptor(ctl)
+	f := func(s string) ([]string, error) {
+		t.Errorf("lookup of: %q", s)
+		return []string{}, nil
+	}
+
+	b, err := domain.New(logger.New(fixtures.LogCategory), "", r, f)
+	assert.Nil(t, err, "wrong NewDomain")
+
+	shutdown := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		b.Run(nil, shutdown)
+		close(done)
+	}()
+
+	close(shutdown)
+	<-done
+}
+
 func TestRunWhenShutdown(t *testing.T) {
 	fixtures.SetupTestLogger()
 	defer fixtures.TeardownTestLogger()
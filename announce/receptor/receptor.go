@@ -18,6 +18,8 @@ import (
 	"github.com/bitmark-inc/bitmarkd/avl"
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/messagebus"
+	"github.com/bitmark-inc/bitmarkd/peer/reputation"
+	"github.com/bitmark-inc/bitmarkd/util"
 	"github.com/bitmark-inc/logger"
 )
 
@@ -67,6 +69,11 @@ func (r *receptor) Add(publicKey []byte, listeners []byte, timestamp uint64) boo
 		return false
 	}
 
+	if isBanned(publicKey, listeners) {
+		r.log.Debugf("ignore banned: %x", publicKey)
+		return false
+	}
+
 	e := &Entity{
 		PublicKey: publicKey,
 		Listeners: listeners,
@@ -191,7 +198,7 @@ deduplicate:
 		node := r.connectable.Get(v)
 		if node != nil {
 			e := node.Value().(*Entity)
-			if e != nil {
+			if e != nil && !isBanned(e.PublicKey, e.Listeners) {
 				log.Infof("%s: connectable entity: %s", names[i], e)
 				messagebus.Bus.Connector.Send(names[i], e.PublicKey, e.Listeners)
 			}
@@ -338,3 +345,17 @@ func New(log *logger.L) Receptor {
 		log:         log,
 	}
 }
+
+// check a peer's public key and both of its listener addresses
+func isBanned(publicKey []byte, listeners []byte) bool {
+	if reputation.IsBanned(publicKey, "") {
+		return true
+	}
+	connV4, connV6 := util.PackedConnection(listeners).Unpack46()
+	for _, c := range []*util.Connection{connV4, connV6} {
+		if c != nil && reputation.IsBanned(nil, c.String()) {
+			return true
+		}
+	}
+	return false
+}
@@ -59,7 +59,8 @@ var globalData announcerData
 // Initialise - set up the announcement system
 // pass a fully qualified domain for root node list
 // or empty string for no root nodes
-func Initialise(domainName, cacheDirectory string, f func(string) ([]string, error)) error {
+// a private node neither looks up root nodes nor announces itself
+func Initialise(domainName, cacheDirectory string, private bool, f func(string) ([]string, error)) error {
 	globalData.Lock()
 	defer globalData.Unlock()
 
@@ -83,6 +84,11 @@ func Initialise(domainName, cacheDirectory string, f func(string) ([]string, err
 
 	globalData.rpcs = rpc.New()
 
+	if private {
+		globalData.log.Info("private network: announcements disabled")
+		domainName = ""
+	}
+
 	globalData.domain, err = domain.New(
 		globalData.log,
 		domainName,
@@ -99,6 +105,7 @@ func Initialise(domainName, cacheDirectory string, f func(string) ([]string, err
 		globalData.rpcs,
 		parameter.InitialiseInterval,
 		parameter.PollingInterval,
+		!private,
 	)
 
 	// all data initialised
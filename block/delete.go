@@ -383,8 +383,9 @@ outer_loop:
 		trx.Delete(storage.Pool.BlockOwnerPayment, blockNumberKey)
 		trx.Delete(storage.Pool.Blocks, blockNumberKey)
 
-		// and delete its hash
+		// and delete its hash and filter
 		trx.Delete(storage.Pool.BlockHeaderHash, blockNumberKey)
+		trx.Delete(storage.Pool.BlockFilters, blockNumberKey)
 
 		// fetch previous block number
 		binary.BigEndian.PutUint64(blockNumberKey, header.Number-1)
@@ -12,6 +12,7 @@ import (
 	"github.com/bitmark-inc/bitmarkd/account"
 	"github.com/bitmark-inc/bitmarkd/asset"
 	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockfilter"
 	"github.com/bitmark-inc/bitmarkd/blockheader"
 	"github.com/bitmark-inc/bitmarkd/blockrecord"
 	"github.com/bitmark-inc/bitmarkd/currency"
@@ -673,6 +674,18 @@ func StoreIncoming(packedBlock, packedNextBlock []byte, performRescan rescanType
 		[]byte{},
 	)
 
+	// compact filter for light clients
+	filterItems := make([][]byte, 0, 2*len(txs))
+	for _, item := range txs {
+		filterItems = append(filterItems, blockfilter.Items(item.unpacked)...)
+	}
+	trx.Put(
+		storage.Pool.BlockFilters,
+		thisBlockNumberKey,
+		blockfilter.New(blockfilter.KeyOf(digest), filterItems),
+		[]byte{},
+	)
+
 	globalData.log.Debugf("stored block: %d time elapsed: %f", header.Number, time.Since(start).Seconds())
 
 	err = trx.Commit()
@@ -17,22 +17,25 @@ import (
 	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 )
 
-type transactionItem struct {
+// Transaction - a decoded transaction of a block
+type Transaction struct {
 	Index int           `json:"index"`
 	TxId  merkle.Digest `json:"txId"`
 	Type  string        `json:"type"`
 	Data  interface{}   `json:"data"`
 }
 
-type blockResult struct {
+// Block - a block's digest, header and packed data, with its decoded
+// transactions if requested
+type Block struct {
 	Digest       *blockdigest.Digest `json:"digest,omitempty"`
 	Header       *blockrecord.Header `json:"header,omitempty"`
-	Transactions []transactionItem   `json:"transactions,omitempty"`
+	Transactions []Transaction       `json:"transactions,omitempty"`
 	Packed       []byte              `json:"binary,omitempty"`
 }
 
 // BlockDump dump of a particular block
-func BlockDump(number uint64, decodeTxs bool) (*blockResult, error) {
+func BlockDump(number uint64, decodeTxs bool) (*Block, error) {
 
 	// fetch block and compute digest
 	n := make([]byte, 8)
@@ -47,7 +50,7 @@ func BlockDump(number uint64, decodeTxs bool) (*blockResult, error) {
 }
 
 // BlockDump dump of a particular block
-func BlockDecode(packed []byte, number uint64, decodeTxs bool) (*blockResult, error) {
+func BlockDecode(packed []byte, number uint64, decodeTxs bool) (*Block, error) {
 
 	br := blockrecord.Get()
 
@@ -57,7 +60,7 @@ func BlockDecode(packed []byte, number uint64, decodeTxs bool) (*blockResult, er
 	}
 
 	if !decodeTxs {
-		result := &blockResult{
+		result := &Block{
 			Digest:       &digest,
 			Header:       header,
 			Transactions: nil,
@@ -67,7 +70,7 @@ func BlockDecode(packed []byte, number uint64, decodeTxs bool) (*blockResult, er
 		return result, nil
 	}
 
-	txs := make([]transactionItem, header.TransactionCount)
+	txs := make([]Transaction, header.TransactionCount)
 loop:
 	for i := 1; true; i += 1 {
 		transaction, n, err := transactionrecord.Packed(data).Unpack(mode.IsTesting())
@@ -75,7 +78,7 @@ loop:
 			return nil, err
 		}
 		name, _ := transactionrecord.RecordName(transaction)
-		txs[i-1] = transactionItem{
+		txs[i-1] = Transaction{
 			Index: i,
 			TxId:  merkle.NewDigest(data[:n]),
 			Type:  name,
@@ -87,7 +90,7 @@ loop:
 		}
 	}
 
-	result := &blockResult{
+	result := &Block{
 		Digest:       &digest,
 		Header:       header,
 		Transactions: txs,
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package blockfilter - compact block filters for light clients
+//
+// in the style of BIP158 each block has a Golomb-coded set of the
+// SipHash-2-4 values of its owner accounts, asset ids and link
+// digests, keyed by the first 16 bytes of the block digest, so a
+// client can test whether a block concerns it without the block
+//
+// packed filter is:
+//
+//	varint64 item count
+//	Golomb-Rice coded sorted differences, parameter P, false positive rate 1/M
+package blockfilter
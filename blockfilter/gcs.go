@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockfilter
+
+import (
+	"math/bits"
+	"sort"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/util"
+)
+
+// parameters as BIP158 basic filters
+const (
+	P = 19     // Golomb-Rice bits
+	M = 784931 // inverse false positive rate
+)
+
+// KeyOf - the filter key of a block: the first bytes of its digest
+func KeyOf(digest blockdigest.Digest) Key {
+	key := Key{}
+	copy(key[:], digest[:KeySize])
+	return key
+}
+
+// hash an item into the range [0, n*M)
+func hashToRange(key Key, item []byte, f uint64) uint64 {
+	hi, _ := bits.Mul64(sipHash(key, item), f)
+	return hi
+}
+
+// New - packed filter of a set of items, duplicates are ignored
+func New(key Key, items [][]byte) []byte {
+	unique := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		unique[string(item)] = struct{}{}
+	}
+
+	n := uint64(len(unique))
+	f := n * M
+
+	values := make([]uint64, 0, n)
+	for item := range unique {
+		values = append(values, hashToRange(key, []byte(item), f))
+	}
+	sort.Slice(values, func(i, j int) bool {
+		return values[i] < values[j]
+	})
+
+	w := bitWriter{}
+	previous := uint64(0)
+	for _, v := range values {
+		delta := v - previous
+		previous = v
+
+		for q := delta >> P; q > 0; q -= 1 {
+			w.writeBit(1)
+		}
+		w.writeBit(0)
+		w.writeBits(delta, P)
+	}
+
+	return append(util.ToVarint64(n), w.bytes...)
+}
+
+// Count - number of items in a packed filter
+func Count(filter []byte) (uint64, error) {
+	n, length := util.FromVarint64(filter)
+	if length == 0 {
+		return 0, fault.InvalidBlockFilter
+	}
+	return n, nil
+}
+
+// Match - true if the item is probably in the filter, false if it is
+// definitely not
+func Match(filter []byte, key Key, item []byte) (bool, error) {
+	return MatchAny(filter, key, [][]byte{item})
+}
+
+// MatchAny - true if any of the items is probably in the filter
+func MatchAny(filter []byte, key Key, items [][]byte) (bool, error) {
+	n, length := util.FromVarint64(filter)
+	if length == 0 {
+		return false, fault.InvalidBlockFilter
+	}
+	if n == 0 || len(items) == 0 {
+		return false, nil
+	}
+	f := n * M
+
+	targets := make([]uint64, len(items))
+	for i, item := range items {
+		targets[i] = hashToRange(key, item, f)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i] < targets[j]
+	})
+
+	r := bitReader{bytes: filter[length:]}
+	value := uint64(0)
+	t := 0
+	for i := uint64(0); i < n; i += 1 {
+		q := uint64(0)
+		for {
+			b, err := r.readBit()
+			if err != nil {
+				return false, err
+			}
+			if b == 0 {
+				break
+			}
+			q += 1
+		}
+		remainder, err := r.readBits(P)
+		if err != nil {
+			return false, err
+		}
+		value += q<<P | remainder
+
+		for targets[t] < value {
+			t += 1
+			if t == len(targets) {
+				return false, nil
+			}
+		}
+		if targets[t] == value {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// most significant bit first
+type bitWriter struct {
+	bytes []byte
+	used  uint // bits used in the last byte, 8 when full
+}
+
+func (w *bitWriter) writeBit(b uint64) {
+	if w.used == 0 || w.used == 8 {
+		w.bytes = append(w.bytes, 0)
+		w.used = 0
+	}
+	if b != 0 {
+		w.bytes[len(w.bytes)-1] |= 0x80 >> w.used
+	}
+	w.used += 1
+}
+
+func (w *bitWriter) writeBits(value uint64, count uint) {
+	for i := count; i > 0; i -= 1 {
+		w.writeBit((value >> (i - 1)) & 1)
+	}
+}
+
+type bitReader struct {
+	bytes []byte
+	index uint // next bit
+}
+
+func (r *bitReader) readBit() (uint64, error) {
+	i := r.index / 8
+	if i >= uint(len(r.bytes)) {
+		return 0, fault.InvalidBlockFilter
+	}
+	b := (r.bytes[i] >> (7 - r.index%8)) & 1
+	r.index += 1
+	return uint64(b), nil
+}
+
+func (r *bitReader) readBits(count uint) (uint64, error) {
+	value := uint64(0)
+	for i := uint(0); i < count; i += 1 {
+		b, err := r.readBit()
+		if err != nil {
+			return 0, err
+		}
+		value = value<<1 | b
+	}
+	return value, nil
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockfilter_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockfilter"
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+func testItems(prefix string, n int) [][]byte {
+	items := make([][]byte, n)
+	for i := range items {
+		items[i] = []byte(fmt.Sprintf("%s-%d", prefix, i))
+	}
+	return items
+}
+
+func TestMatch(t *testing.T) {
+	key := blockfilter.KeyOf(blockdigest.Digest{1, 2, 3, 4})
+	items := testItems("item", 200)
+
+	filter := blockfilter.New(key, append(items, items[0]))
+
+	n, err := blockfilter.Count(filter)
+	assert.Nil(t, err, "wrong count error")
+	assert.Equal(t, uint64(200), n, "duplicates not removed")
+
+	for _, item := range items {
+		ok, err := blockfilter.Match(filter, key, item)
+		assert.Nil(t, err, "wrong error")
+		assert.True(t, ok, "item not matched: %s", item)
+	}
+
+	falsePositives := 0
+	for _, item := range testItems("other", 1000) {
+		ok, err := blockfilter.Match(filter, key, item)
+		assert.Nil(t, err, "wrong error")
+		if ok {
+			falsePositives += 1
+		}
+	}
+	assert.LessOrEqual(t, falsePositives, 1, "too many false positives")
+
+	// a different block key does not match
+	other := blockfilter.KeyOf(blockdigest.Digest{5, 6, 7, 8})
+	ok, err := blockfilter.MatchAny(filter, other, items[:3])
+	assert.Nil(t, err, "wrong error")
+	assert.False(t, ok, "matched with wrong key")
+}
+
+func TestMatchAny(t *testing.T) {
+	key := blockfilter.KeyOf(blockdigest.Digest{9})
+	items := testItems("item", 20)
+
+	filter := blockfilter.New(key, items)
+
+	ok, err := blockfilter.MatchAny(filter, key, append(testItems("other", 10), items[7]))
+	assert.Nil(t, err, "wrong error")
+	assert.True(t, ok, "item not matched")
+
+	ok, err = blockfilter.MatchAny(filter, key, testItems("other", 10))
+	assert.Nil(t, err, "wrong error")
+	assert.False(t, ok, "wrong match")
+}
+
+func TestMatchWhenEmpty(t *testing.T) {
+	key := blockfilter.KeyOf(blockdigest.Digest{})
+
+	filter := blockfilter.New(key, nil)
+	assert.Equal(t, []byte{0}, filter, "wrong empty filter")
+
+	ok, err := blockfilter.Match(filter, key, []byte("item"))
+	assert.Nil(t, err, "wrong error")
+	assert.False(t, ok, "wrong match")
+}
+
+func TestMatchWhenInvalid(t *testing.T) {
+	key := blockfilter.KeyOf(blockdigest.Digest{})
+
+	_, err := blockfilter.Match([]byte{}, key, []byte("item"))
+	assert.Equal(t, fault.InvalidBlockFilter, err, "wrong error")
+
+	filter := blockfilter.New(key, testItems("item", 10))
+	_, err = blockfilter.MatchAny(filter[:3], key, testItems("other", 10))
+	assert.Equal(t, fault.InvalidBlockFilter, err, "wrong error")
+}
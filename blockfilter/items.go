@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockfilter
+
+import (
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// Items - the filter items of a transaction: owner accounts, asset
+// ids and link digests
+//
+// share ids are issue ids, so they match the links of a provenance
+func Items(transaction transactionrecord.Transaction) [][]byte {
+	items := make([][]byte, 0, 4)
+
+	addAccount := func(a *account.Account) {
+		if a != nil && a.AccountInterface != nil {
+			items = append(items, a.Bytes())
+		}
+	}
+
+	switch tx := transaction.(type) {
+
+	case *transactionrecord.OldBaseData:
+		addAccount(tx.Owner)
+
+	case *transactionrecord.AssetData:
+		assetId := tx.AssetId()
+		items = append(items, assetId[:])
+		addAccount(tx.Registrant)
+
+	case *transactionrecord.BitmarkIssue:
+		items = append(items, tx.AssetId[:])
+		addAccount(tx.Owner)
+
+	case transactionrecord.BitmarkTransfer:
+		link := tx.GetLink()
+		items = append(items, link[:])
+		addAccount(tx.GetOwner())
+
+	case *transactionrecord.BlockFoundation:
+		addAccount(tx.Owner)
+
+	case *transactionrecord.BlockOwnerTransfer:
+		items = append(items, tx.Link[:])
+		addAccount(tx.Owner)
+
+	case *transactionrecord.BitmarkShare:
+		items = append(items, tx.Link[:])
+
+	case *transactionrecord.ShareGrant:
+		items = append(items, tx.ShareId[:])
+		addAccount(tx.Owner)
+		addAccount(tx.Recipient)
+
+	case *transactionrecord.ShareSwap:
+		items = append(items, tx.ShareIdOne[:], tx.ShareIdTwo[:])
+		addAccount(tx.OwnerOne)
+		addAccount(tx.OwnerTwo)
+	}
+
+	return items
+}
+
+// FromBlock - the packed filter of a packed block
+func FromBlock(packedBlock []byte, testing bool) ([]byte, error) {
+	packedHeader, err := blockrecord.PackedHeaderOf(packedBlock)
+	if err != nil {
+		return nil, err
+	}
+	header, err := packedHeader.Unpack()
+	if err != nil {
+		return nil, err
+	}
+
+	data := packedBlock[len(packedHeader):]
+	items := make([][]byte, 0, 2*int(header.TransactionCount))
+	for i := uint16(0); i < header.TransactionCount; i += 1 {
+		transaction, n, err := transactionrecord.Packed(data).Unpack(testing)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, Items(transaction)...)
+		data = data[n:]
+	}
+
+	return New(KeyOf(packedHeader.Digest()), items), nil
+}
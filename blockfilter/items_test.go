@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockfilter_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/blockfilter"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+func testAccount(b byte) *account.Account {
+	publicKey := make([]byte, 32)
+	publicKey[0] = b
+	return &account.Account{
+		AccountInterface: &account.ED25519Account{
+			Test:      true,
+			PublicKey: publicKey,
+		},
+	}
+}
+
+func TestItems(t *testing.T) {
+	owner := testAccount(1)
+	recipient := testAccount(2)
+	assetId := transactionrecord.AssetIdentifier{3, 4}
+	link := merkle.Digest{5, 6}
+
+	items := blockfilter.Items(&transactionrecord.BitmarkIssue{
+		AssetId: assetId,
+		Owner:   owner,
+	})
+	assert.Equal(t, [][]byte{assetId[:], owner.Bytes()}, items, "wrong issue items")
+
+	items = blockfilter.Items(&transactionrecord.BitmarkTransferUnratified{
+		Link:  link,
+		Owner: recipient,
+	})
+	assert.Equal(t, [][]byte{link[:], recipient.Bytes()}, items, "wrong transfer items")
+
+	items = blockfilter.Items(&transactionrecord.ShareGrant{
+		ShareId:   link,
+		Owner:     owner,
+		Recipient: recipient,
+	})
+	assert.Equal(t, [][]byte{link[:], owner.Bytes(), recipient.Bytes()}, items, "wrong grant items")
+
+	items = blockfilter.Items(&transactionrecord.BitmarkShare{
+		Link: link,
+	})
+	assert.Equal(t, [][]byte{link[:]}, items, "wrong share items")
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// vectors from the SipHash paper, key 00..0f and message 00..(n-1)
+func TestSipHash(t *testing.T) {
+	key := Key{}
+	for i := range key {
+		key[i] = byte(i)
+	}
+
+	message := make([]byte, 15)
+	for i := range message {
+		message[i] = byte(i)
+	}
+
+	assert.Equal(t, uint64(0x726fdb47dd0e0e31), sipHash(key, []byte{}), "wrong hash of empty message")
+	assert.Equal(t, uint64(0xa129ca6149be45e5), sipHash(key, message), "wrong hash of 15 bytes")
+	assert.Equal(t, uint64(0x93f5f5799a932462), sipHash(key, message[:8]), "wrong hash of 8 bytes")
+}
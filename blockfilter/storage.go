@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockfilter
+
+import (
+	"encoding/binary"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/storage"
+)
+
+// Get - the packed filter and digest of a stored block
+//
+// blocks stored before filters were kept have their filter built from
+// the block
+func Get(filters storage.Handle, blocks storage.Handle, blockNumber uint64, testing bool) ([]byte, blockdigest.Digest, error) {
+	blockNumberKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(blockNumberKey, blockNumber)
+
+	packedBlock := blocks.Get(blockNumberKey)
+	if packedBlock == nil {
+		return nil, blockdigest.Digest{}, fault.BlockNotFound
+	}
+	packedHeader, err := blockrecord.PackedHeaderOf(packedBlock)
+	if err != nil {
+		return nil, blockdigest.Digest{}, err
+	}
+	digest := packedHeader.Digest()
+
+	if filter := filters.Get(blockNumberKey); filter != nil {
+		return filter, digest, nil
+	}
+
+	filter, err := FromBlock(packedBlock, testing)
+	if err != nil {
+		return nil, blockdigest.Digest{}, err
+	}
+	return filter, digest, nil
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockfilter_test
+
+import (
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/blockfilter"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/difficulty"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/mocks"
+)
+
+func TestGet(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	filters := mocks.NewMockHandle(ctl)
+	blocks := mocks.NewMockHandle(ctl)
+
+	header := blockrecord.Header{
+		Version:          3,
+		TransactionCount: 2,
+		Number:           12,
+		Timestamp:        uint64(time.Now().Unix()),
+		Difficulty:       difficulty.New(),
+	}
+	packedHeader := header.Pack()
+	digest := packedHeader.Digest()
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, 12)
+
+	stored := blockfilter.New(blockfilter.KeyOf(digest), [][]byte{[]byte("item")})
+
+	blocks.EXPECT().Get(key).Return(packedHeader[:]).Times(1)
+	filters.EXPECT().Get(key).Return(stored).Times(1)
+
+	filter, d, err := blockfilter.Get(filters, blocks, 12, true)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, digest, d, "wrong digest")
+	assert.Equal(t, stored, filter, "wrong filter")
+
+	ok, err := blockfilter.Match(filter, blockfilter.KeyOf(d), []byte("item"))
+	assert.Nil(t, err, "wrong error")
+	assert.True(t, ok, "item not matched")
+}
+
+func TestGetWhenBlockNotFound(t *testing.T) {
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	filters := mocks.NewMockHandle(ctl)
+	blocks := mocks.NewMockHandle(ctl)
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, 12)
+
+	blocks.EXPECT().Get(key).Return(nil).Times(1)
+
+	_, _, err := blockfilter.Get(filters, blocks, 12, true)
+	assert.Equal(t, fault.BlockNotFound, err, "wrong error")
+}
@@ -35,10 +35,7 @@ func DigestForBlock(number uint64) (blockdigest.Digest, error) {
 
 	// valid block number
 	if number <= genesis.BlockNumber {
-		if mode.IsTesting() {
-			return genesis.TestGenesisDigest, nil
-		}
-		return genesis.LiveGenesisDigest, nil
+		return genesis.Digest(mode.ChainName())
 	}
 
 	digest := digestFromCache(number)
@@ -88,12 +88,9 @@ func SetGenesis() {
 // internal: must hold lock
 func setGenesis() {
 	globalData.height = genesis.BlockNumber
-	globalData.previousBlock = genesis.LiveGenesisDigest
+	globalData.previousBlock, _ = genesis.Digest(mode.ChainName()) // checked on startup
 	globalData.previousVersion = 1
 	globalData.previousTimestamp = 0
-	if mode.IsTesting() {
-		globalData.previousBlock = genesis.TestGenesisDigest
-	}
 }
 
 // Set - set current header data
@@ -278,17 +278,56 @@ func ResetDifficulty() {
 	difficulty.Current.SetBits(difficulty.OneUint64)
 }
 
+// HeaderSource - function to find the header of a block by number
+type HeaderSource func(height uint64) (*Header, error)
+
+// StoredHeader - header of a block in the local database
+func StoredHeader(height uint64) (*Header, error) {
+	blockKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(blockKey, height)
+
+	packed := storage.Pool.Blocks.Get(blockKey)
+	if packed == nil {
+		return storedLightHeader(blockKey)
+	}
+
+	header, _, _, err := extractHeader(packed, 0, true, storage.Pool.BlockHeaderHash)
+	if err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+// a light node keeps only the headers of blocks
+func storedLightHeader(blockKey []byte) (*Header, error) {
+	packed := storage.Pool.Headers.Get(blockKey)
+	if packed == nil {
+		return nil, fault.BlockNotFound
+	}
+	packedHeader, err := PackedHeaderOf(packed)
+	if err != nil {
+		return nil, err
+	}
+	return packedHeader.Unpack()
+}
+
 // DifficultyByPreviousTimespanAtBlock - next difficulty value by previous timespan
 func DifficultyByPreviousTimespanAtBlock(height uint64) (float64, error) {
-	actualTimespan, err := prevDifficultyTimespan(height)
+	return DifficultyByPreviousTimespanAtBlockFrom(height, StoredHeader)
+}
+
+// DifficultyByPreviousTimespanAtBlockFrom - next difficulty value by
+// previous timespan with the earlier headers from a source
+func DifficultyByPreviousTimespanAtBlockFrom(height uint64, source HeaderSource) (float64, error) {
+	actualTimespan, err := prevDifficultyTimespan(height, source)
 	if err != nil {
 		return float64(0), err
 	}
-	prevDifficulty, err := prevDifficultyBaseAtBlock(height)
+	prevDifficulty, err := prevDifficultyBaseAtBlock(height, source)
 	log.Infof(
 		"previous difficulty %f, expect timespan %d seconds, actual timespan %d seconds",
 		prevDifficulty,
-		difficulty.ExpectedBlockSpacingInSecond*difficulty.AdjustTimespanInBlocks,
+		difficulty.BlockSpacing()*difficulty.AdjustTimespanInBlocks,
 		actualTimespan,
 	)
 	if err != nil {
@@ -297,7 +336,7 @@ func DifficultyByPreviousTimespanAtBlock(height uint64) (float64, error) {
 	return difficulty.NextDifficultyByPreviousTimespan(actualTimespan, prevDifficulty), nil
 }
 
-func prevDifficultyBaseAtBlock(height uint64) (float64, error) {
+func prevDifficultyBaseAtBlock(height uint64, source HeaderSource) (float64, error) {
 	var baseBlockHeight uint64
 	if isDifficultyAdjustmentBlock(height) {
 		baseBlockHeight = height - 1
@@ -310,7 +349,7 @@ func prevDifficultyBaseAtBlock(height uint64) (float64, error) {
 		baseBlockHeight = MinimumDifficultyBaseBlock
 	}
 
-	diff, err := difficultyOfBlock(baseBlockHeight)
+	diff, err := difficultyOfBlock(baseBlockHeight, source)
 	log.Debugf("block %d difficulty %f", baseBlockHeight, diff)
 	if err != nil {
 		return float64(0), err
@@ -318,10 +357,10 @@ func prevDifficultyBaseAtBlock(height uint64) (float64, error) {
 	return diff, nil
 }
 
-func prevDifficultyTimespan(height uint64) (uint64, error) {
+func prevDifficultyTimespan(height uint64, source HeaderSource) (uint64, error) {
 	beginBlock, endBlock := difficulty.PrevTimespanBlockBeginAndEnd(height)
 	log.Debugf("height %d, timespan from block %d - %d", height, beginBlock, endBlock)
-	duration, err := timespanOfBlockFromBeginToEnd(beginBlock, endBlock)
+	duration, err := timespanOfBlockFromBeginToEnd(beginBlock, endBlock, source)
 	if err != nil {
 		log.Errorf("get timespan from block %d - %d with error: %s", beginBlock, endBlock, err)
 		return uint64(0), err
@@ -329,15 +368,15 @@ func prevDifficultyTimespan(height uint64) (uint64, error) {
 	return duration, nil
 }
 
-func timespanOfBlockFromBeginToEnd(beginBlock uint64, endBlock uint64) (uint64, error) {
-	beginTime, err := timestampOfBlock(beginBlock)
+func timespanOfBlockFromBeginToEnd(beginBlock uint64, endBlock uint64, source HeaderSource) (uint64, error) {
+	beginTime, err := timestampOfBlock(beginBlock, source)
 	if err != nil {
 		log.Errorf("block %d timestamp with error: %s", beginBlock, err)
 		return uint64(0), err
 	}
 	log.Debugf("block %d timestamp %d", beginBlock, beginTime)
 
-	endTime, err := timestampOfBlock(endBlock)
+	endTime, err := timestampOfBlock(endBlock, source)
 	if err != nil {
 		log.Errorf("block %d timestamp with error: %s", endBlock, err)
 		return uint64(0), err
@@ -352,16 +391,8 @@ func timespanOfBlockFromBeginToEnd(beginBlock uint64, endBlock uint64) (uint64,
 	return endTime - beginTime, nil
 }
 
-func timestampOfBlock(height uint64) (uint64, error) {
-	blockKey := make([]byte, 8)
-	binary.BigEndian.PutUint64(blockKey, height)
-
-	packed := storage.Pool.Blocks.Get(blockKey)
-	if packed == nil {
-		return uint64(0), fault.BlockNotFound
-	}
-
-	header, _, _, err := extractHeader(packed, 0, true, storage.Pool.BlockHeaderHash)
+func timestampOfBlock(height uint64, source HeaderSource) (uint64, error) {
+	header, err := source(height)
 	if err != nil {
 		return uint64(0), err
 	}
@@ -369,16 +400,8 @@ func timestampOfBlock(height uint64) (uint64, error) {
 	return header.Timestamp, nil
 }
 
-func difficultyOfBlock(height uint64) (float64, error) {
-	blockKey := make([]byte, 8)
-	binary.BigEndian.PutUint64(blockKey, height)
-
-	packed := storage.Pool.Blocks.Get(blockKey)
-	if packed == nil {
-		return float64(0), fault.BlockNotFound
-	}
-
-	header, _, _, err := extractHeader(packed, 0, true, storage.Pool.BlockHeaderHash)
+func difficultyOfBlock(height uint64, source HeaderSource) (float64, error) {
+	header, err := source(height)
 	if err != nil {
 		return float64(0), err
 	}
@@ -0,0 +1,233 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockrecord
+
+import (
+	"crypto/sha256"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/difficulty"
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+// PackedHeaderOf - the packed header at the front of a packed block
+func PackedHeaderOf(block []byte) (PackedHeader, error) {
+	packedHeader := PackedHeader{}
+	if len(block) < totalBlockSize {
+		return packedHeader, fault.InvalidBlockHeaderSize
+	}
+	copy(packedHeader[:], block[:totalBlockSize])
+	return packedHeader, nil
+}
+
+// a validated header
+type chainHeader struct {
+	header *Header
+	digest blockdigest.Digest // empty until known
+	id     [sha256.Size]byte  // to match the header of a block body
+}
+
+// HeaderChain - validates a sequence of headers that follow on from
+// the local chain, so that a bad fork is rejected before any block
+// bodies are downloaded
+type HeaderChain struct {
+	chainName string
+	stored    HeaderSource // for blocks before the first header
+	tip       chainHeader  // last stored block or last header added
+	first     uint64       // block number of headers[0]
+	headers   []chainHeader
+	released  int // cleared entries before headers[0] in its array
+}
+
+// NewHeaderChain - start a header chain after a stored block
+func NewHeaderChain(chainName string, previous *Header, previousDigest blockdigest.Digest, stored HeaderSource) *HeaderChain {
+	return &HeaderChain{
+		chainName: chainName,
+		stored:    stored,
+		tip: chainHeader{
+			header: previous,
+			digest: previousDigest,
+		},
+		first:   previous.Number + 1,
+		headers: make([]chainHeader, 0, 1000),
+	}
+}
+
+// Height - block number of the last header added
+func (c *HeaderChain) Height() uint64 {
+	return c.tip.header.Number
+}
+
+// Add - validate the next header and append it to the chain
+//
+// if checkDigest is false the Argon2 digest is not computed, as for
+// fast sync, and the digest is taken from the following header
+func (c *HeaderChain) Add(packed PackedHeader, checkDigest bool) error {
+	header, err := packed.Unpack()
+	if err != nil {
+		return err
+	}
+
+	previous := c.tip.header
+	if header.Number != previous.Number+1 {
+		return fault.HeightOutOfSequence
+	}
+
+	if err := ValidHeaderVersion(previous.Version, header.Version); err != nil {
+		return err
+	}
+
+	// the digest of an unchecked header is trusted from its successor
+	if c.tip.digest.IsEmpty() {
+		c.tip.digest = header.PreviousBlock
+		if n := len(c.headers); n > 0 {
+			c.headers[n-1].digest = header.PreviousBlock
+		}
+	} else if err := ValidBlockLinkage(c.tip.digest, header.PreviousBlock); err != nil {
+		return err
+	}
+
+	if previous.Timestamp > header.Timestamp {
+		err := ValidBlockTimeSpacingAtVersion(header.Version, previous.Timestamp-header.Timestamp)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := c.validDifficulty(header); err != nil {
+		return err
+	}
+
+	h := chainHeader{
+		header: header,
+		id:     sha256.Sum256(packed[:]),
+	}
+	if checkDigest {
+		h.digest = packed.Digest()
+		if !h.digest.IsValidByDifficulty(header.Difficulty, c.chainName) {
+			return fault.InvalidBlockHeaderDifficulty
+		}
+	}
+
+	c.headers = append(c.headers, h)
+	c.tip = h
+
+	return nil
+}
+
+// the difficulty of a header must be the one calculated at the last
+// adjustment, as blocks are checked when stored
+func (c *HeaderChain) validDifficulty(header *Header) error {
+	if !IsDifficultyAppliedVersion(header.Version) || c.chainName == chain.Local {
+		return nil
+	}
+
+	expected := difficulty.New()
+	if IsBlockToAdjustDifficulty(header.Number, header.Version) {
+		next, err := DifficultyByPreviousTimespanAtBlockFrom(header.Number, c.header)
+		if err != nil {
+			return err
+		}
+		expected.Set(next)
+	} else if IsDifficultyAppliedVersion(c.tip.header.Version) {
+		expected.Set(c.tip.header.Difficulty.Value())
+	} else {
+		// the first difficulty applied header follows the stored
+		// difficulty, which is checked when the block is stored
+		return nil
+	}
+
+	if header.Difficulty.Value() != expected.Value() {
+		return fault.DifficultyDoesNotMatchCalculated
+	}
+	return nil
+}
+
+// header of a block from the chain or the stored blocks
+func (c *HeaderChain) header(height uint64) (*Header, error) {
+	if height >= c.first && height-c.first < uint64(len(c.headers)) {
+		return c.headers[height-c.first].header, nil
+	}
+	if height == c.tip.header.Number {
+		return c.tip.header, nil
+	}
+	header, err := c.stored(height)
+	if err != nil {
+		return nil, localError{err: err}
+	}
+	return header, nil
+}
+
+// a stored header could not be read, which is no fault of the header
+// being added
+type localError struct {
+	err error
+}
+
+func (e localError) Error() string {
+	return e.err.Error()
+}
+
+func (e localError) Unwrap() error {
+	return e.err
+}
+
+// IsLocalError - true if an error from Add came from reading the local
+// chain rather than from validating the header
+func IsLocalError(err error) bool {
+	_, ok := err.(localError)
+	return ok
+}
+
+// Header - a validated header of the chain and its digest, the digest
+// is empty until known
+func (c *HeaderChain) Header(height uint64) (*Header, blockdigest.Digest, error) {
+	if height < c.first || height-c.first >= uint64(len(c.headers)) {
+		return nil, blockdigest.Digest{}, fault.BlockNotFound
+	}
+	h := c.headers[height-c.first]
+	return h.header, h.digest, nil
+}
+
+// Matches - check that a packed block has the header validated for its
+// block number, blocks outside the chain do not match
+func (c *HeaderChain) Matches(height uint64, packedBlock []byte) bool {
+	if height < c.first || height-c.first >= uint64(len(c.headers)) {
+		return false
+	}
+	packedHeader, err := PackedHeaderOf(packedBlock)
+	if err != nil {
+		return false
+	}
+	return sha256.Sum256(packedHeader[:]) == c.headers[height-c.first].id
+}
+
+// Release - forget the headers up to a block number once their blocks
+// are stored
+//
+// released headers are cleared at once, and the rest copied to a new
+// array once most of the old one is released, so that it can be freed
+func (c *HeaderChain) Release(height uint64) {
+	if height < c.first {
+		return
+	}
+	n := height - c.first + 1
+	if n > uint64(len(c.headers)) {
+		n = uint64(len(c.headers))
+	}
+	clear(c.headers[:n])
+	c.headers = c.headers[n:]
+	c.first += n
+	c.released += int(n)
+
+	if c.released > len(c.headers) {
+		remaining := make([]chainHeader, len(c.headers), len(c.headers)+1000)
+		copy(remaining, c.headers)
+		c.headers = remaining
+		c.released = 0
+	}
+}
@@ -0,0 +1,236 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blockrecord_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bitmark-inc/logger"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/difficulty"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+)
+
+// a header following on from another
+func nextTestHeader(previous *blockrecord.Header, previousDigest blockdigest.Digest) *blockrecord.Header {
+	return &blockrecord.Header{
+		Version:          previous.Version,
+		TransactionCount: blockrecord.MinimumTransactions,
+		Number:           previous.Number + 1,
+		PreviousBlock:    previousDigest,
+		MerkleRoot:       merkle.NewDigest([]byte{byte(previous.Number)}),
+		Timestamp:        previous.Timestamp + 60,
+		Difficulty:       previous.Difficulty,
+		Nonce:            blockrecord.NonceType(previous.Number),
+	}
+}
+
+func newTestHeaderChain(chainName string) (*blockrecord.HeaderChain, *blockrecord.Header, blockdigest.Digest) {
+	tip := setupHeader()
+	tip.TransactionCount = blockrecord.MinimumTransactions
+	tip.Timestamp = uint64(time.Now().Add(-time.Hour).Unix())
+	tipDigest := blockdigest.Digest{1, 2, 3}
+
+	stored := func(height uint64) (*blockrecord.Header, error) {
+		return nil, fault.BlockNotFound
+	}
+	return blockrecord.NewHeaderChain(chainName, tip, tipDigest, stored), tip, tipDigest
+}
+
+func TestHeaderChainAddWithoutDigest(t *testing.T) {
+	c, tip, tipDigest := newTestHeaderChain(chain.Local)
+
+	h1 := nextTestHeader(tip, tipDigest)
+	err := c.Add(h1.Pack(), false)
+	assert.Nil(t, err, "wrong first header")
+
+	// digest of an unchecked header comes from the next one
+	h2 := nextTestHeader(h1, blockdigest.Digest{4, 5, 6})
+	err = c.Add(h2.Pack(), false)
+	assert.Nil(t, err, "wrong second header")
+	assert.Equal(t, h2.Number, c.Height(), "wrong height")
+
+	h3 := nextTestHeader(h2, blockdigest.Digest{7})
+	err = c.Add(h3.Pack(), false)
+	assert.Nil(t, err, "wrong third header")
+
+	// but must then link
+	h4 := nextTestHeader(h3, blockdigest.Digest{8})
+	err = c.Add(h4.Pack(), true)
+	assert.Nil(t, err, "wrong fourth header")
+	h5 := nextTestHeader(h4, blockdigest.Digest{9})
+	err = c.Add(h5.Pack(), false)
+	assert.Equal(t, fault.PreviousBlockDigestDoesNotMatch, err, "wrong linkage error")
+}
+
+func TestHeaderChainAddWithDigest(t *testing.T) {
+	c, tip, tipDigest := newTestHeaderChain(chain.Local)
+
+	h1 := nextTestHeader(tip, tipDigest)
+	packed := h1.Pack()
+	err := c.Add(packed, true)
+	assert.Nil(t, err, "wrong first header")
+
+	h2 := nextTestHeader(h1, packed.Digest())
+	err = c.Add(h2.Pack(), false)
+	assert.Nil(t, err, "wrong linked header")
+}
+
+func TestHeaderChainWhenInvalid(t *testing.T) {
+	c, tip, tipDigest := newTestHeaderChain(chain.Local)
+
+	h := nextTestHeader(tip, tipDigest)
+	h.Number += 1
+	err := c.Add(h.Pack(), false)
+	assert.Equal(t, fault.HeightOutOfSequence, err, "wrong sequence error")
+
+	h = nextTestHeader(tip, tipDigest)
+	h.PreviousBlock = blockdigest.Digest{9}
+	err = c.Add(h.Pack(), false)
+	assert.Equal(t, fault.PreviousBlockDigestDoesNotMatch, err, "wrong linkage error")
+
+	h = nextTestHeader(tip, tipDigest)
+	h.Version = tip.Version - 1
+	err = c.Add(h.Pack(), false)
+	assert.Equal(t, fault.BlockVersionMustNotDecrease, err, "wrong version error")
+
+	h = nextTestHeader(tip, tipDigest)
+	h.Timestamp = tip.Timestamp - 3600
+	err = c.Add(h.Pack(), false)
+	assert.Equal(t, fault.InvalidBlockHeaderTimestamp, err, "wrong timestamp error")
+
+	assert.Equal(t, tip.Number, c.Height(), "invalid header added")
+}
+
+func TestHeaderChainDifficulty(t *testing.T) {
+	c, tip, tipDigest := newTestHeaderChain(chain.Testing)
+
+	h := nextTestHeader(tip, tipDigest)
+	h.Difficulty = difficulty.New()
+	h.Difficulty.Set(tip.Difficulty.Value() * 2)
+	err := c.Add(h.Pack(), false)
+	assert.Equal(t, fault.DifficultyDoesNotMatchCalculated, err, "wrong difficulty error")
+}
+
+// the difficulty adjustment logs
+func setupTestLogger(t *testing.T) {
+	_ = logger.Initialise(logger.Configuration{
+		Directory: t.TempDir(),
+		File:      "testing.log",
+		Size:      1048576,
+		Count:     10,
+		Levels: map[string]string{
+			logger.DefaultTag: "critical",
+		},
+	})
+	blockrecord.Initialise(nil)
+	t.Cleanup(logger.Finalise)
+}
+
+func TestHeaderChainWhenStoredHeaderMissing(t *testing.T) {
+	setupTestLogger(t)
+
+	tip := setupHeader()
+	tip.Number = difficulty.AdjustTimespanInBlocks - 1
+	tip.Timestamp = uint64(time.Now().Add(-time.Hour).Unix())
+	tipDigest := blockdigest.Digest{1, 2, 3}
+
+	stored := func(height uint64) (*blockrecord.Header, error) {
+		return nil, fault.BlockNotFound
+	}
+	c := blockrecord.NewHeaderChain(chain.Testing, tip, tipDigest, stored)
+
+	// the difficulty adjustment reads the stored headers
+	err := c.Add(nextTestHeader(tip, tipDigest).Pack(), false)
+	assert.True(t, blockrecord.IsLocalError(err), "not a local error: %v", err)
+
+	h := nextTestHeader(tip, tipDigest)
+	h.Number += 1
+	err = c.Add(h.Pack(), false)
+	assert.False(t, blockrecord.IsLocalError(err), "validation error is local")
+}
+
+func TestHeaderChainMatchesAndRelease(t *testing.T) {
+	c, tip, tipDigest := newTestHeaderChain(chain.Local)
+
+	h1 := nextTestHeader(tip, tipDigest)
+	p1 := h1.Pack()
+	_ = c.Add(p1, false)
+	h2 := nextTestHeader(h1, blockdigest.Digest{1})
+	p2 := h2.Pack()
+	_ = c.Add(p2, false)
+
+	block := append(p1[:], 0x01, 0x02)
+	assert.True(t, c.Matches(h1.Number, block), "header does not match")
+	assert.False(t, c.Matches(h2.Number, block), "other header matches")
+	assert.False(t, c.Matches(tip.Number, block), "stored block matches")
+	assert.False(t, c.Matches(h1.Number, p1[:10]), "short block matches")
+
+	c.Release(h1.Number)
+	assert.False(t, c.Matches(h1.Number, block), "released header matches")
+	assert.True(t, c.Matches(h2.Number, p2[:]), "remaining header does not match")
+}
+
+func TestHeaderChainReleaseEach(t *testing.T) {
+	c, tip, tipDigest := newTestHeaderChain(chain.Local)
+
+	packed := make([]blockrecord.PackedHeader, 0, 10)
+	previous, previousDigest := tip, tipDigest
+	for i := 0; i < 10; i += 1 {
+		h := nextTestHeader(previous, previousDigest)
+		p := h.Pack()
+		assert.Nil(t, c.Add(p, true), "wrong add error")
+		packed = append(packed, p)
+		previous, previousDigest = h, p.Digest()
+	}
+
+	// the remaining headers survive the array being replaced
+	for i := range packed {
+		c.Release(tip.Number + uint64(i) + 1)
+		for j := i + 1; j < len(packed); j += 1 {
+			assert.True(t, c.Matches(tip.Number+uint64(j)+1, packed[j][:]), "header: %d lost after release: %d", j, i)
+		}
+	}
+
+	h := nextTestHeader(previous, previousDigest)
+	p := h.Pack()
+	assert.Nil(t, c.Add(p, true), "wrong add after release")
+	assert.True(t, c.Matches(h.Number, p[:]), "added header does not match")
+}
+
+func TestHeaderChainHeader(t *testing.T) {
+	c, tip, tipDigest := newTestHeaderChain(chain.Local)
+
+	h1 := nextTestHeader(tip, tipDigest)
+	_ = c.Add(h1.Pack(), false)
+
+	// digest unknown until the next header
+	header, digest, err := c.Header(h1.Number)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, h1, header, "wrong header")
+	assert.True(t, digest.IsEmpty(), "digest known")
+
+	d1 := blockdigest.Digest{4, 5, 6}
+	h2 := nextTestHeader(h1, d1)
+	_ = c.Add(h2.Pack(), false)
+
+	_, digest, err = c.Header(h1.Number)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, d1, digest, "wrong digest")
+
+	_, _, err = c.Header(tip.Number)
+	assert.Equal(t, fault.BlockNotFound, err, "stored header found")
+
+	c.Release(h1.Number)
+	_, _, err = c.Header(h1.Number)
+	assert.Equal(t, fault.BlockNotFound, err, "released header found")
+}
@@ -4,3 +4,57 @@
 // license that can be found in the LICENSE file.
 
 package chain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+func TestRegister(t *testing.T) {
+	err := Register(Parameters{
+		Name:     "Staging",
+		Genesis:  "0102030405",
+		PortBase: 30000,
+		Bitcoin:  PaymentRegression,
+	})
+	assert.Nil(t, err, "wrong register error")
+
+	p := Custom("staging")
+	assert.NotNil(t, p, "custom chain not registered")
+	assert.Equal(t, "staging", p.Name, "wrong name")
+	assert.Equal(t, []byte{1, 2, 3, 4, 5}, p.GenesisBlock(), "wrong genesis block")
+	assert.Equal(t, PaymentRegression, p.Bitcoin, "wrong bitcoin network")
+	assert.Equal(t, PaymentTest, p.Litecoin, "wrong default litecoin network")
+
+	assert.True(t, Valid("staging"), "custom chain not valid")
+	assert.False(t, Valid("unknown"), "unknown chain valid")
+	assert.Nil(t, Custom(Testing), "built in chain is custom")
+
+	err = Register(Parameters{Name: "staging", Genesis: "01"})
+	assert.Equal(t, fault.ChainAlreadyRegistered, err, "wrong duplicate error")
+}
+
+func TestRegisterWhenInvalid(t *testing.T) {
+	invalid := []struct {
+		parameters Parameters
+		err        error
+	}{
+		{Parameters{Name: "", Genesis: "01"}, fault.InvalidChain},
+		{Parameters{Name: "Testing", Genesis: "01"}, fault.InvalidChain},
+		{Parameters{Name: "bad", Genesis: ""}, fault.InvalidGenesisBlock},
+		{Parameters{Name: "bad", Genesis: "0x01"}, fault.InvalidGenesisBlock},
+		{Parameters{Name: "bad", Genesis: "01", Bitcoin: PaymentMain}, fault.InvalidPaymentNetwork},
+		{Parameters{Name: "bad", Genesis: "01", Litecoin: "other"}, fault.InvalidPaymentNetwork},
+		{Parameters{Name: "bad", Genesis: "01", PortBase: 65536}, fault.InvalidPortNumber},
+		{Parameters{Name: "bad", Genesis: "01", Difficulty: -1}, fault.InvalidDifficulty},
+	}
+
+	for i, item := range invalid {
+		err := Register(item.parameters)
+		assert.Equal(t, item.err, err, "%d: wrong error", i)
+	}
+	assert.Nil(t, Custom("bad"), "invalid chain registered")
+}
@@ -12,12 +12,12 @@ const (
 	Local   = "local"
 )
 
-// Valid - validate a chain name
+// Valid - validate a chain name, either built in or registered
 func Valid(name string) bool {
 	switch name {
 	case Bitmark, Testing, Local:
 		return true
 	default:
-		return false
+		return Custom(name) != nil
 	}
 }
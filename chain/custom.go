@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+// payment networks of a custom chain
+const (
+	PaymentMain       = "main"
+	PaymentTest       = "test"
+	PaymentRegression = "regtest"
+)
+
+// Parameters - definition of a custom named chain
+// this is read from a chain definition file
+//
+// custom chains always use testing addresses, so their payments are
+// on a test or regression network
+type Parameters struct {
+	Name         string  `gluamapper:"name" json:"name"`
+	Genesis      string  `gluamapper:"genesis" json:"genesis"`             // hex packed genesis block
+	PortBase     int     `gluamapper:"port_base" json:"port_base"`         // added to the default ports
+	Nodes        string  `gluamapper:"nodes" json:"nodes"`                 // DNS TXT domain of the seed nodes
+	Bitcoin      string  `gluamapper:"bitcoin" json:"bitcoin"`             // payment network
+	Litecoin     string  `gluamapper:"litecoin" json:"litecoin"`           // payment network
+	Difficulty   float64 `gluamapper:"difficulty" json:"difficulty"`       // initial transaction difficulty
+	BlockSpacing uint64  `gluamapper:"block_spacing" json:"block_spacing"` // expected seconds between blocks
+
+	genesisBlock []byte
+}
+
+// GenesisBlock - the packed genesis block
+func (p *Parameters) GenesisBlock() []byte {
+	return p.genesisBlock
+}
+
+var custom struct {
+	sync.RWMutex
+	chains map[string]*Parameters
+}
+
+// Register - add a custom chain, its name must not be one of the
+// built in chains
+func Register(parameters Parameters) error {
+	name := strings.ToLower(parameters.Name)
+	switch name {
+	case "", Bitmark, Testing, Local:
+		return fault.InvalidChain
+	}
+
+	genesisBlock, err := hex.DecodeString(parameters.Genesis)
+	if err != nil || len(genesisBlock) == 0 {
+		return fault.InvalidGenesisBlock
+	}
+
+	for _, network := range []*string{&parameters.Bitcoin, &parameters.Litecoin} {
+		switch *network {
+		case "":
+			*network = PaymentTest
+		case PaymentTest, PaymentRegression:
+		default:
+			return fault.InvalidPaymentNetwork
+		}
+	}
+
+	if parameters.PortBase < 0 || parameters.PortBase > 65535 {
+		return fault.InvalidPortNumber
+	}
+
+	if parameters.Difficulty < 0 {
+		return fault.InvalidDifficulty
+	}
+
+	parameters.Name = name
+	parameters.genesisBlock = genesisBlock
+
+	custom.Lock()
+	defer custom.Unlock()
+
+	if custom.chains == nil {
+		custom.chains = make(map[string]*Parameters)
+	}
+	if _, ok := custom.chains[name]; ok {
+		return fault.ChainAlreadyRegistered
+	}
+	custom.chains[name] = &parameters
+
+	return nil
+}
+
+// Custom - the parameters of a custom chain, nil for a built in or
+// unknown chain
+func Custom(name string) *Parameters {
+	custom.RLock()
+	defer custom.RUnlock()
+	return custom.chains[name]
+}
@@ -3,5 +3,6 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-// Package chain - simple module to list the supported chains
+// Package chain - simple module to list the supported chains and any
+// custom chains defined by a chain file
 package chain
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bulk_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/bulk"
+)
+
+func writeFile(t *testing.T, name string, data string) string {
+	fileName := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(fileName, []byte(data), 0o600)
+	assert.Nil(t, err, "write error")
+	return fileName
+}
+
+func TestReadManifestCSV(t *testing.T) {
+	fileName := writeFile(t, "assets.csv", `name, metadata, file, quantity, recipient
+one,k\u0000v,one.txt,,
+two,k\u0000v,/data/two.txt,3,bob
+`)
+	entries, err := bulk.ReadManifest(fileName)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, 2, len(entries), "wrong entry count")
+
+	assert.Equal(t, &bulk.Entry{
+		Name:     "one",
+		Metadata: `k\u0000v`,
+		File:     filepath.Join(filepath.Dir(fileName), "one.txt"),
+		Quantity: 1,
+	}, entries[0], "wrong first entry")
+	assert.Equal(t, "/data/two.txt", entries[1].File, "wrong absolute file")
+	assert.Equal(t, 3, entries[1].Quantity, "wrong quantity")
+	assert.Equal(t, "bob", entries[1].Recipient, "wrong recipient")
+}
+
+func TestReadManifestJSON(t *testing.T) {
+	fileName := writeFile(t, "assets.json", `[{"name":"one","metadata":"k\u0000v","fingerprint":"01ab","quantity":2}]`)
+	entries, err := bulk.ReadManifest(fileName)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, []*bulk.Entry{
+		{
+			Name:        "one",
+			Metadata:    "k\u0000v",
+			Fingerprint: "01ab",
+			Quantity:    2,
+		},
+	}, entries, "wrong entries")
+}
+
+func TestReadManifestWhenInvalid(t *testing.T) {
+	_, err := bulk.ReadManifest(writeFile(t, "a.csv", "name,colour\none,red\n"))
+	assert.EqualError(t, err, `unknown column: "colour"`, "wrong column error")
+
+	_, err = bulk.ReadManifest(writeFile(t, "a.csv", "name,fingerprint,quantity\none,01ab,many\n"))
+	assert.EqualError(t, err, `line: 2: invalid quantity: "many"`, "wrong quantity error")
+
+	_, err = bulk.ReadManifest(writeFile(t, "a.csv", "name,metadata\none,k\n"))
+	assert.EqualError(t, err, "entry: 1: file or fingerprint is required", "wrong fingerprint error")
+
+	_, err = bulk.ReadManifest(writeFile(t, "a.json", `[{"fingerprint":"01ab"}]`))
+	assert.EqualError(t, err, "entry: 1: name is required", "wrong name error")
+}
+
+func TestProgress(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "assets.progress")
+
+	p, err := bulk.LoadProgress(fileName)
+	assert.Nil(t, err, "wrong load error")
+
+	result := p.Result("one", "01ab")
+	result.AssetId = "asset"
+	result.IssueIds = []string{"issue"}
+	result.NonceBase = 1234
+	result.Recipient = "bob"
+	assert.True(t, result.Issued(1), "not issued")
+	assert.False(t, result.Issued(2), "issued")
+	assert.False(t, result.Transferred(), "transferred")
+
+	err = p.Save()
+	assert.Nil(t, err, "wrong save error")
+
+	p, err = bulk.LoadProgress(fileName)
+	assert.Nil(t, err, "wrong reload error")
+	assert.Equal(t, result, p.Result("one", "01ab"), "wrong result")
+
+	result = p.Result("two", "02cd")
+	assert.Equal(t, &bulk.Result{Name: "two", Fingerprint: "02cd"}, result, "wrong new result")
+	assert.True(t, result.Transferred(), "no recipient not transferred")
+}
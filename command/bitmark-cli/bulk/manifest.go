@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bulk
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Entry - one asset to register and issue
+//
+// metadata is in the same form as the create command:
+// "key1\u0000value1\u0000key2\u0000value2"
+type Entry struct {
+	Name        string `json:"name"`
+	Metadata    string `json:"metadata"`
+	File        string `json:"file,omitempty"`        // file to fingerprint
+	Fingerprint string `json:"fingerprint,omitempty"` // used if there is no file
+	Quantity    int    `json:"quantity,omitempty"`    // default 1
+	Recipient   string `json:"recipient,omitempty"`   // identity or account to transfer the issues to
+}
+
+// columns of a CSV manifest
+var columns = []string{"name", "metadata", "file", "fingerprint", "quantity", "recipient"}
+
+// ReadManifest - read a JSON array of entries if the file name ends
+// in ".json", otherwise CSV with a header row naming the columns
+//
+// a relative file in an entry is taken from the manifest's directory
+func ReadManifest(fileName string) ([]*Entry, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []*Entry
+	if strings.EqualFold(".json", filepath.Ext(fileName)) {
+		err = json.NewDecoder(f).Decode(&entries)
+	} else {
+		entries, err = readCSV(f)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	directory := filepath.Dir(fileName)
+	for i, entry := range entries {
+		if 0 == entry.Quantity {
+			entry.Quantity = 1
+		}
+		if "" != entry.File && !filepath.IsAbs(entry.File) {
+			entry.File = filepath.Join(directory, entry.File)
+		}
+		err := entry.check()
+		if err != nil {
+			return nil, fmt.Errorf("entry: %d: %w", i+1, err)
+		}
+	}
+	return entries, nil
+}
+
+func readCSV(r io.Reader) ([]*Entry, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	index := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !isColumn(name) {
+			return nil, fmt.Errorf("unknown column: %q", name)
+		}
+		index[name] = i
+	}
+
+	entries := make([]*Entry, 0)
+	for {
+		record, err := reader.Read()
+		if io.EOF == err {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		field := func(name string) string {
+			if i, ok := index[name]; ok {
+				return record[i]
+			}
+			return ""
+		}
+
+		entry := &Entry{
+			Name:        field("name"),
+			Metadata:    field("metadata"),
+			File:        field("file"),
+			Fingerprint: field("fingerprint"),
+			Recipient:   field("recipient"),
+		}
+		if q := field("quantity"); "" != q {
+			entry.Quantity, err = strconv.Atoi(q)
+			if err != nil {
+				return nil, fmt.Errorf("line: %d: invalid quantity: %q", len(entries)+2, q)
+			}
+		}
+		entries = append(entries, entry)
+	}
+}
+
+func isColumn(name string) bool {
+	for _, c := range columns {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (entry *Entry) check() error {
+	if "" == entry.Name {
+		return fmt.Errorf("name is required")
+	}
+	if "" == entry.File && "" == entry.Fingerprint {
+		return fmt.Errorf("file or fingerprint is required")
+	}
+	if entry.Quantity < 0 {
+		return fmt.Errorf("invalid quantity: %d", entry.Quantity)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bulk
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Result - what has been done for one entry
+type Result struct {
+	Name        string    `json:"name"`
+	Fingerprint string    `json:"fingerprint"`
+	AssetId     string    `json:"assetId,omitempty"`
+	IssueIds    []string  `json:"issueIds,omitempty"`
+	NonceBase   uint64    `json:"nonceBase,omitempty"`   // first nonce of the paid issues
+	ProofStatus string    `json:"proofStatus,omitempty"` // free issue
+	Recipient   string    `json:"recipient,omitempty"`
+	TransferIds []string  `json:"transferIds,omitempty"`
+	Payments    []Payment `json:"payments,omitempty"` // paid issues and transfers
+	Error       string    `json:"error,omitempty"`    // last failure, cleared on success
+}
+
+// Payment - a payment needed to confirm some transactions
+type Payment struct {
+	PayId    string            `json:"payId"`
+	Commands map[string]string `json:"commands,omitempty"`
+}
+
+// Issued - true once all of the issues have been submitted
+func (result *Result) Issued(quantity int) bool {
+	return len(result.IssueIds) >= quantity
+}
+
+// Transferred - true once all of the issues have been transferred
+func (result *Result) Transferred() bool {
+	return "" == result.Recipient || len(result.TransferIds) >= len(result.IssueIds)
+}
+
+// Progress - results by asset fingerprint, saved after each step
+// so that an interrupted run can resume
+type Progress struct {
+	fileName string
+	Results  map[string]*Result `json:"results"`
+}
+
+// LoadProgress - read a progress file, a missing file gives empty progress
+func LoadProgress(fileName string) (*Progress, error) {
+	p := &Progress{
+		fileName: fileName,
+		Results:  make(map[string]*Result),
+	}
+
+	buffer, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(buffer, p)
+	if err != nil {
+		return nil, err
+	}
+	if nil == p.Results {
+		p.Results = make(map[string]*Result)
+	}
+	return p, nil
+}
+
+// Result - the result for a fingerprint, created if not present
+func (p *Progress) Result(name string, fingerprint string) *Result {
+	result, ok := p.Results[fingerprint]
+	if !ok {
+		result = &Result{
+			Name:        name,
+			Fingerprint: fingerprint,
+		}
+		p.Results[fingerprint] = result
+	}
+	return result
+}
+
+// Save - replace the progress file
+func (p *Progress) Save() error {
+	return writeJSON(p.fileName, p)
+}
+
+// WriteReport - write the results in manifest order
+func WriteReport(fileName string, results []*Result) error {
+	return writeJSON(fileName, results)
+}
+
+// write to a temporary file and rename so an interruption cannot
+// leave a partial file
+func writeJSON(fileName string, data interface{}) error {
+	buffer, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tempFile := fileName + ".new"
+	err = os.WriteFile(tempFile, append(buffer, '\n'), 0o600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tempFile, fileName)
+}
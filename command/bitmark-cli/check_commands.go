@@ -140,6 +140,15 @@ func checkSeed(seed string, newSeed bool, testnet bool) (string, error) {
 		return "", fault.IncompatibleOptions
 	}
 
+	// a recovery phrase instead of a seed
+	if phrase := strings.Fields(seed); len(phrase) > 1 {
+		var err error
+		seed, err = account.PhraseToBase58EncodedSeed(phrase)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	// ensure can decode
 	_, err := account.PrivateKeyFromBase58Seed(seed)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 type Private struct {
 	PrivateKey  *account.PrivateKey `json:"privateKey"`
 	Seed        string              `json:"seed"`
+	Path        string              `json:"path,omitempty"`
 	Description string              `json:"description"`
 }
 
@@ -40,7 +41,7 @@ func decryptIdentity(password string, identity *Identity) (*Private, error) {
 		return nil, fault.WrongPassword
 	}
 
-	privateKey, err := account.PrivateKeyFromBase58Seed(seed)
+	privateKey, err := privateKeyFromSeed(seed, identity.Path)
 	if err != nil {
 		return nil, err
 	}
@@ -48,6 +49,7 @@ func decryptIdentity(password string, identity *Identity) (*Private, error) {
 	r := Private{
 		PrivateKey:  privateKey,
 		Seed:        seed,
+		Path:        identity.Path,
 		Description: identity.Description,
 	}
 	return &r, nil
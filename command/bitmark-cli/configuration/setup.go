@@ -28,6 +28,7 @@ type Identity struct {
 	Account     string `json:"account"`
 	Data        string `json:"data"`
 	Salt        string `json:"salt"`
+	Path        string `json:"path,omitempty"` // derivation path if not the seed's own account
 }
 
 // Load - read the configuration
@@ -114,36 +115,66 @@ func (config *Configuration) Private(password string, name string) (*Private, er
 
 // AddIdentity - store encrypted identity
 func (config *Configuration) AddIdentity(name string, description string, seed string, password string) error {
+	return config.AddDerivedIdentity(name, description, seed, "", password)
+}
+
+// AddDerivedIdentity - store encrypted identity for the account at a
+// derivation path below the seed, an empty path is the seed's own account
+//
+// WARNING: a derived identity stores the root seed, not the derived
+// key, so its password decrypts the seed of every account derived
+// from it.  The derived ed25519 key cannot be written as a seed, and
+// signing derives it again from the root seed and the path
+func (config *Configuration) AddDerivedIdentity(name string, description string, seed string, path string, password string) error {
 
 	if _, ok := config.Identities[name]; ok {
 		return fault.IdentityNameAlreadyExists
 	}
 
-	salt, secretKey, err := hashPassword(password)
+	private, err := privateKeyFromSeed(seed, path)
 	if err != nil {
 		return err
 	}
 
-	encrypted, err := encryptData(seed, secretKey)
+	salt, secretKey, err := hashPassword(password)
 	if err != nil {
 		return err
 	}
 
-	private, err := account.PrivateKeyFromBase58Seed(seed)
+	encrypted, err := encryptData(seed, secretKey)
 	if err != nil {
 		return err
 	}
 
+	if "" != path {
+		p, _ := account.ParsePath(path)
+		path = p.String()
+	}
+
 	config.Identities[name] = Identity{
 		Description: description,
 		Account:     private.Account().String(),
 		Data:        encrypted,
 		Salt:        salt.String(),
+		Path:        path,
 	}
 
 	return nil
 }
 
+// the seed's own key, or the key derived at the path
+func privateKeyFromSeed(seed string, path string) (*account.PrivateKey, error) {
+	if "" == path {
+		return account.PrivateKeyFromBase58Seed(seed)
+	}
+
+	p, err := account.ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return account.DerivePrivateKeyFromBase58Seed(seed, p)
+}
+
 // AddReceiveOnlyIdentity - store public-only identity
 func (config *Configuration) AddReceiveOnlyIdentity(name string, description string, acc string) error {
 
@@ -103,7 +103,7 @@ func main() {
 				cli.StringFlag{
 					Name:  "seed, s",
 					Value: "",
-					Usage: "+recover account from existing `SEED`",
+					Usage: "+recover account from existing `SEED` or recovery phrase",
 				},
 			},
 			Action: runSetup,
@@ -125,13 +125,23 @@ func main() {
 				cli.StringFlag{
 					Name:  "seed, s",
 					Value: "",
-					Usage: "+recover account from existing `SEED`",
+					Usage: "+recover account from existing `SEED` or recovery phrase",
 				},
 				cli.StringFlag{
 					Name:  "account, a",
 					Value: "",
 					Usage: "+add read-only `ACCOUNT`",
 				},
+				cli.StringFlag{
+					Name:  "from, f",
+					Value: "",
+					Usage: "+derive from the seed of identity `NAME` (requires --derive)",
+				},
+				cli.StringFlag{
+					Name:  "derive, D",
+					Value: "",
+					Usage: " use the account at hardened derivation `PATH` below the seed e.g. m/0'/1' (the root seed is stored)",
+				},
 			},
 			Action: runAdd,
 		},
@@ -167,6 +177,29 @@ func main() {
 			},
 			Action: runCreate,
 		},
+		{
+			Name:      "bulk-create",
+			Usage:     "register assets and create bitmarks from a manifest",
+			ArgsUsage: "\n   (* = required)",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "manifest, m",
+					Value: "",
+					Usage: "*CSV or JSON manifest `FILE` of name, metadata, file or fingerprint, quantity and recipient",
+				},
+				cli.StringFlag{
+					Name:  "progress, p",
+					Value: "",
+					Usage: " progress `FILE` to resume from [manifest.progress]",
+				},
+				cli.StringFlag{
+					Name:  "report, r",
+					Value: "",
+					Usage: " write results report to `FILE` [stdout]",
+				},
+			},
+			Action: runBulkCreate,
+		},
 		{
 			Name:      "transfer",
 			Usage:     "transfer a bitmark to another account",
@@ -189,6 +222,29 @@ func main() {
 			},
 			Action: runTransfer,
 		},
+		{
+			Name:      "replace",
+			Usage:     "replace an unpaid pending transfer with a new unratified transfer",
+			ArgsUsage: "\n   (* = required)",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "txid, t",
+					Value: "",
+					Usage: "*transaction id to transfer `TXID`",
+				},
+				cli.StringFlag{
+					Name:  "pending, p",
+					Value: "",
+					Usage: "*pending transfer id to be replaced `TXID`",
+				},
+				cli.StringFlag{
+					Name:  "receiver, r",
+					Value: "",
+					Usage: "*identity name to receive the bitmark `ACCOUNT`",
+				},
+			},
+			Action: runReplace,
+		},
 		{
 			Name:      "countersign",
 			Usage:     "countersign a transaction using current identity",
@@ -404,6 +460,34 @@ func main() {
 			},
 			Action: runTransactionStatus,
 		},
+		{
+			Name:      "verify-inclusion",
+			Usage:     "verify a transaction is in a block using only the block header",
+			ArgsUsage: "\n   (* = required)",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "txid, t",
+					Value: "",
+					Usage: "*transaction id to verify `TXID`",
+				},
+				cli.StringFlag{
+					Name:  "proof, f",
+					Value: "",
+					Usage: " offline proof `FILE` holding a Transaction.InclusionProof reply",
+				},
+				cli.StringFlag{
+					Name:  "digest, d",
+					Value: "",
+					Usage: " trusted block header `DIGEST` the proof must match",
+				},
+				cli.Uint64Flag{
+					Name:  "block, b",
+					Value: 0,
+					Usage: " block `NUMBER` of an asset or block foundation record",
+				},
+			},
+			Action: runVerifyInclusion,
+		},
 		{
 			Name:  "list",
 			Usage: "list bitmark-cli identities",
@@ -468,7 +552,81 @@ func main() {
 					Usage: "display recovery phrase",
 				},
 			},
+			Before: func(c *cli.Context) error {
+				// subcommands run in their own app
+				c.App.Metadata = c.Parent().App.Metadata
+				return nil
+			},
 			Action: runSeed,
+			Subcommands: []cli.Command{
+				{
+					Name:      "split",
+					Usage:     "split an identity's seed into SLIP-0039 recovery shares",
+					ArgsUsage: "\n   (* = required)",
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "threshold, t",
+							Value: 0,
+							Usage: "*number of shares `M` needed to recover the seed",
+						},
+						cli.IntFlag{
+							Name:  "count, n",
+							Value: 0,
+							Usage: "*number of shares `N` to create, at most 16",
+						},
+						cli.StringFlag{
+							Name:  "passphrase, P",
+							Value: "",
+							Usage: " optional `PASSPHRASE` needed with the shares",
+						},
+					},
+					Action: runSeedSplit,
+				},
+				{
+					Name:      "combine",
+					Usage:     "recover a seed from SLIP-0039 recovery shares",
+					ArgsUsage: "\n   (+ = at least one)",
+					Flags: []cli.Flag{
+						cli.StringSliceFlag{
+							Name:  "share, s",
+							Usage: "+recovery share `WORDS`, repeat for each share",
+						},
+						cli.StringFlag{
+							Name:  "file, f",
+							Value: "",
+							Usage: "+`FILE` of recovery shares, one on each line",
+						},
+						cli.StringFlag{
+							Name:  "passphrase, P",
+							Value: "",
+							Usage: " `PASSPHRASE` given when the shares were made",
+						},
+					},
+					Action: runSeedCombine,
+				},
+			},
+		},
+		{
+			Name:  "derive",
+			Usage: "list the accounts derived from an identity's seed",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "path, P",
+					Value: "m",
+					Usage: " hardened derivation `PATH` of the parent e.g. m/44'",
+				},
+				cli.IntFlag{
+					Name:  "start, s",
+					Value: 0,
+					Usage: " first child index `N`",
+				},
+				cli.IntFlag{
+					Name:  "count, c",
+					Value: 10,
+					Usage: " number of accounts `COUNT`",
+				},
+			},
+			Action: runDerive,
 		},
 		{
 			Name:   "password",
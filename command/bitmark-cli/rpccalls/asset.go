@@ -6,6 +6,7 @@
 package rpccalls
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/configuration"
@@ -42,8 +43,8 @@ func (client *Client) MakeAsset(assetConfig *AssetData) (*AssetResult, error) {
 
 	client.printJson("Asset Get Request", getArgs)
 
-	var getReply assets.GetReply
-	if err := client.client.Call("Assets.Get", &getArgs, &getReply); err != nil {
+	getReply, err := client.client.GetAssets(context.Background(), &getArgs)
+	if err != nil {
 		return nil, err
 	}
 
@@ -86,6 +87,32 @@ func (client *Client) MakeAsset(assetConfig *AssetData) (*AssetResult, error) {
 
 	client.printJson("Asset Get Reply", getReply)
 
+	r, err := makeAsset(assetConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	client.printJson("Asset Request", r)
+
+	args := bitmarks.CreateArguments{
+		Assets: []*transactionrecord.AssetData{r},
+		Issues: nil,
+	}
+
+	reply, err := client.client.Issue(context.Background(), &args)
+	if err != nil {
+		return nil, err
+	}
+
+	client.printJson("Asset Reply", reply)
+
+	result.AssetId = reply.Assets[0].AssetId
+	return result, nil
+}
+
+// build a properly signed asset record
+func makeAsset(assetConfig *AssetData) (*transactionrecord.AssetData, error) {
+
 	registrant := assetConfig.Registrant.PrivateKey.Account()
 	r := transactionrecord.AssetData{
 		Name:        assetConfig.Name,
@@ -97,7 +124,9 @@ func (client *Client) MakeAsset(assetConfig *AssetData) (*AssetResult, error) {
 
 	// pack without signature
 	packed, err := r.Pack(registrant)
-	if fault.InvalidSignature != err {
+	if err == nil {
+		return nil, fault.MakeAssetFailed
+	} else if fault.InvalidSignature != err {
 		return nil, err
 	}
 
@@ -108,21 +137,5 @@ func (client *Client) MakeAsset(assetConfig *AssetData) (*AssetResult, error) {
 	if _, err = r.Pack(registrant); err != nil {
 		return nil, err
 	}
-
-	client.printJson("Asset Request", r)
-
-	args := bitmarks.CreateArguments{
-		Assets: []*transactionrecord.AssetData{&r},
-		Issues: nil,
-	}
-
-	var reply bitmarks.CreateReply
-	if err := client.client.Call("Bitmarks.Create", &args, &reply); err != nil {
-		return nil, err
-	}
-
-	client.printJson("Asset Reply", reply)
-
-	result.AssetId = reply.Assets[0].AssetId
-	return result, nil
+	return &r, nil
 }
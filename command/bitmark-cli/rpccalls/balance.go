@@ -6,6 +6,8 @@
 package rpccalls
 
 import (
+	"context"
+
 	"github.com/bitmark-inc/bitmarkd/account"
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/rpc/share"
@@ -36,8 +38,7 @@ func (client *Client) GetBalance(balanceConfig *BalanceData) (*share.BalanceRepl
 
 	client.printJson("Balance Request", balanceArgs)
 
-	reply := &share.BalanceReply{}
-	err := client.client.Call("Share.Balance", balanceArgs, reply)
+	reply, err := client.client.ShareBalance(context.Background(), &balanceArgs)
 	if err != nil {
 		return nil, err
 	}
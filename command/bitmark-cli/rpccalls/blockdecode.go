@@ -6,6 +6,8 @@
 package rpccalls
 
 import (
+	"context"
+
 	"github.com/bitmark-inc/bitmarkd/rpc/node"
 )
 
@@ -23,8 +25,7 @@ func (client *Client) DecodeBlock(blockDecodeConfig *BlockDecodeData) (*node.Blo
 
 	client.printJson("BlockDecode Request", blockDecodeArgs)
 
-	reply := &node.BlockDecodeReply{}
-	err := client.client.Call("Node.BlockDecode", blockDecodeArgs, reply)
+	reply, err := client.client.BlockDecode(context.Background(), &blockDecodeArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,8 @@
 package rpccalls
 
 import (
+	"context"
+
 	"github.com/bitmark-inc/bitmarkd/rpc/node"
 )
 
@@ -27,8 +29,7 @@ func (client *Client) GetBlocks(blockDumpConfig *BlockDumpData) (*node.BlockDump
 
 	client.printJson("BlockDump Request", blockDumpArgs)
 
-	reply := &node.BlockDumpRangeReply{}
-	err := client.client.Call("Node.BlockDumpRange", blockDumpArgs, reply)
+	reply, err := client.client.BlockDumpRange(context.Background(), &blockDumpArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,7 @@
 package rpccalls
 
 import (
+	"context"
 	"encoding/hex"
 
 	"github.com/bitmark-inc/bitmarkd/account"
@@ -14,7 +15,6 @@ import (
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/pay"
-	"github.com/bitmark-inc/bitmarkd/rpc/blockowner"
 	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 	"golang.org/x/crypto/ed25519"
 )
@@ -71,8 +71,7 @@ func (client *Client) SingleSignedBlockTransfer(blockTransferConfig *BlockTransf
 // CountersignBlockTransfer - perform a transfer
 func (client *Client) CountersignBlockTransfer(blockTransfer *transactionrecord.BlockOwnerTransfer) (*BlockTransferReply, error) {
 
-	var reply blockowner.TransferReply
-	err := client.client.Call("BlockOwner.Transfer", blockTransfer, &reply)
+	reply, err := client.client.BlockOwnerTransfer(context.Background(), blockTransfer)
 	if err != nil {
 		return nil, err
 	}
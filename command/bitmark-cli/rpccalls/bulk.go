@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpccalls
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/configuration"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/bitmark-inc/bitmarkd/rpc/bitmarks"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// MaximumBatch - largest number of assets or issues in one request
+const MaximumBatch = reservoir.MaximumIssues
+
+// RegisterAssets - register a batch of assets, returning their ids
+// in the same order
+func (client *Client) RegisterAssets(assetConfigs []*AssetData) ([]*transactionrecord.AssetIdentifier, error) {
+
+	if len(assetConfigs) > MaximumBatch {
+		return nil, fault.TooManyItemsToProcess
+	}
+
+	records := make([]*transactionrecord.AssetData, len(assetConfigs))
+	for i, assetConfig := range assetConfigs {
+		r, err := makeAsset(assetConfig)
+		if err != nil {
+			return nil, err
+		}
+		records[i] = r
+	}
+
+	client.printJson("Assets Request", records)
+
+	args := bitmarks.CreateArguments{
+		Assets: records,
+		Issues: nil,
+	}
+
+	reply, err := client.client.Issue(context.Background(), &args)
+	if err != nil {
+		return nil, err
+	}
+
+	client.printJson("Assets Reply", reply)
+
+	assetIds := make([]*transactionrecord.AssetIdentifier, len(reply.Assets))
+	for i, status := range reply.Assets {
+		assetIds[i] = status.AssetId
+	}
+	return assetIds, nil
+}
+
+// FreeIssues - issue the free zero nonce bitmark of each asset and
+// solve the proof for the whole batch
+func (client *Client) FreeIssues(issuer *configuration.Private, assetIds []*transactionrecord.AssetIdentifier) (*IssueReply, error) {
+
+	if len(assetIds) > MaximumBatch {
+		return nil, fault.TooManyItemsToProcess
+	}
+
+	issues := make([]*transactionrecord.BitmarkIssue, len(assetIds))
+	for i, assetId := range assetIds {
+		issueConfig := &IssueData{
+			Issuer:    issuer,
+			AssetId:   assetId,
+			Quantity:  1,
+			FreeIssue: true,
+		}
+		issue, err := makeIssue(client.testnet, issueConfig, 0)
+		if err != nil {
+			return nil, err
+		}
+		if issue == nil {
+			return nil, fault.MakeIssueFailed
+		}
+		issues[i] = issue
+	}
+
+	return client.submitIssues(issues)
+}
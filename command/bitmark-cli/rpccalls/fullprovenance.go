@@ -6,6 +6,8 @@
 package rpccalls
 
 import (
+	"context"
+
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
 )
@@ -41,8 +43,7 @@ func (client *Client) GetFullProvenance(fullProvenanceConfig *FullProvenanceData
 
 	client.printJson("Full Provenance Request", fullProvenanceArgs)
 
-	var reply bitmark.FullProvenanceReply
-	err := client.client.Call("Bitmark.FullProvenance", fullProvenanceArgs, &reply)
+	reply, err := client.client.FullProvenance(context.Background(), &fullProvenanceArgs)
 	if err != nil {
 		return nil, err
 	}
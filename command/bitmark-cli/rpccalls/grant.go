@@ -6,6 +6,7 @@
 package rpccalls
 
 import (
+	"context"
 	"encoding/hex"
 
 	"github.com/bitmark-inc/bitmarkd/account"
@@ -13,7 +14,6 @@ import (
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/pay"
-	"github.com/bitmark-inc/bitmarkd/rpc/share"
 	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 	"golang.org/x/crypto/ed25519"
 )
@@ -87,8 +87,7 @@ func (client *Client) CountersignGrant(grant *transactionrecord.ShareGrant) (*Gr
 
 	client.printJson("Grant Request", grant)
 
-	var reply share.GrantReply
-	err := client.client.Call("Share.Grant", grant, &reply)
+	reply, err := client.client.GrantShares(context.Background(), grant)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpccalls
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
+)
+
+// InclusionProofData - request data for inclusion proof
+type InclusionProofData struct {
+	TxId        string
+	BlockNumber uint64
+}
+
+// GetInclusionProof - fetch the merkle proof of a confirmed transaction
+func (client *Client) GetInclusionProof(proofConfig *InclusionProofData) (*transaction.InclusionProofReply, error) {
+
+	var txId merkle.Digest
+	err := txId.UnmarshalText([]byte(proofConfig.TxId))
+	if err != nil {
+		return nil, err
+	}
+
+	proofArgs := transaction.InclusionProofArguments{
+		TxId:        txId,
+		BlockNumber: proofConfig.BlockNumber,
+	}
+
+	client.printJson("Inclusion Proof Request", proofArgs)
+
+	reply, err := client.client.InclusionProof(context.Background(), &proofArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	client.printJson("Inclusion Proof Reply", reply)
+
+	return reply, nil
+}
@@ -6,23 +6,20 @@
 package rpccalls
 
 import (
+	"context"
+
 	"github.com/bitmark-inc/bitmarkd/rpc/node"
 )
 
 // GetBitmarkInfo - request status from bitmarkd (must be matching version)
 func (client *Client) GetBitmarkInfo() (*node.InfoReply, error) {
-	var reply node.InfoReply
-	if err := client.client.Call("Node.Info", node.InfoArguments{}, &reply); err != nil {
-		return nil, err
-	}
-
-	return &reply, nil
+	return client.client.Info(context.Background())
 }
 
 // GetBitmarkInfoCompat - request status from bitmarkd (any version)
 func (client *Client) GetBitmarkInfoCompat() (map[string]interface{}, error) {
 	var reply map[string]interface{}
-	if err := client.client.Call("Node.Info", node.InfoArguments{}, &reply); err != nil {
+	if err := client.client.Call(context.Background(), "Node.Info", node.InfoArguments{}, &reply); err != nil {
 		return nil, err
 	}
 
@@ -6,6 +6,7 @@
 package rpccalls
 
 import (
+	"context"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
@@ -29,6 +30,7 @@ type IssueData struct {
 	AssetId   *transactionrecord.AssetIdentifier
 	Quantity  int
 	FreeIssue bool
+	Nonce     uint64 // first nonce of a paid issue, zero to use the time
 }
 
 // IssueReply - JSON data to output after asset/issue/proof completes
@@ -51,14 +53,16 @@ func (client *Client) Issue(issueConfig *IssueData) (*IssueReply, error) {
 		return nil, fmt.Errorf("quantity: %d > 1 is not allowed for free", issueConfig.Quantity)
 	}
 
-	nonce := time.Now().UTC().Unix() * 1000
+	nonce := issueConfig.Nonce
 	if issueConfig.FreeIssue {
 		nonce = 0 // only the zero nonce is allowed for free issue
+	} else if 0 == nonce {
+		nonce = uint64(time.Now().UTC().Unix() * 1000)
 	}
 
 	issues := make([]*transactionrecord.BitmarkIssue, issueConfig.Quantity)
 	for i := 0; i < len(issues); i += 1 {
-		issue, err := makeIssue(client.testnet, issueConfig, uint64(nonce)+uint64(i))
+		issue, err := makeIssue(client.testnet, issueConfig, nonce+uint64(i))
 		if err != nil {
 			return nil, err
 		}
@@ -68,6 +72,13 @@ func (client *Client) Issue(issueConfig *IssueData) (*IssueReply, error) {
 		issues[i] = issue
 	}
 
+	return client.submitIssues(issues)
+}
+
+// send issues then either solve the proof for free issues or
+// return the payment commands
+func (client *Client) submitIssues(issues []*transactionrecord.BitmarkIssue) (*IssueReply, error) {
+
 	client.printJson("Issue Request", issues)
 
 	issuesArgs := bitmarks.CreateArguments{
@@ -75,8 +86,8 @@ func (client *Client) Issue(issueConfig *IssueData) (*IssueReply, error) {
 		Issues: issues,
 	}
 
-	var issuesReply bitmarks.CreateReply
-	if err := client.client.Call("Bitmarks.Create", issuesArgs, &issuesReply); err != nil {
+	issuesReply, err := client.client.Issue(context.Background(), &issuesArgs)
+	if err != nil {
 		return nil, err
 	}
 
@@ -118,8 +129,8 @@ func (client *Client) Issue(issueConfig *IssueData) (*IssueReply, error) {
 
 		client.printJson("Proof Request", proofArgs)
 
-		var proofReply bitmarks.ProofReply
-		if err := client.client.Call("Bitmarks.Proof", &proofArgs, &proofReply); err != nil {
+		proofReply, err := client.client.IssueProof(context.Background(), &proofArgs)
+		if err != nil {
 			return nil, err
 		}
 
@@ -6,6 +6,8 @@
 package rpccalls
 
 import (
+	"context"
+
 	"github.com/bitmark-inc/bitmarkd/account"
 	"github.com/bitmark-inc/bitmarkd/rpc/owner"
 )
@@ -28,8 +30,7 @@ func (client *Client) GetOwned(ownedConfig *OwnedData) (*owner.BitmarksReply, er
 
 	client.printJson("Owned Request", ownedArgs)
 
-	reply := &owner.BitmarksReply{}
-	err := client.client.Call("Owner.Bitmarks", ownedArgs, reply)
+	reply, err := client.client.Owned(context.Background(), &ownedArgs)
 	if err != nil {
 		return nil, err
 	}
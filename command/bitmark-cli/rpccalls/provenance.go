@@ -6,6 +6,8 @@
 package rpccalls
 
 import (
+	"context"
+
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
 )
@@ -43,8 +45,7 @@ func (client *Client) GetProvenance(provenanceConfig *ProvenanceData) (*Provenan
 
 	client.printJson("Provenance Request", provenanceArgs)
 
-	var reply bitmark.ProvenanceReply
-	err := client.client.Call("Bitmark.Provenance", provenanceArgs, &reply)
+	reply, err := client.client.Provenance(context.Background(), &provenanceArgs)
 	if err != nil {
 		return nil, err
 	}
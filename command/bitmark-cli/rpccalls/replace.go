@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package rpccalls
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/configuration"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+	"golang.org/x/crypto/ed25519"
+)
+
+// ReplaceData - data for a replace request
+type ReplaceData struct {
+	Owner    *configuration.Private
+	NewOwner *account.Account
+	TxId     string
+	Pending  string
+}
+
+// Replace - replace an unpaid pending transfer with a new unratified transfer
+func (client *Client) Replace(replaceConfig *ReplaceData) (*TransferReply, error) {
+
+	var link merkle.Digest
+	err := link.UnmarshalText([]byte(replaceConfig.TxId))
+	if err != nil {
+		return nil, err
+	}
+
+	var pending merkle.Digest
+	err = pending.UnmarshalText([]byte(replaceConfig.Pending))
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := makeTransferUnratified(client.testnet, link, replaceConfig.Owner, replaceConfig.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+	if transfer == nil {
+		return nil, fault.MakeTransferFailed
+	}
+
+	packed, err := transfer.Pack(replaceConfig.Owner.PrivateKey.Account())
+	if err != nil {
+		return nil, err
+	}
+
+	message := reservoir.ReplacementMessage(pending, packed.MakeLink())
+	signature := ed25519.Sign(replaceConfig.Owner.PrivateKey.PrivateKeyBytes(), message)
+
+	unratified := transfer.(*transactionrecord.BitmarkTransferUnratified)
+	replaceArgs := bitmark.ReplaceArguments{
+		TxId: pending,
+		Transfer: &transactionrecord.BitmarkTransferCountersigned{
+			Link:      unratified.Link,
+			Escrow:    unratified.Escrow,
+			Owner:     unratified.Owner,
+			Signature: unratified.Signature,
+		},
+		Signature: signature,
+	}
+
+	client.printJson("Replace Request", replaceArgs)
+
+	reply, err := client.client.Replace(context.Background(), &replaceArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	tpid, err := reply.PayId.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	commands := make(map[string]string)
+	for _, payment := range reply.Payments {
+		currency := payment[0].Currency
+		commands[currency.String()] = paymentCommand(client.testnet, currency, string(tpid), payment)
+	}
+
+	client.printJson("Replace Reply", reply)
+
+	// make response
+	response := TransferReply{
+		TransferId: reply.TxId,
+		BitmarkId:  reply.BitmarkId,
+		PayId:      reply.PayId,
+		Payments:   reply.Payments,
+		Commands:   commands,
+	}
+
+	return &response, nil
+}
@@ -6,17 +6,14 @@
 package rpccalls
 
 import (
-	"crypto/tls"
 	"io"
-	"net"
-	"net/rpc"
-	"net/rpc/jsonrpc"
+
+	"github.com/bitmark-inc/bitmarkd/sdk"
 )
 
 // Client - to hold RPC connections streams
 type Client struct {
-	conn    net.Conn
-	client  *rpc.Client
+	client  *sdk.Client
 	testnet bool
 	verbose bool
 	handle  io.Writer // if verbose is set output items here
@@ -25,18 +22,19 @@ type Client struct {
 // NewClient - create a RPC connection to a bitmarkd
 func NewClient(testnet bool, connect string, verbose bool, handle io.Writer) (*Client, error) {
 
-	tlsConfig := &tls.Config{
-		InsecureSkipVerify: true,
-	}
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes: []string{connect},
 
-	conn, err := tls.Dial("tcp", connect, tlsConfig)
+		// bitmarkd certificates are self-signed and the
+		// configuration has no fingerprint to pin
+		Insecure: true,
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	r := &Client{
-		conn:    conn,
-		client:  jsonrpc.NewClient(conn),
+		client:  client,
 		testnet: testnet,
 		verbose: verbose,
 		handle:  handle,
@@ -46,6 +44,5 @@ func NewClient(testnet bool, connect string, verbose bool, handle io.Writer) (*C
 
 // Close - shutdown the bitmarkd connection
 func (c *Client) Close() {
-	c.client.Close()
-	c.conn.Close()
+	_ = c.client.Close()
 }
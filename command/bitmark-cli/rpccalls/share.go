@@ -6,11 +6,12 @@
 package rpccalls
 
 import (
+	"context"
+
 	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/configuration"
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/pay"
-	"github.com/bitmark-inc/bitmarkd/rpc/share"
 	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 	"golang.org/x/crypto/ed25519"
 )
@@ -51,8 +52,7 @@ func (client *Client) Share(shareConfig *ShareData) (*ShareReply, error) {
 
 	client.printJson("Share Request", sh)
 
-	var reply share.CreateReply
-	err = client.client.Call("Share.Create", sh, &reply)
+	reply, err := client.client.CreateShare(context.Background(), sh)
 	if err != nil {
 		return nil, err
 	}
@@ -6,6 +6,8 @@
 package rpccalls
 
 import (
+	"context"
+
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
 )
@@ -30,13 +32,12 @@ func (client *Client) GetTransactionStatus(statusConfig *TransactionStatusData)
 
 	client.printJson("Status Request", statusArgs)
 
-	var reply transaction.StatusReply
-	err = client.client.Call("Transaction.Status", statusArgs, &reply)
+	reply, err := client.client.Status(context.Background(), &statusArgs)
 	if err != nil {
 		return nil, err
 	}
 
 	client.printJson("Status Reply", reply)
 
-	return &reply, nil
+	return reply, nil
 }
@@ -6,6 +6,7 @@
 package rpccalls
 
 import (
+	"context"
 	"encoding/hex"
 
 	"github.com/bitmark-inc/bitmarkd/account"
@@ -13,7 +14,6 @@ import (
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/pay"
-	"github.com/bitmark-inc/bitmarkd/rpc/share"
 	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 	"golang.org/x/crypto/ed25519"
 )
@@ -95,8 +95,7 @@ func (client *Client) CountersignSwap(swap *transactionrecord.ShareSwap) (*SwapR
 
 	client.printJson("Swap Request", swap)
 
-	var reply share.SwapReply
-	err := client.client.Call("Share.Swap", swap, &reply)
+	reply, err := client.client.SwapShares(context.Background(), swap)
 	if err != nil {
 		return nil, err
 	}
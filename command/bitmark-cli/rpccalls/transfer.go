@@ -6,6 +6,7 @@
 package rpccalls
 
 import (
+	"context"
 	"encoding/hex"
 
 	"github.com/bitmark-inc/bitmarkd/account"
@@ -13,7 +14,6 @@ import (
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/pay"
-	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
 	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 	"golang.org/x/crypto/ed25519"
 )
@@ -65,8 +65,7 @@ func (client *Client) Transfer(transferConfig *TransferData) (*TransferReply, er
 
 	client.printJson("Transfer Request", transfer)
 
-	var reply bitmark.TransferReply
-	err = client.client.Call("Bitmark.Transfer", transfer, &reply)
+	reply, err := client.client.Transfer(context.Background(), transfer)
 	if err != nil {
 		return nil, err
 	}
@@ -128,8 +127,7 @@ func (client *Client) CountersignTransfer(transfer *transactionrecord.BitmarkTra
 
 	client.printJson("Transfer Request", transfer)
 
-	var reply bitmark.TransferReply
-	err := client.client.Call("Bitmark.Transfer", transfer, &reply)
+	reply, err := client.client.Transfer(context.Background(), transfer)
 	if err != nil {
 		return nil, err
 	}
@@ -30,6 +30,8 @@ func runAdd(c *cli.Context) error {
 	seed := c.String("seed")
 	newSeed := c.Bool("new")
 	acc := c.String("account")
+	from := c.String("from")
+	derive := c.String("derive")
 
 	if m.verbose {
 		fmt.Fprintf(m.e, "identity: %s\n", name)
@@ -37,12 +39,26 @@ func runAdd(c *cli.Context) error {
 		fmt.Fprintf(m.e, "seed: %s\n", seed)
 		fmt.Fprintf(m.e, "account: %s\n", acc)
 		fmt.Fprintf(m.e, "new: %t\n", newSeed)
+		fmt.Fprintf(m.e, "from: %s\n", from)
+		fmt.Fprintf(m.e, "derive: %s\n", derive)
+	}
+
+	if from != "" && (derive == "" || seed != "" || newSeed || acc != "") {
+		return fault.IncompatibleOptions
 	}
 
 	if acc == "" {
-		seed, err = checkSeed(seed, newSeed, m.testnet)
-		if err != nil {
-			return err
+		if from != "" {
+			_, source, err := checkOwnerWithPasswordPrompt(from, m.config, c)
+			if err != nil {
+				return err
+			}
+			seed = source.Seed
+		} else {
+			seed, err = checkSeed(seed, newSeed, m.testnet)
+			if err != nil {
+				return err
+			}
 		}
 
 		password := c.GlobalString("password")
@@ -53,12 +69,12 @@ func runAdd(c *cli.Context) error {
 			}
 		}
 
-		err = m.config.AddIdentity(name, description, seed, password)
+		err = m.config.AddDerivedIdentity(name, description, seed, derive, password)
 		if err != nil {
 			return err
 		}
 
-	} else if seed == "" && acc != "" && !newSeed {
+	} else if seed == "" && acc != "" && !newSeed && derive == "" {
 		err = m.config.AddReceiveOnlyIdentity(name, description, acc)
 		if err != nil {
 			return err
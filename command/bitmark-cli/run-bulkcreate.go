@@ -0,0 +1,360 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/bulk"
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/configuration"
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/rpccalls"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// state shared by the steps of a bulk create
+type bulkCreate struct {
+	client     *rpccalls.Client
+	issuer     *configuration.Private
+	progress   *bulk.Progress
+	entries    []*bulk.Entry
+	recipients []*account.Account
+	results    []*bulk.Result
+}
+
+func runBulkCreate(c *cli.Context) error {
+
+	m := c.App.Metadata["config"].(*metadata)
+
+	manifestFile, err := checkFileName(c.String("manifest"))
+	if err != nil {
+		return err
+	}
+
+	progressFile := c.String("progress")
+	if progressFile == "" {
+		progressFile = manifestFile + ".progress"
+	}
+
+	entries, err := bulk.ReadManifest(manifestFile)
+	if err != nil {
+		return err
+	}
+
+	// check everything before sending any request
+	recipients := make([]*account.Account, len(entries))
+	fingerprints := make(map[string]int)
+	for i, entry := range entries {
+		if entry.File != "" {
+			entry.Fingerprint, err = fingerprintFile(entry.File)
+			if err != nil {
+				return err
+			}
+		}
+		if n, ok := fingerprints[entry.Fingerprint]; ok {
+			return fmt.Errorf("entry: %d: same fingerprint as entry: %d", i+1, n)
+		}
+		fingerprints[entry.Fingerprint] = i + 1
+
+		entry.Metadata, err = checkAssetMetadata(entry.Metadata)
+		if err != nil {
+			return fmt.Errorf("entry: %d: %w", i+1, err)
+		}
+
+		if entry.Recipient != "" {
+			recipients[i], err = m.config.Account(entry.Recipient)
+			if err != nil {
+				return fmt.Errorf("entry: %d: %w", i+1, err)
+			}
+		}
+	}
+
+	progress, err := bulk.LoadProgress(progressFile)
+	if err != nil {
+		return err
+	}
+
+	name, issuer, err := checkOwnerWithPasswordPrompt(c.GlobalString("identity"), m.config, c)
+	if err != nil {
+		return err
+	}
+
+	if m.verbose {
+		fmt.Fprintf(m.e, "issuer: %s\n", name)
+		fmt.Fprintf(m.e, "manifest: %s\n", manifestFile)
+		fmt.Fprintf(m.e, "progress: %s\n", progressFile)
+		fmt.Fprintf(m.e, "entries: %d\n", len(entries))
+	}
+
+	client, err := rpccalls.NewClient(m.testnet, m.config.Connections[m.connectionOffset], m.verbose, m.e)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	b := &bulkCreate{
+		client:     client,
+		issuer:     issuer,
+		progress:   progress,
+		entries:    entries,
+		recipients: recipients,
+		results:    make([]*bulk.Result, len(entries)),
+	}
+	for i, entry := range entries {
+		b.results[i] = progress.Result(entry.Name, entry.Fingerprint)
+		b.results[i].Recipient = entry.Recipient
+		b.results[i].Error = ""
+	}
+
+	for _, step := range []func() error{b.register, b.freeIssue, b.paidIssue, b.transfer} {
+		err := step()
+		if err != nil {
+			return err
+		}
+	}
+
+	if reportFile := c.String("report"); reportFile != "" {
+		err := bulk.WriteReport(reportFile, b.results)
+		if err != nil {
+			return err
+		}
+	} else {
+		printJson(m.w, b.results)
+	}
+
+	incomplete := 0
+	for _, result := range b.results {
+		if result.Error != "" {
+			incomplete += 1
+		}
+	}
+	if incomplete > 0 {
+		return fmt.Errorf("%d of %d entries incomplete: run again to resume", incomplete, len(b.results))
+	}
+	return nil
+}
+
+// register the assets not yet registered
+func (b *bulkCreate) register() error {
+	pending := b.pending(func(i int) bool {
+		return b.results[i].AssetId == ""
+	})
+
+	for _, batch := range batches(pending) {
+		assetConfigs := make([]*rpccalls.AssetData, len(batch))
+		for j, i := range batch {
+			assetConfigs[j] = &rpccalls.AssetData{
+				Name:        b.entries[i].Name,
+				Metadata:    b.entries[i].Metadata,
+				Quantity:    b.entries[i].Quantity,
+				Registrant:  b.issuer,
+				Fingerprint: b.entries[i].Fingerprint,
+			}
+		}
+
+		assetIds, err := b.client.RegisterAssets(assetConfigs)
+		for j, i := range batch {
+			if err != nil {
+				b.results[i].Error = err.Error()
+				continue
+			}
+			text, _ := assetIds[j].MarshalText()
+			b.results[i].AssetId = string(text)
+		}
+
+		if err := b.progress.Save(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// single issues use the free zero nonce and are batched across
+// assets with one proof for each batch
+func (b *bulkCreate) freeIssue() error {
+	pending := b.pending(func(i int) bool {
+		return b.entries[i].Quantity == 1 && b.results[i].AssetId != "" && !b.results[i].Issued(1)
+	})
+
+	for _, batch := range batches(pending) {
+		assetIds := make([]*transactionrecord.AssetIdentifier, len(batch))
+		for j, i := range batch {
+			assetId, err := b.assetId(i)
+			if err != nil {
+				return err
+			}
+			assetIds[j] = assetId
+		}
+
+		reply, err := b.client.FreeIssues(b.issuer, assetIds)
+		for j, i := range batch {
+			if err != nil {
+				b.results[i].Error = err.Error()
+				continue
+			}
+			text, _ := reply.IssueIds[j].MarshalText()
+			b.results[i].IssueIds = []string{string(text)}
+			b.results[i].ProofStatus = reply.ProofStatus.String()
+			if len(reply.Commands) > 0 {
+				b.results[i].Payments = append(b.results[i].Payments, payment(reply.PayId, reply.Commands))
+			}
+		}
+
+		if err := b.progress.Save(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// multiple issues of one asset need payment, and the asset must
+// already be confirmed
+func (b *bulkCreate) paidIssue() error {
+	pending := b.pending(func(i int) bool {
+		return b.entries[i].Quantity > 1 && b.results[i].AssetId != "" && !b.results[i].Issued(b.entries[i].Quantity)
+	})
+
+	// the nonces of each entry follow from a base saved before any
+	// issue is sent, so a resumed run makes the same issues again
+	// instead of new ones
+	base := uint64(time.Now().UTC().UnixNano())
+	saved := false
+	for _, i := range pending {
+		if b.results[i].NonceBase == 0 {
+			b.results[i].NonceBase = base
+			saved = true
+		}
+	}
+	if saved {
+		if err := b.progress.Save(); err != nil {
+			return err
+		}
+	}
+
+	for _, i := range pending {
+		result := b.results[i]
+
+		assetId, err := b.assetId(i)
+		if err != nil {
+			return err
+		}
+
+		for !result.Issued(b.entries[i].Quantity) {
+			quantity := b.entries[i].Quantity - len(result.IssueIds)
+			if quantity > rpccalls.MaximumBatch {
+				quantity = rpccalls.MaximumBatch
+			}
+
+			issueConfig := &rpccalls.IssueData{
+				Issuer:    b.issuer,
+				AssetId:   assetId,
+				Quantity:  quantity,
+				FreeIssue: false,
+				Nonce:     result.NonceBase + uint64(len(result.IssueIds)),
+			}
+			reply, err := b.client.Issue(issueConfig)
+			if err != nil {
+				result.Error = err.Error()
+				break
+			}
+
+			for _, txId := range reply.IssueIds {
+				text, _ := txId.MarshalText()
+				result.IssueIds = append(result.IssueIds, string(text))
+			}
+			result.Payments = append(result.Payments, payment(reply.PayId, reply.Commands))
+
+			if err := b.progress.Save(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// transfer issues to their recipients, which can only be done once
+// the issues are confirmed
+func (b *bulkCreate) transfer() error {
+	pending := b.pending(func(i int) bool {
+		return b.results[i].Issued(b.entries[i].Quantity) && !b.results[i].Transferred()
+	})
+
+	for _, i := range pending {
+		result := b.results[i]
+
+		for !result.Transferred() {
+			transferConfig := &rpccalls.TransferData{
+				Owner:    b.issuer,
+				NewOwner: b.recipients[i],
+				TxId:     result.IssueIds[len(result.TransferIds)],
+			}
+			reply, err := b.client.Transfer(transferConfig)
+			if err != nil && fault.LinkToInvalidOrUnconfirmedTransaction.Error() == err.Error() {
+				result.Error = "waiting for issue confirmation"
+				break
+			} else if err != nil {
+				result.Error = err.Error()
+				break
+			}
+
+			text, _ := reply.TransferId.MarshalText()
+			result.TransferIds = append(result.TransferIds, string(text))
+			result.Payments = append(result.Payments, payment(reply.PayId, reply.Commands))
+
+			if err := b.progress.Save(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexes of entries needing a step and without an earlier failure
+func (b *bulkCreate) pending(needed func(i int) bool) []int {
+	indexes := make([]int, 0, len(b.entries))
+	for i := range b.entries {
+		if b.results[i].Error == "" && needed(i) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+func (b *bulkCreate) assetId(i int) (*transactionrecord.AssetIdentifier, error) {
+	assetId := &transactionrecord.AssetIdentifier{}
+	err := assetId.UnmarshalText([]byte(b.results[i].AssetId))
+	if err != nil {
+		return nil, err
+	}
+	return assetId, nil
+}
+
+// split into batches the server accepts
+func batches(indexes []int) [][]int {
+	result := make([][]int, 0, len(indexes)/rpccalls.MaximumBatch+1)
+	for len(indexes) > 0 {
+		n := len(indexes)
+		if n > rpccalls.MaximumBatch {
+			n = rpccalls.MaximumBatch
+		}
+		result = append(result, indexes[:n])
+		indexes = indexes[n:]
+	}
+	return result
+}
+
+func payment(payId pay.PayId, commands map[string]string) bulk.Payment {
+	text, _ := payId.MarshalText()
+	return bulk.Payment{
+		PayId:    string(text),
+		Commands: commands,
+	}
+}
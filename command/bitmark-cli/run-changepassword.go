@@ -25,7 +25,7 @@ func runChangePassword(c *cli.Context) error {
 		return err
 	}
 
-	err = m.config.AddIdentity(name, owner.Description, owner.Seed, newPassword)
+	err = m.config.AddDerivedIdentity(name, owner.Description, owner.Seed, owner.Path, newPassword)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+type derivedAccount struct {
+	Path    string `json:"path"`
+	Account string `json:"account"`
+}
+
+// list the accounts at consecutive indices below a path of an
+// identity's seed
+func runDerive(c *cli.Context) error {
+
+	m := c.App.Metadata["config"].(*metadata)
+
+	path, err := account.ParsePath(c.String("path"))
+	if err != nil {
+		return err
+	}
+
+	start := c.Int("start")
+	count := c.Int("count")
+	if start < 0 || count <= 0 || uint64(start)+uint64(count) > 0x80000000 {
+		return fault.InvalidCount
+	}
+
+	if m.verbose {
+		fmt.Fprintf(m.e, "path: %s\n", path)
+		fmt.Fprintf(m.e, "start: %d\n", start)
+		fmt.Fprintf(m.e, "count: %d\n", count)
+	}
+
+	_, owner, err := checkOwnerWithPasswordPrompt(c.GlobalString("identity"), m.config, c)
+	if err != nil {
+		return err
+	}
+
+	result := make([]derivedAccount, count)
+	for i := range result {
+		child := path.Child(uint32(start + i))
+		privateKey, err := account.DerivePrivateKeyFromBase58Seed(owner.Seed, child)
+		if err != nil {
+			return err
+		}
+		result[i].Path = child.String()
+		result[i].Account = privateKey.Account().String()
+	}
+
+	printJson(m.w, result)
+	return nil
+}
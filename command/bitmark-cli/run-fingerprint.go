@@ -32,19 +32,11 @@ func runFingerprint(c *cli.Context) error {
 		fmt.Fprintf(m.e, "checksumming file: %s\n", fileName)
 	}
 
-	file, err := os.Open(fileName)
-	if err != nil {
-		return err
-	}
-
-	data, err := ioutil.ReadAll(file)
+	strFP, err := fingerprintFile(fileName)
 	if err != nil {
 		return err
 	}
 
-	fingerprint := sha3.Sum512(data)
-	strFP := fmt.Sprintf("%02x%x", fingerprintVersion, fingerprint)
-
 	if m.verbose {
 		fmt.Fprintf(m.e, "fingerprint: %s\n", strFP)
 	} else {
@@ -59,3 +51,21 @@ func runFingerprint(c *cli.Context) error {
 	}
 	return nil
 }
+
+// compute the asset fingerprint of a file
+func fingerprintFile(fileName string) (string, error) {
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return "", err
+	}
+
+	fingerprint := sha3.Sum512(data)
+	return fmt.Sprintf("%02x%x", fingerprintVersion, fingerprint), nil
+}
@@ -38,6 +38,7 @@ func runList(c *cli.Context) error {
 			Name        string `json:"name"`
 			Account     string `json:"account"`
 			Description string `json:"description"`
+			Path        string `json:"path,omitempty"`
 		}
 		jsonData := make([]item, len(names))
 
@@ -46,6 +47,7 @@ func runList(c *cli.Context) error {
 			jsonData[i].Name = name
 			jsonData[i].Account = identities[name].Account
 			jsonData[i].Description = identities[name].Description
+			jsonData[i].Path = identities[name].Path
 		}
 
 		printJson(m.w, jsonData)
@@ -56,6 +58,10 @@ func runList(c *cli.Context) error {
 			if identities[name].Salt != "" {
 				flag = "SK"
 			}
+			if identities[name].Path != "" {
+				fmt.Fprintf(m.w, "%s %-20s  %s  %q  %s\n", flag, name, identities[name].Account, identities[name].Description, identities[name].Path)
+				continue
+			}
 			fmt.Fprintf(m.w, "%s %-20s  %s  %q\n", flag, name, identities[name].Account, identities[name].Description)
 		}
 	}
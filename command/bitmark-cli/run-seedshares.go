@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+type seedSplitResult struct {
+	Name      string   `json:"name"`
+	Account   string   `json:"account"`
+	Path      string   `json:"path,omitempty"`
+	Threshold int      `json:"threshold"`
+	Shares    []string `json:"shares"`
+}
+
+type seedCombineResult struct {
+	Seed    string `json:"seed"`
+	Account string `json:"account"`
+	Phrase  string `json:"recovery_phrase"`
+}
+
+// split the identity's seed so that any threshold of the shares
+// recover it
+func runSeedSplit(c *cli.Context) error {
+
+	m := c.App.Metadata["config"].(*metadata)
+
+	threshold := c.Int("threshold")
+	count := c.Int("count")
+	if threshold <= 0 || count <= 0 {
+		return fault.InvalidCount
+	}
+
+	name, owner, err := checkOwnerWithPasswordPrompt(c.GlobalString("identity"), m.config, c)
+	if err != nil {
+		return err
+	}
+
+	if m.verbose {
+		fmt.Fprintf(m.e, "identity: %s\n", name)
+		fmt.Fprintf(m.e, "threshold: %d\n", threshold)
+		fmt.Fprintf(m.e, "count: %d\n", count)
+	}
+
+	shares, err := account.Base58EncodedSeedToShares(owner.Seed, threshold, count, c.String("passphrase"))
+	if err != nil {
+		return err
+	}
+
+	result := seedSplitResult{
+		Name:      name,
+		Account:   owner.PrivateKey.Account().String(),
+		Path:      owner.Path,
+		Threshold: threshold,
+		Shares:    make([]string, len(shares)),
+	}
+	for i, share := range shares {
+		result.Shares[i] = strings.Join(share, " ")
+	}
+
+	printJson(m.w, result)
+	return nil
+}
+
+// recover a seed from shares given on the command line or in a file
+func runSeedCombine(c *cli.Context) error {
+
+	m := c.App.Metadata["config"].(*metadata)
+
+	lines := c.StringSlice("share")
+
+	if fileName := c.String("file"); fileName != "" {
+		data, err := os.ReadFile(fileName)
+		if err != nil {
+			return err
+		}
+		lines = append(lines, strings.Split(string(data), "\n")...)
+	}
+
+	shares := make([][]string, 0, len(lines))
+	for _, line := range lines {
+		words := strings.Fields(line)
+		if len(words) > 0 {
+			shares = append(shares, words)
+		}
+	}
+
+	if m.verbose {
+		fmt.Fprintf(m.e, "shares: %d\n", len(shares))
+	}
+
+	seed, err := account.SharesToBase58EncodedSeed(shares, c.String("passphrase"))
+	if err != nil {
+		return err
+	}
+
+	privateKey, err := account.PrivateKeyFromBase58Seed(seed)
+	if err != nil {
+		return err
+	}
+
+	phrase, err := account.Base58EncodedSeedToPhrase(seed)
+	if err != nil {
+		return err
+	}
+
+	result := seedCombineResult{
+		Seed:    seed,
+		Account: privateKey.Account().String(),
+		Phrase:  strings.Join(phrase, " "),
+	}
+
+	printJson(m.w, result)
+	return nil
+}
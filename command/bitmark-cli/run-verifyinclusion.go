@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/urfave/cli"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/rpccalls"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
+)
+
+type verifyInclusionReply struct {
+	TxId        merkle.Digest      `json:"txId"`
+	BlockNumber uint64             `json:"blockNumber,string"`
+	Digest      blockdigest.Digest `json:"digest"`
+	MerkleRoot  merkle.Digest      `json:"merkleRoot"`
+	Trusted     bool               `json:"trusted"` // digest matched the --digest option
+	Verified    bool               `json:"verified"`
+}
+
+func runVerifyInclusion(c *cli.Context) error {
+
+	m := c.App.Metadata["config"].(*metadata)
+
+	txId, err := checkTxId(c.String("txid"))
+	if err != nil {
+		return err
+	}
+
+	var trusted *blockdigest.Digest
+	if s := c.String("digest"); s != "" {
+		trusted = &blockdigest.Digest{}
+		if err := trusted.UnmarshalText([]byte(s)); err != nil {
+			return err
+		}
+	}
+
+	if m.verbose {
+		fmt.Fprintf(m.e, "txid: %s\n", txId)
+	}
+
+	var proof *transaction.InclusionProofReply
+	if fileName := c.String("proof"); fileName != "" {
+
+		// offline: a proof saved from an earlier request
+		data, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			return err
+		}
+		proof = &transaction.InclusionProofReply{}
+		if err := json.Unmarshal(data, proof); err != nil {
+			return err
+		}
+
+	} else {
+		client, err := rpccalls.NewClient(m.testnet, m.config.Connections[m.connectionOffset], m.verbose, m.e)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+
+		proof, err = client.GetInclusionProof(&rpccalls.InclusionProofData{
+			TxId:        txId,
+			BlockNumber: c.Uint64("block"),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	var id merkle.Digest
+	if err := id.UnmarshalText([]byte(txId)); err != nil {
+		return err
+	}
+	if proof.TxId != id {
+		return fault.TransactionIdDoesNotMatch
+	}
+
+	if err := proof.Verify(); err != nil {
+		return err
+	}
+
+	if trusted != nil && *trusted != proof.Digest {
+		return fault.BlockDigestDoesNotMatch
+	}
+
+	header, err := proof.UnpackHeader()
+	if err != nil {
+		return err
+	}
+
+	printJson(m.w, verifyInclusionReply{
+		TxId:        proof.TxId,
+		BlockNumber: proof.BlockNumber,
+		Digest:      proof.Digest,
+		MerkleRoot:  header.MerkleRoot,
+		Trusted:     trusted != nil,
+		Verified:    true,
+	})
+
+	return nil
+}
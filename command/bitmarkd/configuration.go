@@ -13,10 +13,12 @@ import (
 
 	"github.com/bitmark-inc/bitmarkd/chain"
 	"github.com/bitmark-inc/bitmarkd/configuration"
+	"github.com/bitmark-inc/bitmarkd/light"
 	"github.com/bitmark-inc/bitmarkd/payment"
 	"github.com/bitmark-inc/bitmarkd/peer"
 	"github.com/bitmark-inc/bitmarkd/proof"
 	"github.com/bitmark-inc/bitmarkd/publish"
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
 	"github.com/bitmark-inc/bitmarkd/rpc/listeners"
 	"github.com/bitmark-inc/bitmarkd/util"
 	"github.com/bitmark-inc/logger"
@@ -73,6 +75,7 @@ type Configuration struct {
 	DataDirectory string       `gluamapper:"data_directory" json:"data_directory"`
 	PidFile       string       `gluamapper:"pidfile" json:"pidfile"`
 	Chain         string       `gluamapper:"chain" json:"chain"`
+	ChainFile     string       `gluamapper:"chain_file" json:"chain_file"`
 	Nodes         string       `gluamapper:"nodes" json:"nodes"`
 	Fastsync      bool         `gluamapper:"fast_sync" json:"fast_sync"`
 	ProfileHTTP   string       `gluamapper:"profile_http" json:"profile_http"`
@@ -84,10 +87,13 @@ type Configuration struct {
 
 	ClientRPC  listeners.RPCConfiguration   `gluamapper:"client_rpc" json:"client_rpc"`
 	HttpsRPC   listeners.HTTPSConfiguration `gluamapper:"https_rpc" json:"https_rpc"`
+	GrpcRPC    listeners.GRPCConfiguration  `gluamapper:"grpc_rpc" json:"grpc_rpc"`
+	APIKeys    apikey.Configuration         `gluamapper:"api_keys" json:"api_keys"`
 	Peering    peer.Configuration           `gluamapper:"peering" json:"peering"`
 	Publishing publish.Configuration        `gluamapper:"publishing" json:"publishing"`
 	Proofing   proof.Configuration          `gluamapper:"proofing" json:"proofing"`
 	Payment    payment.Configuration        `gluamapper:"payment" json:"payment"`
+	Light      light.Configuration          `gluamapper:"light" json:"light"`
 	Logging    logger.Configuration         `gluamapper:"logging" json:"logging"`
 }
 
@@ -126,6 +132,11 @@ func getConfiguration(configurationFileName string) (*Configuration, error) {
 			MaximumConnections: defaultRPCClients,
 		},
 
+		// default: disabled, no listen addresses
+		GrpcRPC: listeners.GRPCConfiguration{
+			MaximumConnections: defaultRPCClients,
+		},
+
 		Peering: peer.Configuration{
 			DynamicConnections: true,
 			PreferIPv6:         true,
@@ -143,14 +154,53 @@ func getConfiguration(configurationFileName string) (*Configuration, error) {
 			File:      defaultLogFile,
 			Size:      defaultLogSize,
 			Count:     defaultLogCount,
-			Levels:    defaultLogLevels,
+			Levels:    make(map[string]string),
 		},
 	}
 
+	// a fresh copy as parsing adds to the map
+	for tag, level := range defaultLogLevels {
+		options.Logging.Levels[tag] = level
+	}
+
 	if err := configuration.ParseConfigurationFile(configurationFileName, options); err != nil {
 		return nil, err
 	}
 
+	// ensure absolute data directory
+	switch options.DataDirectory {
+	case "", "~":
+		return nil, fmt.Errorf("Path: %q is not a valid directory", options.DataDirectory)
+	case ".":
+		options.DataDirectory = dataDirectory // same directory as the configuration file
+	default:
+		options.DataDirectory = filepath.Clean(options.DataDirectory)
+	}
+
+	// this directory must exist - i.e. must be created prior to running
+	if fileInfo, err := os.Stat(options.DataDirectory); err != nil {
+		return nil, err
+	} else if !fileInfo.IsDir() {
+		return nil, fmt.Errorf("Path: %q is not a directory", options.DataDirectory)
+	}
+
+	// a custom chain is defined in a separate file and must be
+	// registered before its name is valid
+	if options.ChainFile != "" {
+		options.ChainFile = util.EnsureAbsolute(options.DataDirectory, options.ChainFile)
+		parameters := chain.Parameters{}
+		if err := configuration.ParseConfigurationFile(options.ChainFile, &parameters); err != nil {
+			return nil, err
+		}
+		// already registered if the configuration is being reloaded,
+		// a changed chain file only takes effect on restart
+		if chain.Custom(strings.ToLower(parameters.Name)) == nil {
+			if err := chain.Register(parameters); err != nil {
+				return nil, fmt.Errorf("Chain file: %q error: %s", options.ChainFile, err)
+			}
+		}
+	}
+
 	// if any test mode and the database file was not specified
 	// switch to appropriate default.  Abort if then chain name is
 	// not recognised.
@@ -191,24 +241,30 @@ func getConfiguration(configurationFileName string) (*Configuration, error) {
 			options.Payment.P2PCache.LtcDirectory = defaultLocalLtcCacheDirectory
 		}
 	default:
-		return nil, fmt.Errorf("Chain: %s no default database setting", options.Chain)
-	}
-
-	// ensure absolute data directory
-	switch options.DataDirectory {
-	case "", "~":
-		return nil, fmt.Errorf("Path: %q is not a valid directory", options.DataDirectory)
-	case ".":
-		options.DataDirectory = dataDirectory // same directory as the configuration file
-	default:
-		options.DataDirectory = filepath.Clean(options.DataDirectory)
+		if chain.Custom(options.Chain) == nil {
+			return nil, fmt.Errorf("Chain: %s no default database setting", options.Chain)
+		}
+		if options.Database.Name == defaultBitmarkDatabase {
+			options.Database.Name = options.Chain
+		}
+		if options.CacheDirectory == defaultBitmarkCacheDirectory {
+			options.CacheDirectory = options.Chain + "-cache"
+		}
+		if options.Payment.P2PCache.BtcDirectory == defaultBitmarkBtcCacheDirectory {
+			options.Payment.P2PCache.BtcDirectory = options.Chain + "-btc-cache"
+		}
+		if options.Payment.P2PCache.LtcDirectory == defaultBitmarkLtcCacheDirectory {
+			options.Payment.P2PCache.LtcDirectory = options.Chain + "-ltc-cache"
+		}
 	}
 
-	// this directory must exist - i.e. must be created prior to running
-	if fileInfo, err := os.Stat(options.DataDirectory); err != nil {
-		return nil, err
-	} else if !fileInfo.IsDir() {
-		return nil, fmt.Errorf("Path: %q is not a directory", options.DataDirectory)
+	// a light node follows its peers and cannot accept transactions
+	// as it has no blocks to verify them against
+	if options.Light.Enabled {
+		if options.Standalone {
+			return nil, fmt.Errorf("Light: standalone mode is not supported")
+		}
+		options.ReadOnly = true
 	}
 
 	// force all relevant items to be absolute paths
@@ -227,6 +283,7 @@ func getConfiguration(configurationFileName string) (*Configuration, error) {
 	// optional absolute paths i.e. blank or an absolute path
 	optionalAbsolute := []*string{
 		&options.PidFile,
+		&options.APIKeys.KeyFile,
 	}
 	for _, f := range optionalAbsolute {
 		if *f != "" {
@@ -12,6 +12,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 
 	"github.com/bitmark-inc/bitmarkd/announce"
@@ -21,10 +22,13 @@ import (
 	"github.com/bitmark-inc/bitmarkd/blockrecord"
 	"github.com/bitmark-inc/bitmarkd/chain"
 	"github.com/bitmark-inc/bitmarkd/difficulty"
+	"github.com/bitmark-inc/bitmarkd/genesis"
+	"github.com/bitmark-inc/bitmarkd/light"
 	"github.com/bitmark-inc/bitmarkd/mode"
 	"github.com/bitmark-inc/bitmarkd/ownership"
 	"github.com/bitmark-inc/bitmarkd/payment"
 	"github.com/bitmark-inc/bitmarkd/peer"
+	"github.com/bitmark-inc/bitmarkd/peer/reputation"
 	"github.com/bitmark-inc/bitmarkd/proof"
 	"github.com/bitmark-inc/bitmarkd/publish"
 	"github.com/bitmark-inc/bitmarkd/reservoir"
@@ -131,6 +135,16 @@ func main() {
 	}
 	defer mode.Finalise()
 
+	// a custom chain sets its own block spacing and genesis block
+	if c := chain.Custom(theConfiguration.Chain); c != nil {
+		log.Infof("custom chain: %q  block spacing: %d s", c.Name, c.BlockSpacing)
+		difficulty.SetBlockSpacing(c.BlockSpacing)
+	}
+	if _, err := genesis.Digest(theConfiguration.Chain); err != nil {
+		log.Criticalf("genesis block error: %s", err)
+		exitwithstatus.Message("genesis block error: %s", err)
+	}
+
 	// start a profiling http server
 	// this uses the default builtin HTTP handler
 	// and is not associated with the normal ClientRPC HTTPS server
@@ -147,9 +161,11 @@ func main() {
 	log.Infof("database: %q", theConfiguration.Database)
 	log.Infof("read only: %t", theConfiguration.ReadOnly)
 	log.Infof("standalone: %t", theConfiguration.Standalone)
+	log.Infof("light: %t", theConfiguration.Light.Enabled)
 
 	// connection info
 	log.Debugf("%s = %#v", "ClientRPC", theConfiguration.ClientRPC)
+	log.Debugf("%s = %#v", "GrpcRPC", theConfiguration.GrpcRPC)
 	log.Debugf("%s = %#v", "Peering", theConfiguration.Peering)
 	log.Debugf("%s = %#v", "Publishing", theConfiguration.Publishing)
 	log.Debugf("%s = %#v", "Proofing", theConfiguration.Proofing)
@@ -227,6 +243,15 @@ func main() {
 	}
 	defer block.Finalise()
 
+	// header-only mode - depends on storage and blockheader
+	log.Info("initialise light")
+	err = light.Initialise(&theConfiguration.Light)
+	if err != nil {
+		log.Criticalf("light initialise error: %s", err)
+		exitwithstatus.Message("light initialise error: %s", err)
+	}
+	defer light.Finalise()
+
 	// these commands are allowed to access the internal database
 	if len(arguments) > 0 && processDataCommand(log, arguments, theConfiguration) {
 		return
@@ -270,15 +295,26 @@ func main() {
 			case chain.Bitmark:
 				nodesDomain = "nodes.live.bitmark.com"
 			default:
-				log.Criticalf("unexpected chain name: %q", cn)
-				exitwithstatus.Message("unexpected chain name: %q", cn)
+				c := chain.Custom(cn)
+				if c == nil {
+					log.Criticalf("unexpected chain name: %q", cn)
+					exitwithstatus.Message("unexpected chain name: %q", cn)
+				}
+				nodesDomain = c.Nodes
 			}
 		default:
 			// domain names are complex to validate so just rely on
 			// trying to fetch the TXT records for validation
 			nodesDomain = theConfiguration.Nodes // just assume it is a domain name
 		}
-		err = announce.Initialise(nodesDomain, theConfiguration.CacheDirectory, net.LookupTXT)
+		err = reputation.Initialise(theConfiguration.CacheDirectory)
+		if err != nil {
+			log.Criticalf("reputation initialise error: %s", err)
+			exitwithstatus.Message("reputation initialise error: %s", err)
+		}
+		defer reputation.Finalise()
+
+		err = announce.Initialise(nodesDomain, theConfiguration.CacheDirectory, theConfiguration.Peering.Private, net.LookupTXT)
 		if err != nil {
 			log.Criticalf("announce initialise error: %s", err)
 			exitwithstatus.Message("announce initialise error: %s", err)
@@ -287,7 +323,7 @@ func main() {
 	}
 
 	// start payment services
-	if !(theConfiguration.Standalone && theConfiguration.ReadOnly) {
+	if !(theConfiguration.Standalone && theConfiguration.ReadOnly) && !theConfiguration.Light.Enabled {
 		log.Info("initialise payment")
 		err = payment.Initialise(&theConfiguration.Payment)
 		if err != nil {
@@ -329,6 +365,8 @@ func main() {
 	err = rpc.Initialise(
 		&theConfiguration.ClientRPC,
 		&theConfiguration.HttpsRPC,
+		&theConfiguration.GrpcRPC,
+		&theConfiguration.APIKeys,
 		version,
 		announce.Get(),
 		theConfiguration.ReadOnly,
@@ -340,7 +378,7 @@ func main() {
 	defer rpc.Finalise()
 
 	// start proof background processes
-	if !(theConfiguration.Standalone && theConfiguration.ReadOnly) {
+	if !(theConfiguration.Standalone && theConfiguration.ReadOnly) && !theConfiguration.Light.Enabled {
 		log.Info("initialise proofing")
 		err = proof.Initialise(&theConfiguration.Proofing)
 		if err != nil {
@@ -362,13 +400,32 @@ func main() {
 
 	// wait for CTRL-C before shutting down to allow manual testing
 	if len(options["quiet"]) == 0 {
-		fmt.Printf("\n\nWaiting for CTRL-C (SIGINT) or 'kill <pid>' (SIGTERM)…")
+		fmt.Printf("\n\nWaiting for CTRL-C (SIGINT) or 'kill <pid>' (SIGTERM), 'kill -HUP <pid>' to reload…")
 	}
 
 	// turn Signals into channel messages
+	// SIGHUP reloads the configuration file
 	ch := make(chan os.Signal, 1)
-	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM)
+	signal.Notify(ch, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
 	sig := <-ch
+	for syscall.SIGHUP == sig {
+		log.Infof("received signal: %v  reloading: %q", sig, configurationFile)
+		current, restart, err := reload(log, configurationFile, theConfiguration)
+		if err != nil {
+			log.Errorf("reload error: %s", err)
+		} else if len(restart) > 0 {
+			log.Warnf("reload: restart needed for: %s", strings.Join(restart, ", "))
+		}
+		if len(options["quiet"]) == 0 {
+			if err != nil {
+				fmt.Printf("\nreload error: %s\n", err)
+			} else if len(restart) > 0 {
+				fmt.Printf("\nreload: restart needed for: %s\n", strings.Join(restart, ", "))
+			}
+		}
+		theConfiguration = current
+		sig = <-ch
+	}
 	log.Infof("received signal: %v", sig)
 	if len(options["quiet"]) == 0 {
 		fmt.Printf("\nreceived signal: %v\n", sig)
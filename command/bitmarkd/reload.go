@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/payment"
+	"github.com/bitmark-inc/bitmarkd/peer"
+	"github.com/bitmark-inc/bitmarkd/rpc"
+	"github.com/bitmark-inc/logger"
+	"github.com/bitmark-inc/logger/level"
+)
+
+// reload - re-read the configuration file on SIGHUP and apply the
+// settings that can change while running:
+//
+//	logging.levels
+//	https_rpc.allow
+//	api_keys             (if API keys were enabled at start)
+//	peering.connect      (not for a private network)
+//	peering.maximum_reorg_depth
+//	payment.bootstrap_nodes
+//
+// returns the configuration now in effect and the names of any other
+// changed settings, which need a restart
+func reload(log *logger.L, configurationFile string, current *Configuration) (*Configuration, []string, error) {
+	next, err := getConfiguration(configurationFile)
+	if err != nil {
+		return current, nil, err
+	}
+
+	// start from the running configuration and copy in each
+	// setting once it has been applied
+	applied := *current
+
+	if !reflect.DeepEqual(current.Logging.Levels, next.Logging.Levels) {
+		err := setLogLevels(next.Logging.Levels)
+		if err != nil {
+			log.Errorf("reload: logging levels error: %s", err)
+		} else {
+			log.Info("reload: logging levels changed")
+			applied.Logging.Levels = next.Logging.Levels
+		}
+	}
+
+	if !reflect.DeepEqual(current.HttpsRPC.Allow, next.HttpsRPC.Allow) {
+		err := rpc.SetAllow(next.HttpsRPC.Allow)
+		if err != nil {
+			log.Errorf("reload: https allow error: %s", err)
+		} else {
+			log.Info("reload: https allow changed")
+			applied.HttpsRPC.Allow = next.HttpsRPC.Allow
+		}
+	}
+
+	// a key file can change without any change to the configuration
+	err = rpc.SetAPIKeys(&next.APIKeys)
+	if fault.APIKeysNotEnabled == err {
+		log.Warn("reload: api keys were not enabled at start")
+	} else if err != nil {
+		log.Errorf("reload: api keys error: %s", err)
+	} else {
+		applied.APIKeys = next.APIKeys
+	}
+
+	if !current.Standalone && !current.Peering.Private &&
+		!reflect.DeepEqual(current.Peering.Connect, next.Peering.Connect) {
+		err := peer.SetStaticConnections(next.Peering.Connect)
+		if err != nil {
+			log.Errorf("reload: static connections error: %s", err)
+		} else {
+			log.Info("reload: static connections changed")
+			applied.Peering.Connect = next.Peering.Connect
+		}
+	}
+
+	if !current.Standalone && current.Peering.MaximumReorgDepth != next.Peering.MaximumReorgDepth {
+		err := peer.SetMaximumReorgDepth(next.Peering.MaximumReorgDepth)
+		if err != nil {
+			log.Errorf("reload: maximum reorg depth error: %s", err)
+		} else {
+			log.Info("reload: maximum reorg depth changed")
+			applied.Peering.MaximumReorgDepth = next.Peering.MaximumReorgDepth
+		}
+	}
+
+	if "p2p" == current.Payment.Mode && "p2p" == next.Payment.Mode &&
+		!reflect.DeepEqual(current.Payment.BootstrapNodes, next.Payment.BootstrapNodes) {
+		err := payment.SetBootstrapNodes(&next.Payment)
+		if err != nil {
+			log.Errorf("reload: payment bootstrap nodes error: %s", err)
+		} else {
+			log.Info("reload: payment bootstrap nodes changed")
+			applied.Payment.BootstrapNodes = next.Payment.BootstrapNodes
+		}
+	}
+
+	restart := changedSettings("", reflect.ValueOf(applied), reflect.ValueOf(*next))
+
+	return &applied, restart, nil
+}
+
+// set the level of every existing logger from the new levels,
+// loggers not listed take the default level
+func setLogLevels(levels map[string]string) error {
+	for tag, l := range levels {
+		if _, ok := level.ValidLevels[l]; !ok {
+			return fmt.Errorf("tag: %q has invalid level: %q", tag, l)
+		}
+	}
+
+	defaultLevel, ok := levels[logger.DefaultTag]
+	if !ok {
+		defaultLevel = logger.DefaultLevel
+	}
+
+	buffer, err := logger.ListLevels()
+	if err != nil {
+		return err
+	}
+	var current logger.LogLevels
+	err = json.Unmarshal(buffer, &current)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range current.Levels {
+		l, ok := levels[c.Tag]
+		if !ok {
+			l = defaultLevel
+		}
+		if l == c.LogLevel {
+			continue
+		}
+		err := logger.UpdateTagLogLevel(c.Tag, l)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// names of the settings that differ, using the configuration file
+// names and descending into nested sections
+func changedSettings(prefix string, a reflect.Value, b reflect.Value) []string {
+	changed := make([]string, 0)
+
+	if reflect.Struct != a.Kind() {
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			changed = append(changed, prefix)
+		}
+		return changed
+	}
+
+	t := a.Type()
+	for i := 0; i < t.NumField(); i += 1 {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := settingName(field)
+		if "" != prefix {
+			name = prefix + "." + name
+		}
+		changed = append(changed, changedSettings(name, a.Field(i), b.Field(i))...)
+	}
+	return changed
+}
+
+// the configuration file name of a field
+func settingName(field reflect.StructField) string {
+	for _, key := range []string{"gluamapper", "json"} {
+		if tag, ok := field.Tag.Lookup(key); ok {
+			name := strings.Split(tag, ",")[0]
+			if "" != name && "-" != name {
+				return name
+			}
+		}
+	}
+	return strings.ToLower(field.Name)
+}
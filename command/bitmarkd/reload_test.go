@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/peer"
+)
+
+func TestChangedSettings(t *testing.T) {
+	a := Configuration{
+		Chain: "testing",
+		Peering: peer.Configuration{
+			Listen: []string{"127.0.0.1:2136"},
+		},
+	}
+	a.Logging.Levels = map[string]string{"DEFAULT": "info"}
+
+	b := a
+	b.Logging.Levels = map[string]string{"DEFAULT": "info"}
+	assert.Equal(t, []string{}, changedSettings("", reflect.ValueOf(a), reflect.ValueOf(b)), "wrong unchanged")
+
+	b.Chain = "local"
+	b.Peering.Listen = []string{"127.0.0.1:2137"}
+	b.Logging.Levels = map[string]string{"DEFAULT": "debug"}
+	b.ClientRPC.Client.Required = true
+
+	expected := []string{
+		"chain",
+		"client_rpc.client.required",
+		"peering.listen",
+		"logging.levels",
+	}
+	assert.Equal(t, expected, changedSettings("", reflect.ValueOf(a), reflect.ValueOf(b)), "wrong changes")
+}
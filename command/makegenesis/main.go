@@ -10,9 +10,142 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bitmark-inc/exitwithstatus"
+	"github.com/bitmark-inc/getoptions"
+	"golang.org/x/crypto/ed25519"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/difficulty"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 )
 
+// set by the linker: go build -ldflags "-X main.version=M.N" ./...
+var version = "zero" // do not change this value
+
+// private key generation data
+const (
+	genesisKey = "Bitmark Inc. Genesis Block for Chain:"
+	iterations = 2016
+)
+
+// main program
+//
+// the live and test genesis blocks are checked by the tests, this
+// creates the genesis block of a custom chain for its chain definition
+// file
 func main() {
-	fmt.Printf("makegenesis is a dummy program, just run its tests instead\n")
+	// ensure exit handler is first
+	defer exitwithstatus.Handler()
+
+	flags := []getoptions.Option{
+		{Long: "help", HasArg: getoptions.NO_ARGUMENT, Short: 'h'},
+		{Long: "message", HasArg: getoptions.REQUIRED_ARGUMENT, Short: 'm'},
+		{Long: "timestamp", HasArg: getoptions.REQUIRED_ARGUMENT, Short: 't'},
+		{Long: "version", HasArg: getoptions.NO_ARGUMENT, Short: 'V'},
+	}
+
+	program, options, arguments, err := getoptions.GetOS(flags)
+	if err != nil {
+		exitwithstatus.Message("%s: getoptions error: %s", program, err)
+	}
+
+	if len(options["version"]) > 0 {
+		exitwithstatus.Message("%s: version: %s", program, version)
+	}
+
+	if len(options["help"]) > 0 || len(arguments) != 1 {
+		exitwithstatus.Message("usage: %s [--help] [--message=TEXT] [--timestamp=UNIX-SECONDS] chain-name", program)
+	}
+
+	name := strings.ToLower(arguments[0])
+
+	message := "Genesis Block for Chain: " + name
+	if len(options["message"]) > 0 {
+		message = options["message"][0]
+	}
+
+	timestamp := uint64(time.Now().Unix())
+	if len(options["timestamp"]) > 0 {
+		timestamp, err = strconv.ParseUint(options["timestamp"][0], 10, 64)
+		if err != nil {
+			exitwithstatus.Message("%s: timestamp: %q  error: %s", program, options["timestamp"][0], err)
+		}
+	}
+
+	block, err := makeGenesis(name, message, timestamp)
+	if err != nil {
+		exitwithstatus.Message("%s: genesis error: %s", program, err)
+	}
+
+	packedHeader, err := blockrecord.PackedHeaderOf(block)
+	if err != nil {
+		exitwithstatus.Message("%s: header error: %s", program, err)
+	}
+
+	fmt.Printf("-- genesis digest: %s\n", packedHeader.Digest())
+	fmt.Printf("name = \"%s\"\n", name)
+	fmt.Printf("genesis = \"%x\"\n", block)
+}
+
+// make a signed base record and a header for it, custom chains use
+// testing addresses
+func makeGenesis(name string, message string, timestamp uint64) ([]byte, error) {
+
+	text := []byte(genesisKey + " " + name)
+	buffer := make([]byte, 32)
+	for i := 0; i < iterations; i += 1 {
+		buffer2 := sha3.Sum256(append(buffer, text...))
+		buffer = buffer2[:]
+	}
+
+	publicKey, privateKey, err := ed25519.GenerateKey(bytes.NewBuffer(buffer))
+	if err != nil {
+		return nil, err
+	}
+
+	owner := &account.Account{
+		AccountInterface: &account.ED25519Account{
+			Test:      true,
+			PublicKey: publicKey,
+		},
+	}
+
+	base := &transactionrecord.OldBaseData{
+		Currency:       currency.Nothing,
+		PaymentAddress: message,
+		Owner:          owner,
+		Nonce:          timestamp,
+	}
+
+	// the unsigned record is returned with the signature error
+	unsigned, _ := base.Pack(owner)
+	base.Signature = ed25519.Sign(privateKey, unsigned)
+
+	packed, err := base.Pack(owner)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &blockrecord.Header{
+		Version:          1,
+		TransactionCount: 1,
+		Number:           1,
+		MerkleRoot:       merkle.Digest(packed.MakeLink()),
+		Timestamp:        timestamp,
+		Difficulty:       difficulty.New(),
+		Nonce:            0,
+	}
+
+	hPacked := h.Pack()
+	return append(hPacked[:], packed...), nil
 }
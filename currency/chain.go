@@ -466,19 +466,21 @@ func (currency Currency) ChainParam(chainName string) *chaincfg.Params {
 	case Nothing:
 		return nil // for genesis blocks
 	case Bitcoin:
-		if chainName == chain.Testing {
-			return btcTestNet3Params
-		} else if chainName == chain.Bitmark {
+		switch paymentNetwork(chainName, currency) {
+		case chain.PaymentMain:
 			return btcMainNetParams
-		} else {
+		case chain.PaymentTest:
+			return btcTestNet3Params
+		default:
 			return btcRegressionNetParams
 		}
 	case Litecoin:
-		if chainName == chain.Testing {
-			return ltcTestNet4Params
-		} else if chainName == chain.Bitmark {
+		switch paymentNetwork(chainName, currency) {
+		case chain.PaymentMain:
 			return ltcMainNetParams
-		} else {
+		case chain.PaymentTest:
+			return ltcTestNet4Params
+		default:
 			return ltcRegressionNetParams
 		}
 	default:
@@ -486,3 +488,20 @@ func (currency Currency) ChainParam(chainName string) *chaincfg.Params {
 	}
 	return nil
 }
+
+// the payment network of a currency used by a chain
+func paymentNetwork(chainName string, currency Currency) string {
+	switch chainName {
+	case chain.Bitmark:
+		return chain.PaymentMain
+	case chain.Testing:
+		return chain.PaymentTest
+	}
+	if p := chain.Custom(chainName); p != nil {
+		if currency == Litecoin {
+			return p.Litecoin
+		}
+		return p.Bitcoin
+	}
+	return chain.PaymentRegression
+}
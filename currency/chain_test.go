@@ -76,3 +76,24 @@ func TestLtcChainParams(t *testing.T) {
 		t.Fatal("incorrect currency")
 	}
 }
+
+func TestCustomChainParams(t *testing.T) {
+
+	err := chain.Register(chain.Parameters{
+		Name:     "currency-test",
+		Genesis:  "01",
+		Bitcoin:  chain.PaymentRegression,
+		Litecoin: chain.PaymentTest,
+	})
+	if err != nil {
+		t.Fatalf("register error: %s", err)
+	}
+
+	if Bitcoin.ChainParam("currency-test").Name != "regtest" {
+		t.Fatal("invalid bitcoin network")
+	}
+
+	if Litecoin.ChainParam("currency-test").Name != "testnet4" {
+		t.Fatal("invalid litecoin network")
+	}
+}
@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/configuration"
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/rpccalls"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// Account - a testing account to own assets and bitmarks
+type Account struct {
+	private *configuration.Private
+}
+
+// NewAccount - create an account from a new random seed
+func NewAccount() (*Account, error) {
+	seed, err := account.NewBase58EncodedSeedV2(true)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := account.PrivateKeyFromBase58Seed(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Account{
+		private: &configuration.Private{
+			PrivateKey: privateKey,
+			Seed:       seed,
+		},
+	}, nil
+}
+
+// Account - the public account
+func (a *Account) Account() *account.Account {
+	return a.private.PrivateKey.Account()
+}
+
+// Payment - what must be paid before a transaction is verified
+type Payment struct {
+	PayId    pay.PayId
+	Payments map[string]transactionrecord.PaymentAlternative // by currency
+}
+
+// Create - register an asset and issue one bitmark of it for free,
+// returns the asset id and the issue transaction id
+//
+// the free issue is verified by its proof of work, both are confirmed
+// when a block is mined
+func (n *Node) Create(owner *Account, name string) (*transactionrecord.AssetIdentifier, merkle.Digest, error) {
+	client, err := n.Client()
+	if err != nil {
+		return nil, merkle.Digest{}, err
+	}
+	defer client.Close()
+
+	fingerprint := make([]byte, 32)
+	if _, err := rand.Read(fingerprint); err != nil {
+		return nil, merkle.Digest{}, err
+	}
+
+	asset, err := client.MakeAsset(&rpccalls.AssetData{
+		Name:        name,
+		Metadata:    "",
+		Quantity:    1,
+		Registrant:  owner.private,
+		Fingerprint: "01" + hex.EncodeToString(fingerprint),
+	})
+	if err != nil {
+		return nil, merkle.Digest{}, err
+	}
+
+	reply, err := client.Issue(&rpccalls.IssueData{
+		Issuer:    owner.private,
+		AssetId:   asset.AssetId,
+		Quantity:  1,
+		FreeIssue: true,
+	})
+	if err != nil {
+		return nil, merkle.Digest{}, err
+	}
+
+	return asset.AssetId, reply.IssueIds[0], nil
+}
+
+// Issue - issue more bitmarks of a confirmed asset, returns the issue
+// transaction ids and their payment
+//
+// the issues stay pending until the payment is made with Network.Pay
+func (n *Node) Issue(owner *Account, assetId *transactionrecord.AssetIdentifier, quantity int) ([]merkle.Digest, *Payment, error) {
+	client, err := n.Client()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer client.Close()
+
+	reply, err := client.Issue(&rpccalls.IssueData{
+		Issuer:   owner.private,
+		AssetId:  assetId,
+		Quantity: quantity,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payment := &Payment{
+		PayId:    reply.PayId,
+		Payments: reply.Payments,
+	}
+	return reply.IssueIds, payment, nil
+}
+
+// TransactionStatus - status of a transaction: Pending, Verified or
+// Confirmed
+func (n *Node) TransactionStatus(txId merkle.Digest) (string, error) {
+	client, err := n.Client()
+	if err != nil {
+		return "", err
+	}
+	defer client.Close()
+
+	reply, err := client.GetTransactionStatus(&rpccalls.TransactionStatusData{
+		TxId: txId.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+	return reply.Status, nil
+}
+
+// WaitForStatus - wait until a transaction has the status on the node
+func (n *Node) WaitForStatus(txId merkle.Digest, status string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		if s, err := n.TransactionStatus(txId); err == nil && s == status {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fault.TimeoutWaitingForStatus
+		}
+		time.Sleep(pollInterval)
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package devnet - a harness to run a small network of bitmarkd nodes
+// on loopback for end-to-end tests
+//
+// Each node is a separate bitmarkd process started and controlled by
+// the test program, which also takes the place of the external
+// services.  Nodes do not yet run inside the test process, as that
+// needs the package globals that hold each subsystem's state to become
+// per node instances.  The nodes are on the local chain with:
+//
+//   - generated peer, proof and RPC identities
+//   - no hasher: the harness subscribes to a node's proof jobs and
+//     submits a nonce only when the test mines, so every block and its
+//     transactions are chosen by the test
+//   - payments verified by the Electrum watcher against a fake Bitcoin
+//     chain served by the harness, where the test makes each payment
+//   - a private network whose connections pass through loopback links
+//     of the harness, which are cut to partition and rejoined to heal
+//     the running nodes
+//
+// Build compiles bitmarkd for tests that are not given a program.  The
+// end-to-end test of this package only runs when DEVNET is set in the
+// environment, so a plain "go test ./..." neither builds bitmarkd nor
+// starts any nodes.
+//
+// example:
+//
+//	binary, err := devnet.Build(t.TempDir())
+//	network, err := devnet.New(devnet.Configuration{
+//		Binary:    binary,
+//		Directory: t.TempDir(),
+//		Nodes:     3,
+//	})
+//	...
+//	err = network.Start()
+//	defer network.Stop()
+//	...
+//	owner, _ := devnet.NewAccount()
+//	assetId, issueId, err := network.Node(0).Create(owner, "asset")
+//	height, err := network.Node(0).Mine([]merkle.Digest{issueId}, time.Minute)
+//	err = network.WaitForHeight(height, time.Minute)
+//
+//	txIds, payment, err := network.Node(1).Issue(owner, assetId, 2)
+//	_, err = network.Pay(payment)
+//	err = network.Node(1).WaitForStatus(txIds[0], "Verified", time.Minute)
+package devnet
@@ -0,0 +1,458 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/currency/bitcoin"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+const (
+	electrumServerVersion  = "devnet electrum"
+	electrumVersion        = "1.4"
+	electrumMethodMissing  = -32601
+	electrumBadParameters  = -32602
+	electrumMaximumHeaders = 2016 // per blockchain.block.headers
+)
+
+// electrumServer - a fake Bitcoin chain served over the Electrum
+// protocol, every node's payment watcher follows it so payments are
+// verified by bitmarkd only when the test pays them
+type electrumServer struct {
+	sync.Mutex
+
+	listener     net.Listener
+	headers      []wire.BlockHeader           // by height
+	transactions map[string]string            // tx id → raw hex
+	heights      map[string]int64             // tx id → height of its block
+	history      map[string][]electrumHistory // script hash → its transactions
+	sessions     map[*electrumSession]struct{}
+}
+
+type electrumHistory struct {
+	TxHash string `json:"tx_hash"`
+	Height int64  `json:"height"`
+}
+
+type electrumHeader struct {
+	Height int64  `json:"height"`
+	Hex    string `json:"hex"`
+}
+
+type electrumHeaders struct {
+	Count int    `json:"count"`
+	Hex   string `json:"hex"`
+	Max   int    `json:"max"`
+}
+
+type electrumMerkle struct {
+	BlockHeight int64    `json:"block_height"`
+	Merkle      []string `json:"merkle"`
+	Pos         int      `json:"pos"`
+}
+
+type electrumRequest struct {
+	Id     *uint64           `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+}
+
+type electrumError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type electrumResponse struct {
+	JSONRPC string         `json:"jsonrpc"`
+	Id      uint64         `json:"id"`
+	Result  interface{}    `json:"result"`
+	Error   *electrumError `json:"error,omitempty"`
+}
+
+type electrumNotification struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+// one watcher connection and its subscriptions
+type electrumSession struct {
+	sync.Mutex // serialise writes
+
+	conn         net.Conn
+	encoder      *json.Encoder
+	headers      bool
+	scriptHashes map[string]struct{}
+}
+
+// start a server on a free loopback port with only a genesis block
+func newElectrumServer() (*electrumServer, error) {
+	listener, err := net.Listen("tcp", net.JoinHostPort(loopback, "0"))
+	if err != nil {
+		return nil, err
+	}
+
+	s := &electrumServer{
+		listener:     listener,
+		transactions: make(map[string]string),
+		heights:      make(map[string]int64),
+		history:      make(map[string][]electrumHistory),
+		sessions:     make(map[*electrumSession]struct{}),
+	}
+	genesis := wire.BlockHeader{
+		Version:   1,
+		Bits:      chaincfg.RegressionNetParams.PowLimitBits,
+		Timestamp: time.Unix(time.Now().Unix(), 0),
+	}
+	mine(&genesis)
+	s.headers = append(s.headers, genesis)
+
+	go s.accept()
+
+	return s, nil
+}
+
+// address - host:port the nodes connect to
+func (s *electrumServer) address() string {
+	return s.listener.Addr().String()
+}
+
+// close - stop listening and drop every watcher
+func (s *electrumServer) close() {
+	s.listener.Close()
+
+	s.Lock()
+	defer s.Unlock()
+	for session := range s.sessions {
+		session.conn.Close()
+	}
+}
+
+// pay - mine a block holding one transaction that makes every
+// Bitcoin payment with the pay id, returns the transaction id
+func (s *electrumServer) pay(payId pay.PayId, payments transactionrecord.PaymentAlternative) (string, error) {
+	tx := wire.NewMsgTx(wire.TxVersion)
+
+	// spend a made-up output, the watchers never look at inputs
+	var previous chainhash.Hash
+	if _, err := rand.Read(previous[:]); err != nil {
+		return "", err
+	}
+	tx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&previous, 0), nil, nil))
+
+	scriptHashes := make([]string, 0, len(payments))
+	for _, payment := range payments {
+		if payment.Currency != currency.Bitcoin {
+			return "", fault.MissingBitcoinPayment
+		}
+		script, err := outputScript(payment.Address)
+		if err != nil {
+			return "", err
+		}
+		tx.AddTxOut(wire.NewTxOut(int64(payment.Amount), script))
+		scriptHashes = append(scriptHashes, scriptHash(script))
+	}
+	if len(scriptHashes) == 0 {
+		return "", fault.MissingBitcoinPayment
+	}
+
+	// OP_RETURN OP_PUSHDATA(48) pay id
+	tx.AddTxOut(wire.NewTxOut(0, append([]byte{0x6a, byte(len(payId))}, payId[:]...)))
+
+	raw := bytes.Buffer{}
+	if err := tx.Serialize(&raw); err != nil {
+		return "", err
+	}
+	txId := tx.TxHash()
+
+	s.Lock()
+	defer s.Unlock()
+
+	previousHeader := s.headers[len(s.headers)-1]
+	header := wire.BlockHeader{
+		Version:    1,
+		PrevBlock:  previousHeader.BlockHash(),
+		MerkleRoot: txId,
+		Timestamp:  time.Unix(time.Now().Unix(), 0),
+		Bits:       chaincfg.RegressionNetParams.PowLimitBits,
+	}
+	mine(&header)
+	s.headers = append(s.headers, header)
+	height := int64(len(s.headers) - 1)
+
+	s.transactions[txId.String()] = hex.EncodeToString(raw.Bytes())
+	s.heights[txId.String()] = height
+	for _, h := range scriptHashes {
+		s.history[h] = append(s.history[h], electrumHistory{
+			TxHash: txId.String(),
+			Height: height,
+		})
+	}
+
+	// the new tip first, so the watchers count the confirmation
+	tip := s.tip()
+	for session := range s.sessions {
+		if session.headers {
+			session.notify("blockchain.headers.subscribe", tip)
+		}
+	}
+	for _, h := range scriptHashes {
+		status := s.status(h)
+		for session := range s.sessions {
+			if _, ok := session.scriptHashes[h]; ok {
+				session.notify("blockchain.scripthash.subscribe", h, status)
+			}
+		}
+	}
+
+	return txId.String(), nil
+}
+
+func (s *electrumServer) accept() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		session := &electrumSession{
+			conn:         conn,
+			encoder:      json.NewEncoder(conn),
+			scriptHashes: make(map[string]struct{}),
+		}
+		s.Lock()
+		s.sessions[session] = struct{}{}
+		s.Unlock()
+
+		go s.serve(session)
+	}
+}
+
+// answer requests until the watcher disconnects
+func (s *electrumServer) serve(session *electrumSession) {
+	decoder := json.NewDecoder(session.conn)
+	for {
+		var request electrumRequest
+		if err := decoder.Decode(&request); err != nil {
+			break
+		}
+		if request.Id == nil {
+			continue
+		}
+
+		s.Lock()
+		result, err := s.call(session, request.Method, request.Params)
+		s.Unlock()
+
+		session.reply(*request.Id, result, err)
+	}
+
+	s.Lock()
+	delete(s.sessions, session)
+	s.Unlock()
+	session.conn.Close()
+}
+
+// the result of a request, called with the server locked
+func (s *electrumServer) call(session *electrumSession, method string, params []json.RawMessage) (interface{}, *electrumError) {
+	switch method {
+
+	case "server.version":
+		return []string{electrumServerVersion, electrumVersion}, nil
+
+	case "blockchain.headers.subscribe":
+		session.headers = true
+		return s.tip(), nil
+
+	case "blockchain.scripthash.subscribe":
+		var h string
+		if len(params) != 1 || json.Unmarshal(params[0], &h) != nil {
+			return nil, badParameters(method)
+		}
+		session.scriptHashes[h] = struct{}{}
+		return s.status(h), nil
+
+	case "blockchain.scripthash.get_history":
+		var h string
+		if len(params) != 1 || json.Unmarshal(params[0], &h) != nil {
+			return nil, badParameters(method)
+		}
+		history := s.history[h]
+		if history == nil {
+			history = []electrumHistory{}
+		}
+		return history, nil
+
+	case "blockchain.block.header":
+		var height int64
+		if len(params) < 1 || json.Unmarshal(params[0], &height) != nil || height < 0 || height >= int64(len(s.headers)) {
+			return nil, badParameters(method)
+		}
+		return headerHex(&s.headers[height]), nil
+
+	case "blockchain.block.headers":
+		var start, count int64
+		if len(params) < 2 || json.Unmarshal(params[0], &start) != nil || json.Unmarshal(params[1], &count) != nil || start < 0 || count < 0 {
+			return nil, badParameters(method)
+		}
+		result := electrumHeaders{Max: electrumMaximumHeaders}
+		for h := start; h < start+count && h < int64(len(s.headers)) && result.Count < electrumMaximumHeaders; h += 1 {
+			result.Hex += headerHex(&s.headers[h])
+			result.Count += 1
+		}
+		return result, nil
+
+	case "blockchain.transaction.get":
+		var txId string
+		if len(params) < 1 || json.Unmarshal(params[0], &txId) != nil {
+			return nil, badParameters(method)
+		}
+		raw, ok := s.transactions[txId]
+		if !ok {
+			return nil, badParameters(method)
+		}
+		return raw, nil
+
+	case "blockchain.transaction.get_merkle":
+		var txId string
+		if len(params) < 1 || json.Unmarshal(params[0], &txId) != nil {
+			return nil, badParameters(method)
+		}
+		height, ok := s.heights[txId]
+		if !ok {
+			return nil, badParameters(method)
+		}
+		// the only transaction of its block is the Merkle root
+		return electrumMerkle{BlockHeight: height, Merkle: []string{}, Pos: 0}, nil
+
+	default:
+		return nil, &electrumError{
+			Code:    electrumMethodMissing,
+			Message: fmt.Sprintf("unknown method: %q", method),
+		}
+	}
+}
+
+// the highest block, called with the server locked
+func (s *electrumServer) tip() electrumHeader {
+	height := len(s.headers) - 1
+	return electrumHeader{
+		Height: int64(height),
+		Hex:    headerHex(&s.headers[height]),
+	}
+}
+
+// the Electrum status of a script hash: the SHA256 of its history,
+// nil without any, called with the server locked
+func (s *electrumServer) status(h string) *string {
+	history := s.history[h]
+	if len(history) == 0 {
+		return nil
+	}
+
+	buffer := bytes.Buffer{}
+	for _, item := range history {
+		fmt.Fprintf(&buffer, "%s:%d:", item.TxHash, item.Height)
+	}
+	digest := sha256.Sum256(buffer.Bytes())
+	status := hex.EncodeToString(digest[:])
+	return &status
+}
+
+func (session *electrumSession) reply(id uint64, result interface{}, err *electrumError) {
+	session.Lock()
+	defer session.Unlock()
+
+	_ = session.encoder.Encode(electrumResponse{
+		JSONRPC: "2.0",
+		Id:      id,
+		Result:  result,
+		Error:   err,
+	})
+}
+
+func (session *electrumSession) notify(method string, params ...interface{}) {
+	session.Lock()
+	defer session.Unlock()
+
+	_ = session.encoder.Encode(electrumNotification{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+	})
+}
+
+func badParameters(method string) *electrumError {
+	return &electrumError{
+		Code:    electrumBadParameters,
+		Message: fmt.Sprintf("invalid parameters for: %q", method),
+	}
+}
+
+// find a nonce for the header's bits, the regression network limit
+// is met by about one in two
+func mine(header *wire.BlockHeader) {
+	target := blockchain.CompactToBig(header.Bits)
+	for {
+		hash := header.BlockHash()
+		if blockchain.HashToBig(&hash).Cmp(target) <= 0 {
+			return
+		}
+		header.Nonce += 1
+	}
+}
+
+func headerHex(header *wire.BlockHeader) string {
+	buffer := bytes.Buffer{}
+	_ = header.Serialize(&buffer)
+	return hex.EncodeToString(buffer.Bytes())
+}
+
+// the output script paying a Bitcoin address
+func outputScript(address string) ([]byte, error) {
+	version, hash, err := bitcoin.ValidateAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	switch version {
+	case bitcoin.LivenetScript, bitcoin.TestnetScript:
+		// OP_HASH160 <hash> OP_EQUAL
+		script := append([]byte{0xa9, byte(len(hash))}, hash[:]...)
+		return append(script, 0x87), nil
+	default:
+		// OP_DUP OP_HASH160 <hash> OP_EQUALVERIFY OP_CHECKSIG
+		script := append([]byte{0x76, 0xa9, byte(len(hash))}, hash[:]...)
+		return append(script, 0x88, 0xac), nil
+	}
+}
+
+// the Electrum script hash: reversed SHA256 of the output script
+func scriptHash(script []byte) string {
+	digest := sha256.Sum256(script)
+	for i, j := 0, len(digest)-1; i < j; i, j = i+1, j-1 {
+		digest[i], digest[j] = digest[j], digest[i]
+	}
+	return hex.EncodeToString(digest[:])
+}
@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// a watcher connection to the fake server
+type testElectrumClient struct {
+	t       *testing.T
+	conn    net.Conn
+	encoder *json.Encoder
+	decoder *json.Decoder
+	nextId  uint64
+}
+
+type testElectrumMessage struct {
+	Id     *uint64           `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	Result json.RawMessage   `json:"result"`
+	Error  *electrumError    `json:"error"`
+}
+
+func newTestElectrumClient(t *testing.T, s *electrumServer) *testElectrumClient {
+	conn, err := net.Dial("tcp", s.address())
+	if err != nil {
+		t.Fatalf("dial error: %s", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+
+	return &testElectrumClient{
+		t:       t,
+		conn:    conn,
+		encoder: json.NewEncoder(conn),
+		decoder: json.NewDecoder(conn),
+	}
+}
+
+func (c *testElectrumClient) receive() *testElectrumMessage {
+	var m testElectrumMessage
+	if err := c.decoder.Decode(&m); err != nil {
+		c.t.Fatalf("receive error: %s", err)
+	}
+	return &m
+}
+
+// send a request and decode its result
+func (c *testElectrumClient) call(result interface{}, method string, params ...interface{}) *electrumError {
+	id := c.nextId
+	c.nextId += 1
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	err := c.encoder.Encode(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"method":  method,
+		"params":  params,
+	})
+	if err != nil {
+		c.t.Fatalf("send error: %s", err)
+	}
+
+	m := c.receive()
+	if m.Id == nil || *m.Id != id {
+		c.t.Fatalf("%s: not a response: %+v", method, m)
+	}
+	if m.Error != nil {
+		return m.Error
+	}
+	if err := json.Unmarshal(m.Result, result); err != nil {
+		c.t.Fatalf("%s: result: %s  error: %s", method, m.Result, err)
+	}
+	return nil
+}
+
+func TestElectrumServer(t *testing.T) {
+	s, err := newElectrumServer()
+	assert.Nil(t, err, "wrong server error")
+	defer s.close()
+
+	c := newTestElectrumClient(t, s)
+
+	var version []string
+	assert.Nil(t, c.call(&version, "server.version", "test", "1.4"), "wrong version error")
+	assert.Equal(t, []string{electrumServerVersion, electrumVersion}, version, "wrong version")
+
+	var tip electrumHeader
+	assert.Nil(t, c.call(&tip, "blockchain.headers.subscribe"), "wrong headers error")
+	assert.Equal(t, int64(0), tip.Height, "wrong genesis height")
+
+	script, err := outputScript(testBitcoinAddress)
+	assert.Nil(t, err, "wrong script error")
+	h := scriptHash(script)
+
+	var status *string
+	assert.Nil(t, c.call(&status, "blockchain.scripthash.subscribe", h), "wrong subscribe error")
+	assert.Nil(t, status, "status without history")
+
+	var payId pay.PayId
+	for i := range payId {
+		payId[i] = byte(i)
+	}
+	txId, err := s.pay(payId, transactionrecord.PaymentAlternative{
+		{Currency: currency.Bitcoin, Address: testBitcoinAddress, Amount: 25000},
+	})
+	assert.Nil(t, err, "wrong pay error")
+
+	// the new tip, then the changed address
+	m := c.receive()
+	assert.Equal(t, "blockchain.headers.subscribe", m.Method, "wrong first notification")
+	assert.Nil(t, json.Unmarshal(m.Params[0], &tip), "wrong tip")
+	assert.Equal(t, int64(1), tip.Height, "wrong tip height")
+
+	m = c.receive()
+	assert.Equal(t, "blockchain.scripthash.subscribe", m.Method, "wrong second notification")
+	assert.Nil(t, json.Unmarshal(m.Params[1], &status), "wrong status")
+	assert.NotNil(t, status, "missing status")
+
+	var history []electrumHistory
+	assert.Nil(t, c.call(&history, "blockchain.scripthash.get_history", h), "wrong history error")
+	assert.Equal(t, []electrumHistory{{TxHash: txId, Height: 1}}, history, "wrong history")
+
+	var rawHeader string
+	assert.Nil(t, c.call(&rawHeader, "blockchain.block.header", 1), "wrong header error")
+	header := wire.BlockHeader{}
+	data, _ := hex.DecodeString(rawHeader)
+	assert.Nil(t, header.Deserialize(bytes.NewReader(data)), "wrong header")
+	assert.Equal(t, txId, header.MerkleRoot.String(), "wrong merkle root")
+	assert.Equal(t, s.headers[0].BlockHash(), header.PrevBlock, "wrong previous block")
+	hash := header.BlockHash()
+	assert.True(t, blockchain.HashToBig(&hash).Cmp(blockchain.CompactToBig(header.Bits)) <= 0, "header not mined")
+
+	var headers electrumHeaders
+	assert.Nil(t, c.call(&headers, "blockchain.block.headers", 0, 5), "wrong headers error")
+	assert.Equal(t, 2, headers.Count, "wrong header count")
+	assert.Equal(t, headerHex(&s.headers[0])+rawHeader, headers.Hex, "wrong headers")
+
+	var proof electrumMerkle
+	assert.Nil(t, c.call(&proof, "blockchain.transaction.get_merkle", txId, 1), "wrong merkle error")
+	assert.Equal(t, electrumMerkle{BlockHeight: 1, Merkle: []string{}, Pos: 0}, proof, "wrong merkle branch")
+
+	var rawTx string
+	assert.Nil(t, c.call(&rawTx, "blockchain.transaction.get", txId), "wrong transaction error")
+	tx := wire.MsgTx{}
+	data, _ = hex.DecodeString(rawTx)
+	assert.Nil(t, tx.Deserialize(bytes.NewReader(data)), "wrong transaction")
+	assert.Equal(t, txId, tx.TxHash().String(), "wrong transaction id")
+	assert.Equal(t, 2, len(tx.TxOut), "wrong output count")
+	assert.Equal(t, int64(25000), tx.TxOut[0].Value, "wrong amount")
+	assert.Equal(t, script, tx.TxOut[0].PkScript, "wrong payee")
+	assert.Equal(t, append([]byte{0x6a, 0x30}, payId[:]...), tx.TxOut[1].PkScript, "wrong pay id output")
+
+	assert.NotNil(t, c.call(&rawHeader, "blockchain.block.header", 2), "header above tip")
+	assert.NotNil(t, c.call(&rawTx, "blockchain.unknown"), "unknown method")
+}
+
+func TestElectrumServerWhenNotBitcoin(t *testing.T) {
+	s, err := newElectrumServer()
+	assert.Nil(t, err, "wrong server error")
+	defer s.close()
+
+	_, err = s.pay(pay.PayId{}, transactionrecord.PaymentAlternative{
+		{Currency: currency.Litecoin, Address: testLitecoinAddress, Amount: 25000},
+	})
+	assert.Equal(t, fault.MissingBitcoinPayment, err, "wrong currency error")
+
+	_, err = s.pay(pay.PayId{}, transactionrecord.PaymentAlternative{})
+	assert.Equal(t, fault.MissingBitcoinPayment, err, "wrong empty error")
+	assert.Equal(t, 1, len(s.headers), "block added")
+}
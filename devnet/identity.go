@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/certgen"
+)
+
+// key tags, as written by bitmarkd gen-peer-identity
+const (
+	taggedPublic  = "PUBLIC:"
+	taggedPrivate = "PRIVATE:"
+	taggedSeed    = "SEED:"
+)
+
+// the keys and certificate of a node
+type identity struct {
+	peerPublicKey   string
+	peerPrivateKey  string
+	proofPublicKey  string
+	proofPrivateKey string
+	signingKey      string
+	certificate     string
+	certificateKey  string
+}
+
+// create a new identity, the same as the bitmarkd setup commands but
+// without needing the ZeroMQ library
+func newIdentity() (*identity, error) {
+	peerPublicKey, peerPrivateKey, err := makeCurveKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	proofPublicKey, proofPrivateKey, err := makeCurveKeyPair()
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := account.NewBase58EncodedSeedV2(true)
+	if err != nil {
+		return nil, err
+	}
+
+	validUntil := time.Now().Add(365 * 24 * time.Hour)
+	certificate, key, err := certgen.NewTLSCertPair("bitmarkd devnet", validUntil, true, []string{loopback})
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity{
+		peerPublicKey:   peerPublicKey,
+		peerPrivateKey:  peerPrivateKey,
+		proofPublicKey:  proofPublicKey,
+		proofPrivateKey: proofPrivateKey,
+		signingKey:      taggedSeed + seed,
+		certificate:     string(certificate),
+		certificateKey:  string(key),
+	}, nil
+}
+
+// a tagged hex CurveZMQ key pair
+func makeCurveKeyPair() (string, string, error) {
+	privateKey := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(privateKey); err != nil {
+		return "", "", err
+	}
+
+	publicKey, err := curve25519.X25519(privateKey, curve25519.Basepoint)
+	if err != nil {
+		return "", "", err
+	}
+
+	return taggedPublic + hex.EncodeToString(publicKey), taggedPrivate + hex.EncodeToString(privateKey), nil
+}
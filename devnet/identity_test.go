@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"crypto/tls"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/curve25519"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/zmqutil"
+)
+
+func TestNewIdentity(t *testing.T) {
+	id, err := newIdentity()
+	assert.Nil(t, err, "wrong identity error")
+
+	publicKey, err := zmqutil.ReadPublicKey(id.peerPublicKey)
+	assert.Nil(t, err, "wrong public key error")
+	privateKey, err := zmqutil.ReadPrivateKey(id.peerPrivateKey)
+	assert.Nil(t, err, "wrong private key error")
+
+	expected, _ := curve25519.X25519(privateKey, curve25519.Basepoint)
+	assert.Equal(t, expected, publicKey, "keys are not a pair")
+
+	proofKey, err := zmqutil.ReadPublicKey(id.proofPublicKey)
+	assert.Nil(t, err, "wrong proof key error")
+	assert.NotEqual(t, publicKey, proofKey, "proof key is the peer key")
+
+	assert.True(t, strings.HasPrefix(id.signingKey, taggedSeed), "untagged signing key")
+	signingKey, err := account.PrivateKeyFromBase58Seed(id.signingKey[len(taggedSeed):])
+	assert.Nil(t, err, "wrong signing key error")
+	assert.True(t, signingKey.IsTesting(), "signing key is not testing")
+
+	_, err = tls.X509KeyPair([]byte(id.certificate), []byte(id.certificateKey))
+	assert.Nil(t, err, "wrong certificate error")
+}
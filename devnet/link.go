@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// link - a loopback TCP relay carrying the peer connections of one
+// node to another, cutting it partitions the running nodes without
+// restarting them
+type link struct {
+	sync.Mutex
+
+	listener net.Listener
+	target   string // host:port of the destination node's peer listener
+	cut      bool
+	conns    map[net.Conn]struct{}
+}
+
+// listen on a free loopback port for connections to the target
+func newLink(target string) (*link, error) {
+	listener, err := net.Listen("tcp", net.JoinHostPort(loopback, "0"))
+	if err != nil {
+		return nil, err
+	}
+
+	l := &link{
+		listener: listener,
+		target:   target,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	go l.accept()
+
+	return l, nil
+}
+
+// address - host:port the source node connects to
+func (l *link) address() string {
+	return l.listener.Addr().String()
+}
+
+// setCut - drop every relayed connection and refuse new ones, or
+// accept them again
+func (l *link) setCut(cut bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	l.cut = cut
+	if cut {
+		for conn := range l.conns {
+			conn.Close()
+		}
+	}
+}
+
+// close - stop listening and drop every relayed connection
+func (l *link) close() {
+	l.listener.Close()
+	l.setCut(true)
+}
+
+func (l *link) accept() {
+	for {
+		conn, err := l.listener.Accept()
+		if err != nil {
+			return
+		}
+		go l.relay(conn)
+	}
+}
+
+// copy data both ways until either side closes or the link is cut
+func (l *link) relay(in net.Conn) {
+	l.Lock()
+	if l.cut {
+		l.Unlock()
+		in.Close()
+		return
+	}
+	out, err := net.Dial("tcp", l.target)
+	if err != nil {
+		l.Unlock()
+		in.Close()
+		return
+	}
+	l.conns[in] = struct{}{}
+	l.conns[out] = struct{}{}
+	l.Unlock()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		_, _ = io.Copy(out, in)
+		done <- struct{}{}
+	}()
+	go func() {
+		_, _ = io.Copy(in, out)
+		done <- struct{}{}
+	}()
+	<-done
+
+	in.Close()
+	out.Close()
+
+	l.Lock()
+	delete(l.conns, in)
+	delete(l.conns, out)
+	l.Unlock()
+}
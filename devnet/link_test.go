@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// a loopback server that echoes each line
+func newEchoServer(t *testing.T) net.Listener {
+	listener, err := net.Listen("tcp", net.JoinHostPort(loopback, "0"))
+	if err != nil {
+		t.Fatalf("listen error: %s", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, _ = io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+	return listener
+}
+
+// send a line through the link and read it back
+func echo(l *link, line string) error {
+	conn, err := net.DialTimeout("tcp", l.address(), time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	return echoOn(conn, line)
+}
+
+func echoOn(conn net.Conn, line string) error {
+	_ = conn.SetDeadline(time.Now().Add(time.Second))
+	if _, err := conn.Write([]byte(line + "\n")); err != nil {
+		return err
+	}
+	reply, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if reply != line+"\n" {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func TestLink(t *testing.T) {
+	server := newEchoServer(t)
+
+	l, err := newLink(server.Addr().String())
+	assert.Nil(t, err, "wrong link error")
+	defer l.close()
+
+	assert.Nil(t, echo(l, "joined"), "joined link did not relay")
+
+	// an open connection is dropped when cut
+	conn, err := net.Dial("tcp", l.address())
+	assert.Nil(t, err, "wrong dial error")
+	defer conn.Close()
+	assert.Nil(t, echoOn(conn, "before"), "open connection did not relay")
+
+	l.setCut(true)
+	assert.NotNil(t, echoOn(conn, "after"), "cut connection relayed")
+	assert.NotNil(t, echo(l, "cut"), "cut link relayed")
+
+	l.setCut(false)
+	assert.Nil(t, echo(l, "rejoined"), "rejoined link did not relay")
+}
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// a Lua table with named fields
+type table map[string]interface{}
+
+// a Lua table as an array
+type array []interface{}
+
+// render a configuration file that returns the table
+func renderConfiguration(t table) string {
+	b := &strings.Builder{}
+	b.WriteString("-- bitmarkd.conf  -*- mode: lua -*-\n")
+	b.WriteString("-- generated by devnet: do not edit\n\n")
+	b.WriteString("return ")
+	writeValue(b, t, 0)
+	b.WriteString("\n")
+	return b.String()
+}
+
+func writeValue(b *strings.Builder, value interface{}, depth int) {
+	indent := strings.Repeat("    ", depth+1)
+	switch v := value.(type) {
+	case table:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteString("{\n")
+		for _, k := range keys {
+			b.WriteString(indent)
+			b.WriteString(k)
+			b.WriteString(" = ")
+			writeValue(b, v[k], depth+1)
+			b.WriteString(",\n")
+		}
+		b.WriteString(indent[4:])
+		b.WriteString("}")
+
+	case array:
+		b.WriteString("{\n")
+		for _, item := range v {
+			b.WriteString(indent)
+			writeValue(b, item, depth+1)
+			b.WriteString(",\n")
+		}
+		b.WriteString(indent[4:])
+		b.WriteString("}")
+
+	case []string:
+		a := make(array, len(v))
+		for i, s := range v {
+			a[i] = s
+		}
+		writeValue(b, a, depth)
+
+	case string:
+		b.WriteString(strconv.Quote(v))
+
+	case bool:
+		b.WriteString(strconv.FormatBool(v))
+
+	case int:
+		b.WriteString(strconv.Itoa(v))
+
+	default:
+		panic(fmt.Sprintf("devnet: unsupported configuration value: %T", value))
+	}
+}
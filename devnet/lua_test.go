@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/configuration"
+)
+
+func TestRenderConfiguration(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "test.conf")
+
+	data := renderConfiguration(table{
+		"name":    "quoted \"text\"\nsecond line",
+		"enabled": true,
+		"count":   3,
+		"list":    []string{"a", "b"},
+		"nested": table{
+			"items": array{
+				table{"key": "k1"},
+				table{"key": "k2"},
+			},
+		},
+	})
+	err := ioutil.WriteFile(fileName, []byte(data), 0o600)
+	assert.Nil(t, err, "wrong write error")
+
+	type item struct {
+		Key string `gluamapper:"key"`
+	}
+	result := struct {
+		Name    string   `gluamapper:"name"`
+		Enabled bool     `gluamapper:"enabled"`
+		Count   int      `gluamapper:"count"`
+		List    []string `gluamapper:"list"`
+		Nested  struct {
+			Items []item `gluamapper:"items"`
+		} `gluamapper:"nested"`
+	}{}
+
+	err = configuration.ParseConfigurationFile(fileName, &result)
+	assert.Nil(t, err, "wrong parse error")
+	assert.Equal(t, "quoted \"text\"\nsecond line", result.Name, "wrong string")
+	assert.True(t, result.Enabled, "wrong bool")
+	assert.Equal(t, 3, result.Count, "wrong int")
+	assert.Equal(t, []string{"a", "b"}, result.List, "wrong list")
+	assert.Equal(t, []item{{Key: "k1"}, {Key: "k2"}}, result.Nested.Items, "wrong nested table")
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"syscall"
+	"time"
+
+	zmq "github.com/pebbe/zmq4"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/proof"
+	"github.com/bitmark-inc/bitmarkd/zmqutil"
+)
+
+// reply of the proof submission socket
+type submissionReply struct {
+	Job string `json:"job"`
+	OK  bool   `json:"ok"`
+}
+
+// Mine - wait for the node to publish a block holding all of the
+// transactions, solve it and wait until the node stores it, returns
+// the block number
+//
+// the harness is the only miner, so a block holds exactly the
+// transactions verified when its job was published, and none is mined
+// without a call
+func (n *Node) Mine(txIds []merkle.Digest, timeout time.Duration) (uint64, error) {
+	if !n.IsRunning() {
+		return 0, fault.NodeIsNotRunning
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	job, err := n.waitForJob(txIds, deadline)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := n.submit(job, deadline); err != nil {
+		return 0, err
+	}
+
+	height := job.Header.Number
+	if err := n.WaitForHeight(height, time.Until(deadline)); err != nil {
+		return 0, err
+	}
+	return height, nil
+}
+
+// receive published jobs until one holds every transaction
+func (n *Node) waitForJob(txIds []merkle.Digest, deadline time.Time) (*proof.PublishedItem, error) {
+	socket, err := n.proofSocket(zmq.SUB, n.ports.proofPublish)
+	if err != nil {
+		return nil, err
+	}
+	defer socket.Close()
+
+	if err := socket.SetSubscribe(""); err != nil {
+		return nil, err
+	}
+
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return nil, fault.TimeoutWaitingForJob
+		}
+		if err := socket.SetRcvtimeo(remaining); err != nil {
+			return nil, err
+		}
+
+		data, err := socket.RecvBytes(0)
+		if zmq.AsErrno(err) == zmq.Errno(syscall.EAGAIN) {
+			return nil, fault.TimeoutWaitingForJob
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var job proof.PublishedItem
+		if err := json.Unmarshal(data, &job); err != nil {
+			return nil, err
+		}
+		if holdsAll(job.TxIds, txIds) {
+			return &job, nil
+		}
+	}
+}
+
+// send the job back with its own nonce, which the local chain accepts
+func (n *Node) submit(job *proof.PublishedItem, deadline time.Time) error {
+	socket, err := n.proofSocket(zmq.REQ, n.ports.proofSubmit)
+	if err != nil {
+		return err
+	}
+	defer socket.Close()
+
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		return fault.TimeoutWaitingForJob
+	}
+	if err := socket.SetSndtimeo(remaining); err != nil {
+		return err
+	}
+	if err := socket.SetRcvtimeo(remaining); err != nil {
+		return err
+	}
+
+	packed := make([]byte, 8)
+	binary.LittleEndian.PutUint64(packed, uint64(job.Header.Nonce))
+
+	request, err := json.Marshal(proof.SubmittedItem{
+		Request: "block.nonce",
+		Job:     job.Job,
+		Packed:  packed,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := socket.SendBytes(request, 0); err != nil {
+		return err
+	}
+
+	data, err := socket.RecvBytes(0)
+	if zmq.AsErrno(err) == zmq.Errno(syscall.EAGAIN) {
+		return fault.TimeoutWaitingForJob
+	}
+	if err != nil {
+		return err
+	}
+
+	var reply submissionReply
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return err
+	}
+	if !reply.OK {
+		return fault.JobRejected
+	}
+	return nil
+}
+
+// a CurveZMQ client of one of the node's proof sockets
+func (n *Node) proofSocket(socketType zmq.Type, port int) (*zmq.Socket, error) {
+	serverPublicKey, err := zmqutil.ReadPublicKey(n.identity.proofPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, privateKey, err := zmq.NewCurveKeypair()
+	if err != nil {
+		return nil, err
+	}
+
+	socket, err := zmq.NewSocket(socketType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, set := range []func() error{
+		func() error { return socket.SetLinger(0) },
+		func() error { return socket.SetCurveServer(0) },
+		func() error { return socket.SetCurvePublickey(publicKey) },
+		func() error { return socket.SetCurveSecretkey(privateKey) },
+		func() error { return socket.SetCurveServerkey(string(serverPublicKey)) },
+		func() error { return socket.Connect("tcp://" + address(port)) },
+	} {
+		if err := set(); err != nil {
+			socket.Close()
+			return nil, err
+		}
+	}
+	return socket, nil
+}
+
+// true if every wanted transaction is in the list
+func holdsAll(txIds []merkle.Digest, wanted []merkle.Digest) bool {
+	present := make(map[merkle.Digest]struct{}, len(txIds))
+	for _, txId := range txIds {
+		present[txId] = struct{}{}
+	}
+	for _, txId := range wanted {
+		if _, ok := present[txId]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,254 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+// the bitmarkd program built by Build
+const bitmarkdPackage = "github.com/bitmark-inc/bitmarkd/command/bitmarkd"
+
+// Configuration - options for a devnet
+type Configuration struct {
+	Binary    string // the bitmarkd program
+	Directory string // node directories are created here
+	Nodes     int    // number of nodes
+	LogLevel  string // bitmarkd DEFAULT log level, default: error
+}
+
+// Network - a set of nodes on loopback
+type Network struct {
+	sync.Mutex
+
+	nodes    []*Node
+	links    [][]*link // [i][j] carries the connections of node i to node j
+	electrum *electrumServer
+}
+
+// Build - compile bitmarkd into the directory, returns the program
+func Build(directory string) (string, error) {
+	binary := filepath.Join(directory, "bitmarkd")
+	cmd := exec.Command("go", "build", "-o", binary, bitmarkdPackage)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("build: %s  output: %s", err, output)
+	}
+	return binary, nil
+}
+
+// New - create the nodes, their identities and configuration files,
+// all nodes are connected to each other through links, and all follow
+// the same fake Electrum chain for Bitcoin payments
+func New(configuration Configuration) (*Network, error) {
+	if configuration.Nodes < 1 {
+		return nil, fault.InvalidCount
+	}
+	if configuration.Binary == "" {
+		return nil, fault.MissingBinary
+	}
+
+	logLevel := configuration.LogLevel
+	if logLevel == "" {
+		logLevel = defaultLogLevel
+	}
+
+	electrum, err := newElectrumServer()
+	if err != nil {
+		return nil, err
+	}
+
+	network := &Network{
+		nodes:    make([]*Node, configuration.Nodes),
+		links:    make([][]*link, configuration.Nodes),
+		electrum: electrum,
+	}
+	for i := range network.nodes {
+		directory := filepath.Join(configuration.Directory, fmt.Sprintf("node%d", i))
+		n, err := newNode(i, configuration.Binary, directory, logLevel, electrum.address())
+		if err != nil {
+			network.close()
+			return nil, err
+		}
+		network.nodes[i] = n
+		network.links[i] = make([]*link, configuration.Nodes)
+	}
+
+	for i, n := range network.nodes {
+		for j, peer := range network.nodes {
+			if i == j {
+				continue
+			}
+			l, err := newLink(address(peer.ports.peer))
+			if err != nil {
+				network.close()
+				return nil, err
+			}
+			network.links[i][j] = l
+			n.peers = append(n.peers, peerLink{node: peer, link: l})
+		}
+	}
+
+	for _, n := range network.nodes {
+		if err := n.writeConfiguration(); err != nil {
+			network.close()
+			return nil, err
+		}
+	}
+
+	return network, nil
+}
+
+// Node - a node by index, nil if out of range
+func (network *Network) Node(i int) *Node {
+	if i < 0 || i >= len(network.nodes) {
+		return nil
+	}
+	return network.nodes[i]
+}
+
+// Nodes - all of the nodes
+func (network *Network) Nodes() []*Node {
+	return network.nodes
+}
+
+// Start - start all nodes
+func (network *Network) Start() error {
+	network.Lock()
+	defer network.Unlock()
+
+	for _, n := range network.nodes {
+		if err := n.Start(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stop - stop all running nodes, then close the links and the
+// Electrum server, the network cannot be started again
+func (network *Network) Stop() {
+	network.Lock()
+	defer network.Unlock()
+
+	for _, n := range network.nodes {
+		_ = n.Stop()
+	}
+	network.close()
+}
+
+func (network *Network) close() {
+	for _, links := range network.links {
+		for _, l := range links {
+			if l != nil {
+				l.close()
+			}
+		}
+	}
+	network.electrum.close()
+}
+
+// Pay - make the Bitcoin payment for a transaction on the fake chain
+// in a new block, returns the Bitcoin transaction id
+//
+// each node verifies the payment when its watcher next subscribes to
+// the payment address, within half a minute
+func (network *Network) Pay(payment *Payment) (string, error) {
+	if payment == nil {
+		return "", fault.MissingBitcoinPayment
+	}
+	alternative, ok := payment.Payments[currency.Bitcoin.String()]
+	if !ok {
+		return "", fault.MissingBitcoinPayment
+	}
+	return network.electrum.pay(payment.PayId, alternative)
+}
+
+// Partition - split the network into groups of node indices, nodes in
+// different groups cannot connect, any node not listed is in a group of
+// its own
+//
+// the links between groups are cut, so running nodes keep their state
+// and a node left without peers stops synchronising until healed
+func (network *Network) Partition(groups ...[]int) error {
+	network.Lock()
+	defer network.Unlock()
+
+	group := make(map[int]int)
+	for g, members := range groups {
+		for _, i := range members {
+			if i < 0 || i >= len(network.nodes) {
+				return fault.NodeIndexOutOfRange
+			}
+			if _, ok := group[i]; ok {
+				return fault.InvalidPartition
+			}
+			group[i] = g
+		}
+	}
+	for i := range network.nodes {
+		if _, ok := group[i]; !ok {
+			group[i] = len(groups) + i
+		}
+	}
+
+	network.cut(func(i int, j int) bool {
+		return group[i] != group[j]
+	})
+	return nil
+}
+
+// Heal - rejoin every link
+func (network *Network) Heal() {
+	network.Lock()
+	defer network.Unlock()
+
+	network.cut(func(i int, j int) bool {
+		return false
+	})
+}
+
+// cut or rejoin each link between two nodes
+func (network *Network) cut(apart func(i int, j int) bool) {
+	for i, links := range network.links {
+		for j, l := range links {
+			if l != nil {
+				l.setCut(apart(i, j))
+			}
+		}
+	}
+}
+
+// true if the link from one node to another is cut
+func (network *Network) isCut(from int, to int) bool {
+	network.Lock()
+	defer network.Unlock()
+
+	l := network.links[from][to]
+	l.Lock()
+	defer l.Unlock()
+	return l.cut
+}
+
+// WaitForHeight - wait until every running node has a block at the height
+func (network *Network) WaitForHeight(height uint64, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for _, n := range network.nodes {
+		if !n.IsRunning() {
+			continue
+		}
+		if err := n.WaitForHeight(height, time.Until(deadline)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,265 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/configuration"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/peer"
+	"github.com/bitmark-inc/bitmarkd/proof"
+)
+
+// the parts of the bitmarkd configuration set by the harness
+type testConfiguration struct {
+	Chain    string              `gluamapper:"chain"`
+	Peering  peer.Configuration  `gluamapper:"peering"`
+	Proofing proof.Configuration `gluamapper:"proofing"`
+	Payment  struct {
+		Mode     string `gluamapper:"mode"`
+		Electrum struct {
+			Bitcoin struct {
+				Server string `gluamapper:"server"`
+			} `gluamapper:"bitcoin"`
+		} `gluamapper:"electrum"`
+	} `gluamapper:"payment"`
+}
+
+func readTestConfiguration(t *testing.T, n *Node) *testConfiguration {
+	c := &testConfiguration{}
+	err := configuration.ParseConfigurationFile(n.ConfigurationFile(), c)
+	assert.Nil(t, err, "wrong parse error")
+	return c
+}
+
+func newTestNetwork(t *testing.T, nodes int) *Network {
+	network, err := New(Configuration{
+		Binary:    "bitmarkd",
+		Directory: t.TempDir(),
+		Nodes:     nodes,
+	})
+	assert.Nil(t, err, "wrong new error")
+	t.Cleanup(network.Stop)
+	return network
+}
+
+func TestNew(t *testing.T) {
+	network := newTestNetwork(t, 3)
+	assert.Equal(t, 3, len(network.Nodes()), "wrong node count")
+	assert.Nil(t, network.Node(3), "node out of range")
+
+	for i, n := range network.Nodes() {
+		c := readTestConfiguration(t, n)
+		assert.Equal(t, chain.Local, c.Chain, "%d: wrong chain", i)
+		assert.True(t, c.Peering.Private, "%d: not private", i)
+		assert.Equal(t, 2, len(c.Peering.Connect), "%d: wrong connections", i)
+		assert.Equal(t, 2, len(c.Peering.Allow), "%d: wrong allowed keys", i)
+		assert.Equal(t, 1, c.Peering.MinimumConnections, "%d: wrong minimum connections", i)
+		assert.Equal(t, n.PublicKey(), c.Peering.PublicKey, "%d: wrong public key", i)
+		assert.False(t, c.Proofing.InternalHashEnable, "%d: internal hasher", i)
+		assert.Equal(t, "electrum", c.Payment.Mode, "%d: wrong payment mode", i)
+		assert.Equal(t, network.electrum.address(), c.Payment.Electrum.Bitcoin.Server, "%d: wrong electrum server", i)
+		assert.False(t, n.IsRunning(), "%d: running", i)
+	}
+
+	// connections pass through the links
+	c := readTestConfiguration(t, network.Node(0))
+	assert.Equal(t, network.Node(1).PublicKey(), c.Peering.Connect[0].PublicKey, "wrong connect key")
+	assert.Equal(t, network.links[0][1].address(), c.Peering.Connect[0].Address, "wrong connect address")
+	assert.Equal(t, network.Node(1).ports.peer, portOf(t, network.links[0][1].target), "wrong link target")
+	assert.Equal(t, network.Node(2).PublicKey(), c.Peering.Allow[1], "wrong allowed key")
+}
+
+func portOf(t *testing.T, hostPort string) int {
+	_, port, err := net.SplitHostPort(hostPort)
+	assert.Nil(t, err, "wrong address")
+	p, err := strconv.Atoi(port)
+	assert.Nil(t, err, "wrong port")
+	return p
+}
+
+func TestNewWhenInvalid(t *testing.T) {
+	_, err := New(Configuration{Binary: "bitmarkd", Directory: t.TempDir(), Nodes: 0})
+	assert.Equal(t, fault.InvalidCount, err, "wrong count error")
+
+	_, err = New(Configuration{Directory: t.TempDir(), Nodes: 1})
+	assert.Equal(t, fault.MissingBinary, err, "wrong binary error")
+}
+
+func TestPartitionAndHeal(t *testing.T) {
+	network := newTestNetwork(t, 4)
+
+	err := network.Partition([]int{0, 1}, []int{2})
+	assert.Nil(t, err, "wrong partition error")
+
+	for i := range network.Nodes() {
+		for j := range network.Nodes() {
+			if i == j {
+				continue
+			}
+			together := i < 2 && j < 2
+			assert.Equal(t, !together, network.isCut(i, j), "%d→%d: wrong cut", i, j)
+		}
+	}
+
+	// the configuration is never rewritten
+	c := readTestConfiguration(t, network.Node(3))
+	assert.Equal(t, 3, len(c.Peering.Connect), "wrong connections")
+
+	network.Heal()
+
+	for i := range network.Nodes() {
+		for j := range network.Nodes() {
+			if i != j {
+				assert.False(t, network.isCut(i, j), "%d→%d: cut", i, j)
+			}
+		}
+	}
+}
+
+func TestPartitionWhenInvalid(t *testing.T) {
+	network := newTestNetwork(t, 3)
+
+	err := network.Partition([]int{0, 1}, []int{1, 2})
+	assert.Equal(t, fault.InvalidPartition, err, "wrong duplicate error")
+
+	err = network.Partition([]int{0, 3})
+	assert.Equal(t, fault.NodeIndexOutOfRange, err, "wrong range error")
+}
+
+func TestMineWhenNotRunning(t *testing.T) {
+	network := newTestNetwork(t, 3)
+
+	_, err := network.Node(1).Mine(nil, time.Second)
+	assert.Equal(t, fault.NodeIsNotRunning, err, "wrong mining error")
+
+	err = network.Node(0).Stop()
+	assert.Equal(t, fault.NodeIsNotRunning, err, "wrong stop error")
+}
+
+func TestPayWhenNotBitcoin(t *testing.T) {
+	network := newTestNetwork(t, 1)
+
+	_, err := network.Pay(&Payment{})
+	assert.Equal(t, fault.MissingBitcoinPayment, err, "wrong payment error")
+
+	_, err = network.Pay(nil)
+	assert.Equal(t, fault.MissingBitcoinPayment, err, "wrong missing payment error")
+}
+
+// end-to-end test against a real bitmarkd, the program in BITMARKD or
+// one built for the test, only run if DEVNET is set
+func TestNetwork(t *testing.T) {
+	if os.Getenv("DEVNET") == "" {
+		t.Skip("end-to-end test: set DEVNET=1 to run")
+	}
+
+	binary := os.Getenv("BITMARKD")
+	if binary == "" {
+		var err error
+		binary, err = Build(t.TempDir())
+		if err != nil {
+			t.Fatalf("build error: %s", err)
+		}
+	}
+
+	network, err := New(Configuration{
+		Binary:    binary,
+		Directory: t.TempDir(),
+		Nodes:     3,
+	})
+	if err != nil {
+		t.Fatalf("new error: %s", err)
+	}
+
+	if err := network.Start(); err != nil {
+		t.Fatalf("start error: %s", err)
+	}
+	defer network.Stop()
+
+	owner, err := NewAccount()
+	assert.Nil(t, err, "wrong account error")
+
+	// free issue, verified by its proof
+	assetId, issueId, err := network.Node(1).Create(owner, "devnet asset")
+	if err != nil {
+		t.Fatalf("create error: %s", err)
+	}
+
+	height, err := network.Node(0).Mine([]merkle.Digest{issueId}, 2*time.Minute)
+	if err != nil {
+		t.Fatalf("mine error: %s", err)
+	}
+	err = network.WaitForHeight(height, time.Minute)
+	assert.Nil(t, err, "network did not synchronise")
+
+	status, err := network.Node(2).TransactionStatus(issueId)
+	assert.Nil(t, err, "wrong status error")
+	assert.Equal(t, "Confirmed", status, "issue not confirmed")
+
+	// paid issues stay pending until paid on the fake chain
+	txIds, payment, err := network.Node(1).Issue(owner, assetId, 2)
+	if err != nil {
+		t.Fatalf("issue error: %s", err)
+	}
+	assert.Equal(t, 2, len(txIds), "wrong issue count")
+
+	status, err = network.Node(1).TransactionStatus(txIds[0])
+	assert.Nil(t, err, "wrong status error")
+	assert.Equal(t, "Pending", status, "unpaid issue not pending")
+
+	_, err = network.Pay(payment)
+	assert.Nil(t, err, "wrong pay error")
+	err = network.Node(0).WaitForStatus(txIds[1], "Verified", time.Minute)
+	assert.Nil(t, err, "paid issue not verified")
+
+	height, err = network.Node(0).Mine(txIds, 2*time.Minute)
+	if err != nil {
+		t.Fatalf("mine error: %s", err)
+	}
+	err = network.WaitForHeight(height, time.Minute)
+	assert.Nil(t, err, "network did not synchronise")
+
+	// the isolated node falls behind while the others mine
+	err = network.Partition([]int{0, 1})
+	assert.Nil(t, err, "wrong partition error")
+
+	isolated, err := network.Node(2).Height()
+	assert.Nil(t, err, "wrong height error")
+
+	_, issueId, err = network.Node(1).Create(owner, "partitioned asset")
+	if err != nil {
+		t.Fatalf("create error: %s", err)
+	}
+	height, err = network.Node(0).Mine([]merkle.Digest{issueId}, 2*time.Minute)
+	if err != nil {
+		t.Fatalf("mine error: %s", err)
+	}
+	err = network.Node(1).WaitForHeight(height, time.Minute)
+	assert.Nil(t, err, "group did not synchronise")
+
+	behind, err := network.Node(2).Height()
+	assert.Nil(t, err, "wrong height error")
+	assert.Equal(t, isolated, behind, "isolated node advanced")
+
+	// the running node catches up without a restart
+	network.Heal()
+	err = network.WaitForHeight(height, 2*time.Minute)
+	assert.Nil(t, err, "healed network did not synchronise")
+
+	status, err = network.Node(2).TransactionStatus(issueId)
+	assert.Nil(t, err, "wrong status error")
+	assert.Equal(t, "Confirmed", status, "issue not confirmed after healing")
+}
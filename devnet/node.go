@@ -0,0 +1,391 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package devnet
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/command/bitmark-cli/rpccalls"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/node"
+)
+
+const (
+	loopback          = "127.0.0.1"
+	configurationFile = "bitmarkd.conf"
+	consoleFile       = "console.log"
+	startTimeout      = 30 * time.Second
+	stopTimeout       = 30 * time.Second
+	pollInterval      = 250 * time.Millisecond
+	defaultLogLevel   = "error"
+
+	// recorder payment addresses, paid on the fake Electrum chain
+	testBitcoinAddress  = "msxN7C7cRNgbgyUzt3EcvrpmWXc59sZVN4"
+	testLitecoinAddress = "mjPkDNakVA4w4hJZ6WF7p8yKUV2merhyCM"
+)
+
+// the protected HTTPS requests, all allowed from loopback
+var httpsRequests = []string{"details", "connections", "peers", "reservoir", "bans"}
+
+// loopback ports of a node
+type ports struct {
+	rpc          int
+	https        int
+	peer         int
+	broadcast    int
+	proofPublish int
+	proofSubmit  int
+}
+
+// Node - one bitmarkd of a devnet
+type Node struct {
+	sync.Mutex
+
+	index     int
+	binary    string
+	directory string
+	logLevel  string
+	identity  *identity
+	ports     ports
+	electrum  string // host:port of the fake Electrum server
+
+	// the other nodes, each reached through its own link
+	peers []peerLink
+
+	cmd  *exec.Cmd
+	done chan struct{}
+}
+
+// a connection to another node
+type peerLink struct {
+	node *Node
+	link *link
+}
+
+// create a node in its own directory
+func newNode(index int, binary string, directory string, logLevel string, electrum string) (*Node, error) {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return nil, err
+	}
+
+	id, err := newIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	p := ports{}
+	for _, port := range []*int{&p.rpc, &p.https, &p.peer, &p.broadcast, &p.proofPublish, &p.proofSubmit} {
+		*port, err = freePort()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Node{
+		index:     index,
+		binary:    binary,
+		directory: directory,
+		logLevel:  logLevel,
+		identity:  id,
+		ports:     p,
+		electrum:  electrum,
+	}, nil
+}
+
+// an unused loopback port
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(loopback, "0"))
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
+
+func address(port int) string {
+	return net.JoinHostPort(loopback, strconv.Itoa(port))
+}
+
+// Index - position of the node in the network
+func (n *Node) Index() int {
+	return n.index
+}
+
+// Directory - data directory of the node, including its logs
+func (n *Node) Directory() string {
+	return n.directory
+}
+
+// RPCAddress - host:port of the client JSON RPC
+func (n *Node) RPCAddress() string {
+	return address(n.ports.rpc)
+}
+
+// HTTPSAddress - host:port of the HTTPS RPC
+func (n *Node) HTTPSAddress() string {
+	return address(n.ports.https)
+}
+
+// PublicKey - tagged peer public key
+func (n *Node) PublicKey() string {
+	return n.identity.peerPublicKey
+}
+
+// IsRunning - true if the bitmarkd process has started and not exited
+func (n *Node) IsRunning() bool {
+	n.Lock()
+	defer n.Unlock()
+	return n.isRunning()
+}
+
+func (n *Node) isRunning() bool {
+	if n.done == nil {
+		return false
+	}
+	select {
+	case <-n.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// the configuration of the node, connected to every other node
+// through its links
+func (n *Node) configuration() table {
+	id := n.identity
+
+	connect := array{}
+	allow := []string{}
+	for _, peer := range n.peers {
+		connect = append(connect, table{
+			"public_key": peer.node.identity.peerPublicKey,
+			"address":    peer.link.address(),
+		})
+		allow = append(allow, peer.node.identity.peerPublicKey)
+	}
+
+	httpsAllow := table{}
+	for _, request := range httpsRequests {
+		httpsAllow[request] = []string{"127.0.0.0/8", "::1/128"}
+	}
+
+	return table{
+		"data_directory": n.directory,
+		"chain":          chain.Local,
+		"nodes":          "none",
+
+		"client_rpc": table{
+			"maximum_connections": 50,
+			"bandwidth":           25000000,
+			"listen":              []string{address(n.ports.rpc)},
+			"announce":            []string{},
+			"certificate":         id.certificate,
+			"private_key":         id.certificateKey,
+		},
+
+		"https_rpc": table{
+			"maximum_connections": 50,
+			"listen":              []string{address(n.ports.https)},
+			"allow":               httpsAllow,
+			"certificate":         id.certificate,
+			"private_key":         id.certificateKey,
+		},
+
+		"peering": table{
+			"dynamic_connections": false,
+			"prefer_ipv6":         false,
+			"listen":              []string{address(n.ports.peer)},
+			"announce":            []string{},
+			"public_key":          id.peerPublicKey,
+			"private_key":         id.peerPrivateKey,
+			"connect":             connect,
+			"private":             true,
+			"allow":               allow,
+			"minimum_connections": 1,
+		},
+
+		"publishing": table{
+			"broadcast":   []string{address(n.ports.broadcast)},
+			"public_key":  id.peerPublicKey,
+			"private_key": id.peerPrivateKey,
+		},
+
+		"proofing": table{
+			"local_use_internal_hash": false,
+			"public_key":              id.proofPublicKey,
+			"private_key":             id.proofPrivateKey,
+			"signing_key":             id.signingKey,
+			"payment_address": table{
+				"bitcoin":  testBitcoinAddress,
+				"litecoin": testLitecoinAddress,
+			},
+			"publish": []string{address(n.ports.proofPublish)},
+			"submit":  []string{address(n.ports.proofSubmit)},
+		},
+
+		"payment": table{
+			"mode": "electrum",
+			"electrum": table{
+				"bitcoin": table{
+					"server": n.electrum,
+					"tls":    false,
+				},
+			},
+		},
+
+		"logging": table{
+			"size":    1048576,
+			"count":   10,
+			"console": false,
+			"levels": table{
+				"DEFAULT": n.logLevel,
+			},
+		},
+	}
+}
+
+// ConfigurationFile - the generated bitmarkd configuration
+func (n *Node) ConfigurationFile() string {
+	return filepath.Join(n.directory, configurationFile)
+}
+
+func (n *Node) writeConfiguration() error {
+	data := renderConfiguration(n.configuration())
+	return ioutil.WriteFile(n.ConfigurationFile(), []byte(data), 0o600)
+}
+
+// Start - run bitmarkd and wait for its RPC to answer
+func (n *Node) Start() error {
+	n.Lock()
+	defer n.Unlock()
+
+	if n.isRunning() {
+		return fault.NodeIsRunning
+	}
+
+	if err := n.writeConfiguration(); err != nil {
+		return err
+	}
+
+	console, err := os.OpenFile(filepath.Join(n.directory, consoleFile), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(n.binary, "--config-file="+n.ConfigurationFile(), "start")
+	cmd.Dir = n.directory
+	cmd.Stdout = console
+	cmd.Stderr = console
+	if err := cmd.Start(); err != nil {
+		console.Close()
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		_ = cmd.Wait()
+		console.Close()
+		close(done)
+	}()
+
+	n.cmd = cmd
+	n.done = done
+
+	deadline := time.Now().Add(startTimeout)
+	for {
+		if _, err := n.Info(); err == nil {
+			return nil
+		}
+		select {
+		case <-done:
+			return fault.NodeIsNotRunning
+		case <-time.After(pollInterval):
+		}
+		if time.Now().After(deadline) {
+			n.stop()
+			return fault.TimeoutWaitingForNode
+		}
+	}
+}
+
+// Stop - terminate bitmarkd, killing it if it does not shut down
+func (n *Node) Stop() error {
+	n.Lock()
+	defer n.Unlock()
+
+	if !n.isRunning() {
+		return fault.NodeIsNotRunning
+	}
+	n.stop()
+	return nil
+}
+
+func (n *Node) stop() {
+	_ = n.cmd.Process.Signal(syscall.SIGTERM)
+	select {
+	case <-n.done:
+	case <-time.After(stopTimeout):
+		_ = n.cmd.Process.Kill()
+		<-n.done
+	}
+}
+
+// Restart - stop and start again
+func (n *Node) Restart() error {
+	if err := n.Stop(); err != nil && err != fault.NodeIsNotRunning {
+		return err
+	}
+	return n.Start()
+}
+
+// Client - a new JSON RPC connection, the caller must close it
+func (n *Node) Client() (*rpccalls.Client, error) {
+	return rpccalls.NewClient(true, n.RPCAddress(), false, nil)
+}
+
+// Info - the Node.Info RPC
+func (n *Node) Info() (*node.InfoReply, error) {
+	client, err := n.Client()
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return client.GetBitmarkInfo()
+}
+
+// Height - the highest block of the node
+func (n *Node) Height() (uint64, error) {
+	info, err := n.Info()
+	if err != nil {
+		return 0, err
+	}
+	return info.Block.Height, nil
+}
+
+// WaitForHeight - wait until the node has a block at the height
+func (n *Node) WaitForHeight(height uint64, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		if h, err := n.Height(); err == nil && h >= height {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fault.TimeoutWaitingForHeight
+		}
+		time.Sleep(pollInterval)
+	}
+}
@@ -22,7 +22,6 @@ const (
 	minimumReciprocal             float64 = 1.0
 	ExpectedBlockSpacingInSecond          = 2 * 60
 	AdjustTimespanInBlocks                = 200
-	adjustTimespanInSecond                = ExpectedBlockSpacingInSecond * AdjustTimespanInBlocks
 	nextDifficultyRatioUpperbound         = 4
 	nextDifficultyRaioLowerbound          = 0.25
 	firstBlock                            = 2
@@ -54,6 +53,23 @@ type Difficulty struct {
 // Current - current difficulty
 var Current = New()
 
+// expected block spacing of the chain in use
+var blockSpacingInSecond uint64 = ExpectedBlockSpacingInSecond
+
+// SetBlockSpacing - set the expected block spacing of a custom chain,
+// zero restores the default
+func SetBlockSpacing(seconds uint64) {
+	if seconds == 0 {
+		seconds = ExpectedBlockSpacingInSecond
+	}
+	blockSpacingInSecond = seconds
+}
+
+// BlockSpacing - expected seconds between blocks
+func BlockSpacing() uint64 {
+	return blockSpacingInSecond
+}
+
 // difficulty of 1 as 256 bit big endian value
 var constOne = []byte{
 	0x00, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
@@ -314,6 +330,7 @@ func NextDifficultyByPreviousTimespan(prevTimespanSecond uint64, currentDifficul
 }
 
 func adjustRatioByLastTimespan(actualTimespanSecond uint64) float64 {
+	adjustTimespanInSecond := blockSpacingInSecond * AdjustTimespanInBlocks
 	if actualTimespanSecond <= adjustTimespanInSecond>>2 {
 		return nextDifficultyRatioUpperbound
 	}
@@ -349,6 +366,6 @@ func prevBeginBlockWhenAtBeginOfNextTimespan(height uint64) (uint64, uint64) {
 // Hashrate - calculate hashrate from current difficulty, rounded to 3 digits
 func Hashrate() float64 {
 	zeroBitCount := defaultEmptyBits + math.Log2(Current.Value())
-	rate := math.Pow(2, zeroBitCount) / ExpectedBlockSpacingInSecond
+	rate := math.Pow(2, zeroBitCount) / float64(blockSpacingInSecond)
 	return math.Floor(rate*1000+0.5) / 1000
 }
@@ -328,3 +328,18 @@ func TestNextDifficultyByPreviousTimespanWhenSmaller(t *testing.T) {
 
 	assert.Equal(t, diff*3, actual, "wrong difficulty adjust")
 }
+
+func TestSetBlockSpacing(t *testing.T) {
+	defer difficulty.SetBlockSpacing(0)
+
+	difficulty.Current.Set(4.8)
+	difficulty.SetBlockSpacing(60)
+	assert.Equal(t, uint64(60), difficulty.BlockSpacing(), "wrong block spacing")
+
+	// half the spacing needs twice the hash rate
+	expected := math.Floor(float64(1228.8000000000004)/60*1000) / 1000
+	assert.Equal(t, expected, difficulty.Hashrate(), "network hashrate")
+
+	difficulty.SetBlockSpacing(0)
+	assert.Equal(t, uint64(difficulty.ExpectedBlockSpacingInSecond), difficulty.BlockSpacing(), "wrong default block spacing")
+}
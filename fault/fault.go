@@ -1,5 +1,5 @@
 // SPDX-License-Identifier: ISC
-// Copyright (c) 2014-2025 Bitmark Inc.
+// Copyright (c) 2014-2026 Bitmark Inc.
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
@@ -8,7 +8,7 @@
 // *************************************
 
 // generated by:   generate-fault.sh
-// generated on:   2025-02-12 16:22:49
+// generated on:   2026-10-18 22:30:00
 
 package fault
 
@@ -20,8 +20,10 @@ func e(s string) error {
 // auto generated error vars: *** DO NOT MODIFY ***
 
 var (
+	AccountIsNotWatched                   = e("account is not watched")
 	AddressIsNil                          = e("address is nil")
 	AlreadyInitialised                    = e("already initialised")
+	APIKeysNotEnabled                     = e("api keys not enabled")
 	AssetFingerprintIsRequired            = e("asset fingerprint is required")
 	AssetIsNotIndexed                     = e("asset is not indexed")
 	AssetMetadataIsRequired               = e("asset metadata is required")
@@ -31,11 +33,15 @@ var (
 	BitcoinAddressForWrongNetwork         = e("bitcoin address for wrong network")
 	BitcoinAddressIsNotSupported          = e("bitcoin address is not supported")
 	BlockAlreadyProcessed                 = e("block already processed")
+	BlockDigestDoesNotMatch               = e("block digest does not match")
+	BlockDoesNotMatchHeader               = e("block does not match header")
 	BlockEndEarlierThanBegin              = e("block end earlier than begin")
 	BlockHeaderNotFound                   = e("block header not found")
 	BlockHeightNotFound                   = e("block height not found")
 	BlockIsTooOld                         = e("block is too old")
 	BlockNotFound                         = e("block not found")
+	BlockNumberDoesNotMatch               = e("block number does not match")
+	BlocksInLightDatabase                 = e("blocks in light database")
 	BlockVersionMustNotDecrease           = e("block version must not decrease")
 	BufferCapacityLimit                   = e("buffer capacity limit")
 	CannotConvertSharesBackToAssets       = e("cannot convert shares back to assets")
@@ -44,7 +50,9 @@ var (
 	CannotDecodeSeed                      = e("cannot decode seed")
 	CanOnlyConvertAssetsToShares          = e("can only convert assets to shares")
 	CertificateFileAlreadyExists          = e("certificate file already exists")
+	ChainAlreadyRegistered                = e("chain already registered")
 	ChecksumMismatch                      = e("checksum mismatch")
+	ClientCertificateNotPinned            = e("client certificate not pinned")
 	ClientSocketNotConnected              = e("client socket not connected")
 	ClientSocketNotCreated                = e("client socket not created")
 	ConnectingToSelfForbidden             = e("connecting to self forbidden")
@@ -58,12 +66,17 @@ var (
 	DescriptionIsRequired                 = e("description is required")
 	DifficultyDoesNotMatchCalculated      = e("difficulty does not match calculated")
 	DoubleTransferAttempt                 = e("double transfer attempt")
+	DuplicateAPIKey                       = e("duplicate api key")
+	ElectrumConnectionClosed              = e("electrum connection closed")
+	ElectrumRequestTimeout                = e("electrum request timeout")
+	ElectrumServerError                   = e("electrum server error")
 	FileDoesNotExist                      = e("file does not exist")
 	FileNameIsRequired                    = e("file name is required")
 	FingerprintTooLong                    = e("fingerprint too long")
 	FingerprintTooShort                   = e("fingerprint too short")
 	HashCannotBeNil                       = e("hash cannot be nil")
 	HashNotFound                          = e("hash not found")
+	HeaderRangeNotSupported               = e("header range not supported")
 	HeightOutOfSequence                   = e("height out of sequence")
 	IdentityNameAlreadyExists             = e("identity name already exists")
 	IdentityNameIsRequired                = e("identity name is required")
@@ -71,31 +84,46 @@ var (
 	IncompatibleOptions                   = e("incompatible options")
 	IncorrectBlockRangeToRollback         = e("incorrect block range to rollback")
 	IncorrectChain                        = e("incorrect chain")
+	IncorrectPassphrase                   = e("incorrect passphrase or not a seed")
 	InsufficientShares                    = e("insufficient shares")
+	InvalidAPIKey                         = e("invalid api key")
+	InvalidAPIKeyMethod                   = e("invalid api key method")
 	InvalidBitcoinAddress                 = e("invalid bitcoin address")
+	InvalidBlockFilter                    = e("invalid block filter")
 	InvalidBlockHeaderDifficulty          = e("invalid block header difficulty")
 	InvalidBlockHeaderSize                = e("invalid block header size")
 	InvalidBlockHeaderTimestamp           = e("invalid block header timestamp")
 	InvalidBlockHeaderVersion             = e("invalid block header version")
 	InvalidBuffer                         = e("invalid buffer")
+	InvalidCertificateAuthority           = e("invalid certificate authority")
 	InvalidChain                          = e("invalid chain")
 	InvalidCount                          = e("invalid count")
 	InvalidCurrency                       = e("invalid currency")
 	InvalidCurrencyAddress                = e("invalid currency address")
 	InvalidCursor                         = e("invalid cursor")
+	InvalidDerivationPath                 = e("invalid derivation path")
+	InvalidDifficulty                     = e("invalid difficulty")
 	InvalidDnsTxtRecord                   = e("invalid dns txt record")
+	InvalidElectrumResponse               = e("invalid electrum response")
 	InvalidFingerprint                    = e("invalid fingerprint")
+	InvalidGenesisBlock                   = e("invalid genesis block")
 	InvalidIdentityName                   = e("invalid identity name")
 	InvalidIpAddress                      = e("invalid ip address")
 	InvalidItem                           = e("invalid item")
 	InvalidKeyLength                      = e("invalid key length")
 	InvalidKeyType                        = e("invalid key type")
 	InvalidLength                         = e("invalid length")
+	InvalidLightningCertificate           = e("invalid lightning certificate")
+	InvalidLightningResponse              = e("invalid lightning response")
 	InvalidLitecoinAddress                = e("invalid litecoin address")
 	InvalidNodeDomain                     = e("invalid node domain")
 	InvalidNonce                          = e("invalid nonce")
 	InvalidOwnerOrRegistrant              = e("invalid owner or registrant")
+	InvalidParameters                     = e("invalid parameters")
+	InvalidPartition                      = e("invalid partition")
+	InvalidPassphrase                     = e("invalid passphrase")
 	InvalidPasswordLength                 = e("invalid password length")
+	InvalidPaymentNetwork                 = e("invalid payment network")
 	InvalidPaymentVersion                 = e("invalid payment version")
 	InvalidPeerResponse                   = e("invalid peer response")
 	InvalidPortNumber                     = e("invalid port number")
@@ -103,15 +131,23 @@ var (
 	InvalidProofSigningKey                = e("invalid proof signing key")
 	InvalidPublicKey                      = e("invalid public key")
 	InvalidRecoveryPhraseLength           = e("invalid recovery phrase length")
+	InvalidRecoveryPhraseWord             = e("invalid recovery phrase word")
 	InvalidSecretKeyLength                = e("invalid secret key length")
 	InvalidSeedHeader                     = e("invalid seed header")
 	InvalidSeedLength                     = e("invalid seed length")
+	InvalidShare                          = e("invalid share")
+	InvalidShareThreshold                 = e("invalid share threshold")
 	InvalidSignature                      = e("invalid signature")
 	InvalidTimestamp                      = e("invalid timestamp")
+	JobRejected                           = e("job rejected")
 	KeyFileAlreadyExists                  = e("key file already exists")
+	LightningNotEnabled                   = e("lightning not enabled")
+	LightningPayeeIsNotThisNode           = e("lightning payee is not this node")
+	LightningRequestFailed                = e("lightning request failed")
 	LinkToInvalidOrUnconfirmedTransaction = e("link to invalid or unconfirmed transaction")
 	LitecoinAddressForWrongNetwork        = e("litecoin address for wrong network")
 	LitecoinAddressIsNotSupported         = e("litecoin address is not supported")
+	MakeAssetFailed                       = e("make asset failed")
 	MakeBlockTransferFailed               = e("make block transfer failed")
 	MakeGrantFailed                       = e("make grant failed")
 	MakeIssueFailed                       = e("make issue failed")
@@ -121,17 +157,29 @@ var (
 	MerkleRootDoesNotMatch                = e("merkle root does not match")
 	MetadataIsNotMap                      = e("metadata is not map")
 	MetadataTooLong                       = e("metadata too long")
+	MethodNotAllowedForAPIKey             = e("method not allowed for api key")
+	MissingAPIKey                         = e("missing api key")
+	MissingBinary                         = e("missing binary")
+	MissingBitcoinPayment                 = e("missing bitcoin payment")
 	MissingBlockOwner                     = e("missing block owner")
+	MissingLightningAddress               = e("missing lightning address")
 	MissingOwnerData                      = e("missing owner data")
 	MissingParameters                     = e("missing parameters")
 	MissingPaymentBitcoinSection          = e("missing payment bitcoin section")
+	MissingPaymentElectrumSection         = e("missing payment electrum section")
+	MissingPaymentLightningSection        = e("missing payment lightning section")
 	MissingPaymentLitecoinSection         = e("missing payment litecoin section")
 	MissingPreviousBlockHeader            = e("missing previous block header")
 	MissingReservoir                      = e("missing reservoir")
+	MissingWatchedAccounts                = e("missing watched accounts")
 	NameTooLong                           = e("name too long")
 	NilPointer                            = e("nil pointer")
 	NoAddressToReturn                     = e("no address to return")
+	NoBitcoinPayment                      = e("no bitcoin payment")
 	NoConnectionsAvailable                = e("no connections available")
+	NodeIndexOutOfRange                   = e("node index out of range")
+	NodeIsNotRunning                      = e("node is not running")
+	NodeIsRunning                         = e("node is running")
 	NoNewBlockHeadersFromPeer             = e("no new block headers from peer")
 	NoNewTransactions                     = e("no new transactions")
 	NotACountersignableRecord             = e("not a countersignable record")
@@ -152,27 +200,47 @@ var (
 	OwnershipIsNotIndexed                 = e("ownership is not indexed")
 	PasswordMismatch                      = e("password mismatch")
 	PayIdAlreadyUsed                      = e("pay id already used")
+	PayIdIsNotPending                     = e("pay id is not pending")
 	PaymentAddressTooLong                 = e("payment address too long")
+	PeerIsBanned                          = e("peer is banned")
+	PeerNotAllowed                        = e("peer not allowed")
+	PeersFixedInPrivateNetwork            = e("peers fixed in private network")
 	PreviousBlockDigestDoesNotMatch       = e("previous block digest does not match")
 	PreviousOwnershipWasNotDeleted        = e("previous ownership was not deleted")
 	PreviousTransactionWasNotDeleted      = e("previous transaction was not deleted")
 	ProcessStopping                       = e("process stopping")
 	RateLimiting                          = e("rate limiting")
 	RecordHasExpired                      = e("record has expired")
+	RecordNotFound                        = e("record not found")
+	ReorganisationTooDeep                 = e("reorganisation too deep")
+	ReplacementLinkMismatch               = e("replacement link mismatch")
+	ServerCertificateNotPinned            = e("server certificate not pinned")
+	ShareDigestMismatch                   = e("share digest mismatch")
 	ShareIdsCannotBeIdentical             = e("share ids cannot be identical")
 	ShareQuantityTooSmall                 = e("share quantity too small")
 	SignatureTooLong                      = e("signature too long")
 	TimeoutWaitingForHeader               = e("timeout waiting for header")
+	TimeoutWaitingForHeight               = e("timeout waiting for height")
+	TimeoutWaitingForJob                  = e("timeout waiting for job")
+	TimeoutWaitingForNode                 = e("timeout waiting for node")
+	TimeoutWaitingForStatus               = e("timeout waiting for status")
 	TooManyItemsToProcess                 = e("too many items to process")
 	TransactionAlreadyExists              = e("transaction already exists")
+	TransactionAlreadyVerified            = e("transaction already verified")
 	TransactionCountOutOfRange            = e("transaction count out of range")
+	TransactionHashDoesNotMatch           = e("transaction hash does not match")
 	TransactionHexDataIsRequired          = e("transaction hex data is required")
+	TransactionIdDoesNotMatch             = e("transaction id does not match")
 	TransactionIdIsRequired               = e("transaction id is required")
+	TransactionIndexOutOfRange            = e("transaction index out of range")
 	TransactionIsNotAnAsset               = e("transaction is not an asset")
 	TransactionIsNotAnIssue               = e("transaction is not an issue")
 	TransactionIsNotATransfer             = e("transaction is not a transfer")
+	TransactionIsNotConfirmed             = e("transaction is not confirmed")
 	TransactionIsNotIndexed               = e("transaction is not indexed")
+	TransactionIsNotPending               = e("transaction is not pending")
 	TransactionLinksToSelf                = e("transaction links to self")
+	TransactionNotInBlock                 = e("transaction not in block")
 	UnexpectedTransactionRecord           = e("unexpected transaction record")
 	UnmarshalTextFailed                   = e("unmarshal text failed")
 	UnsupportedCurrency                   = e("unsupported currency")
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package genesis
+
+import (
+	"sync"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+// size of a packed block header, as blockrecord
+const headerSize = 100
+
+// digests of custom genesis blocks, computed on first use
+var customDigests struct {
+	sync.Mutex
+	digests map[string]blockdigest.Digest
+}
+
+// Block - the packed genesis block of a chain
+func Block(chainName string) []byte {
+	switch chainName {
+	case chain.Bitmark:
+		return LiveGenesisBlock
+	case chain.Testing, chain.Local:
+		return TestGenesisBlock
+	}
+	if p := chain.Custom(chainName); p != nil {
+		return p.GenesisBlock()
+	}
+	return nil
+}
+
+// Digest - digest of the genesis header of a chain
+func Digest(chainName string) (blockdigest.Digest, error) {
+	switch chainName {
+	case chain.Bitmark:
+		return LiveGenesisDigest, nil
+	case chain.Testing, chain.Local:
+		return TestGenesisDigest, nil
+	}
+
+	customDigests.Lock()
+	defer customDigests.Unlock()
+
+	if digest, ok := customDigests.digests[chainName]; ok {
+		return digest, nil
+	}
+
+	block := Block(chainName)
+	if len(block) < headerSize {
+		return blockdigest.Digest{}, fault.InvalidGenesisBlock
+	}
+
+	digest := blockdigest.NewDigest(block[:headerSize])
+	if customDigests.digests == nil {
+		customDigests.digests = make(map[string]blockdigest.Digest)
+	}
+	customDigests.digests[chainName] = digest
+
+	return digest, nil
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package genesis_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/genesis"
+)
+
+func TestBuiltInChains(t *testing.T) {
+	assert.Equal(t, genesis.LiveGenesisBlock, genesis.Block(chain.Bitmark), "wrong live block")
+	assert.Equal(t, genesis.TestGenesisBlock, genesis.Block(chain.Testing), "wrong test block")
+	assert.Equal(t, genesis.TestGenesisBlock, genesis.Block(chain.Local), "wrong local block")
+
+	digest, err := genesis.Digest(chain.Bitmark)
+	assert.Nil(t, err, "wrong live digest error")
+	assert.Equal(t, genesis.LiveGenesisDigest, digest, "wrong live digest")
+
+	_, err = genesis.Digest("unknown")
+	assert.Equal(t, fault.InvalidGenesisBlock, err, "wrong unknown chain error")
+}
+
+func TestCustomChain(t *testing.T) {
+	// reuse the test genesis block under another name
+	err := chain.Register(chain.Parameters{
+		Name:    "genesis-test",
+		Genesis: hex.EncodeToString(genesis.TestGenesisBlock),
+	})
+	assert.Nil(t, err, "wrong register error")
+
+	assert.Equal(t, genesis.TestGenesisBlock, genesis.Block("genesis-test"), "wrong block")
+
+	digest, err := genesis.Digest("genesis-test")
+	assert.Nil(t, err, "wrong digest error")
+	assert.Equal(t, genesis.TestGenesisDigest, digest, "wrong digest")
+
+	err = chain.Register(chain.Parameters{
+		Name:    "genesis-short",
+		Genesis: "0102",
+	})
+	assert.Nil(t, err, "wrong register error")
+
+	digest, err = genesis.Digest("genesis-short")
+	assert.Equal(t, fault.InvalidGenesisBlock, err, "wrong short block error")
+	assert.Equal(t, blockdigest.Digest{}, digest, "wrong short block digest")
+}
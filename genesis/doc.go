@@ -3,5 +3,6 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
 
-// Package genesis - live and test genesis blocks
+// Package genesis - live and test genesis blocks, and the genesis
+// blocks of custom chains
 package genesis
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package light - header-only node for a set of watched accounts
+//
+// a light node synchronises and validates only the block headers,
+// which are kept in their own pool.  The compact filter of each new
+// block is fetched from a full peer and tested against the watched
+// accounts and the transactions they own; only a matching block is
+// fetched, checked against its stored header and merkle root, and
+// the bitmark issues and transfers of the watched accounts are
+// indexed into the normal transaction and ownership pools.
+//
+// when a bitmark is transferred to a watched account its provenance
+// is located on a full peer and fetched back to the issue, or to the
+// last transfer already indexed, so that the ownership records can be
+// replayed in order.  Block ownership and shares are not indexed.
+//
+// the filters are served by a single, unauthenticated peer: a block
+// that matches is always verified, but a peer can omit a match and so
+// hide transactions, which are only found if the block is scanned again
+// from an honest peer.
+package light
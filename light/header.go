@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockheader"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/difficulty"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/genesis"
+	"github.com/bitmark-inc/bitmarkd/storage"
+)
+
+// StoreHeader - store a validated header and its digest as the new
+// local tip
+//
+// the header must already be validated, e.g. by a header chain, this
+// only checks that it follows the local tip
+func StoreHeader(header *blockrecord.Header, digest blockdigest.Digest) error {
+	if err := follows(header); err != nil {
+		return err
+	}
+
+	trx, err := storage.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+	return commitHeader(trx, header, digest)
+}
+
+// check that a header follows the local tip and adjust the difficulty
+// for it
+func follows(header *blockrecord.Header) error {
+	height, previous, _, _ := blockheader.Get()
+	if header.Number != height+1 {
+		return fault.HeightOutOfSequence
+	}
+	if header.PreviousBlock != previous {
+		return fault.PreviousBlockDigestDoesNotMatch
+	}
+
+	if blockrecord.IsBlockToAdjustDifficulty(header.Number, header.Version) {
+		if _, _, err := blockrecord.AdjustDifficultyAtBlock(header.Number); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add a header to an open transaction, commit it and set the new tip
+func commitHeader(trx storage.Transaction, header *blockrecord.Header, digest blockdigest.Digest) error {
+	key := blockNumberKey(header.Number)
+	packed := header.Pack()
+
+	trx.Put(storage.Pool.Headers, key, packed[:], []byte{})
+	trx.Put(storage.Pool.BlockHeaderHash, key, digest[:], []byte{})
+	if err := trx.Commit(); err != nil {
+		return err
+	}
+
+	blockheader.Set(header.Number, digest, header.Version, header.Timestamp)
+	return nil
+}
+
+// DeleteDownTo - delete from the highest header down to and including
+// the specified block number
+//
+// the watched records of the deleted blocks are removed and the
+// ownership of the remainder rebuilt, the blocks are scanned again as
+// their replacement headers are stored
+func DeleteDownTo(finalBlockNumber uint64) error {
+	if finalBlockNumber <= genesis.BlockNumber {
+		return fault.BlockNotFound
+	}
+
+	height := blockheader.Height()
+	if finalBlockNumber > height {
+		return nil
+	}
+
+	globalData.log.Infof("delete down to header: %d", finalBlockNumber)
+
+	trx, err := storage.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	for n := height; n >= finalBlockNumber; n -= 1 {
+		trx.Delete(storage.Pool.Headers, blockNumberKey(n))
+		trx.Delete(storage.Pool.BlockHeaderHash, blockNumberKey(n))
+	}
+
+	remaining, err := removeRecords(trx, finalBlockNumber)
+	if err != nil {
+		trx.Abort()
+		return err
+	}
+	if err := trx.Commit(); err != nil {
+		return err
+	}
+
+	// a deleted key is still read from the database until committed,
+	// so the ownership is replayed in a second transaction
+	trx, err = storage.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+	if err := replay(trx, remaining); err != nil {
+		trx.Abort()
+		return err
+	}
+	if err := trx.Commit(); err != nil {
+		return err
+	}
+
+	blockheader.ClearCache()
+
+	previous := finalBlockNumber - 1
+	if previous == genesis.BlockNumber {
+		blockheader.SetGenesis()
+		blockrecord.ResetDifficulty()
+		return nil
+	}
+
+	header, err := blockrecord.StoredHeader(previous)
+	if err != nil {
+		return err
+	}
+	digest, err := blockheader.DigestForBlock(previous)
+	if err != nil {
+		return err
+	}
+	blockheader.Set(header.Number, digest, header.Version, header.Timestamp)
+
+	if blockrecord.IsDifficultyAppliedVersion(header.Version) && header.Number >= difficulty.AdjustTimespanInBlocks {
+		if _, _, err := blockrecord.AdjustDifficultyAtBlock(header.Number); err != nil {
+			return err
+		}
+	} else {
+		blockrecord.ResetDifficulty()
+	}
+	return nil
+}
@@ -0,0 +1,412 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package light_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockfilter"
+	"github.com/bitmark-inc/bitmarkd/blockheader"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/difficulty"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/light"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/ownership"
+	"github.com/bitmark-inc/bitmarkd/peer/mocks"
+	"github.com/bitmark-inc/bitmarkd/storage"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+	"github.com/bitmark-inc/logger"
+)
+
+const (
+	testingDirName   = "testing"
+	databaseFileName = testingDirName + "/test"
+)
+
+type testKey struct {
+	account    *account.Account
+	privateKey ed25519.PrivateKey
+}
+
+func newTestKey(seed byte) testKey {
+	s := make([]byte, ed25519.SeedSize)
+	s[0] = seed
+	privateKey := ed25519.NewKeyFromSeed(s)
+	return testKey{
+		account: &account.Account{
+			AccountInterface: &account.ED25519Account{
+				Test:      true,
+				PublicKey: privateKey.Public().(ed25519.PublicKey),
+			},
+		},
+		privateKey: privateKey,
+	}
+}
+
+func setup(t *testing.T, watch ...*account.Account) {
+	removeFiles()
+	_ = os.Mkdir(testingDirName, 0o700)
+
+	logging := logger.Configuration{
+		Directory: testingDirName,
+		File:      "testing.log",
+		Size:      1048576,
+		Count:     10,
+		Console:   false,
+		Levels: map[string]string{
+			logger.DefaultTag: "critical",
+		},
+	}
+	if err := logger.Initialise(logging); err != nil {
+		t.Fatalf("logger setup failed: %s", err)
+	}
+
+	mode.Initialise(chain.Testing)
+
+	if err := storage.Initialise(databaseFileName, false); err != nil {
+		t.Fatalf("storage initialise error: %s", err)
+	}
+	if err := blockheader.Initialise(); err != nil {
+		t.Fatalf("blockheader initialise error: %s", err)
+	}
+	blockrecord.Initialise(storage.Pool.BlockHeaderHash)
+	ownership.Initialise(storage.Pool.OwnerList, storage.Pool.OwnerData)
+
+	configuration := light.Configuration{
+		Enabled: true,
+	}
+	for _, a := range watch {
+		configuration.Watch = append(configuration.Watch, a.String())
+	}
+	if err := light.Initialise(&configuration); err != nil {
+		t.Fatalf("light initialise error: %s", err)
+	}
+}
+
+func teardown() {
+	_ = light.Finalise()
+	blockrecord.Finalise()
+	_ = blockheader.Finalise()
+	storage.Finalise()
+	mode.Finalise()
+	logger.Finalise()
+	removeFiles()
+}
+
+func removeFiles() {
+	os.RemoveAll(testingDirName)
+}
+
+// a block following the local tip, with its digest and filter
+type testBlock struct {
+	header *blockrecord.Header
+	digest blockdigest.Digest
+	packed []byte
+	filter []byte
+}
+
+func newTestBlock(transactions ...transactionrecord.Packed) testBlock {
+	height, previous, _, _ := blockheader.Get()
+
+	txIds := make([]merkle.Digest, len(transactions))
+	items := make([][]byte, 0, 2*len(transactions))
+	for i, p := range transactions {
+		txIds[i] = p.MakeLink()
+		tx, _, err := p.Unpack(true)
+		if err != nil {
+			panic(err)
+		}
+		items = append(items, blockfilter.Items(tx)...)
+	}
+	root := merkle.Digest{}
+	if tree := merkle.FullMerkleTree(txIds); len(tree) > 0 {
+		root = tree[len(tree)-1]
+	}
+
+	// headers alone must still count the base transactions
+	count := uint16(len(transactions))
+	if count == 0 {
+		count = blockrecord.MinimumTransactions
+	}
+
+	header := &blockrecord.Header{
+		Version:          1,
+		TransactionCount: count,
+		Number:           height + 1,
+		PreviousBlock:    previous,
+		MerkleRoot:       root,
+		Timestamp:        uint64(time.Now().Unix()),
+		Difficulty:       difficulty.New(),
+	}
+	packedHeader := header.Pack()
+	packed := append([]byte{}, packedHeader[:]...)
+	for _, p := range transactions {
+		packed = append(packed, p...)
+	}
+	digest := blockdigest.Digest{byte(header.Number), 0x55}
+
+	return testBlock{
+		header: header,
+		digest: digest,
+		packed: packed,
+		filter: blockfilter.New(blockfilter.KeyOf(digest), items),
+	}
+}
+
+func newTestAsset(t *testing.T, registrant testKey, fingerprint string) (transactionrecord.Packed, transactionrecord.AssetIdentifier) {
+	asset := transactionrecord.AssetData{
+		Name:        "light",
+		Fingerprint: fingerprint,
+		Metadata:    "owner\x00light",
+		Registrant:  registrant.account,
+	}
+	message, _ := asset.Pack(registrant.account)
+	asset.Signature = ed25519.Sign(registrant.privateKey, message)
+	packed, err := asset.Pack(registrant.account)
+	assert.Nil(t, err, "asset pack error")
+	return packed, asset.AssetId()
+}
+
+func newTestIssue(t *testing.T, assetId transactionrecord.AssetIdentifier, owner testKey) transactionrecord.Packed {
+	issue := transactionrecord.BitmarkIssue{
+		AssetId: assetId,
+		Owner:   owner.account,
+		Nonce:   1,
+	}
+	message, _ := issue.Pack(owner.account)
+	issue.Signature = ed25519.Sign(owner.privateKey, message)
+	packed, err := issue.Pack(owner.account)
+	assert.Nil(t, err, "issue pack error")
+	return packed
+}
+
+func newTestTransfer(t *testing.T, link merkle.Digest, from testKey, to testKey) transactionrecord.Packed {
+	transfer := transactionrecord.BitmarkTransferUnratified{
+		Link:  link,
+		Owner: to.account,
+	}
+	message, _ := transfer.Pack(from.account)
+	transfer.Signature = ed25519.Sign(from.privateKey, message)
+	packed, err := transfer.Pack(from.account)
+	assert.Nil(t, err, "transfer pack error")
+	return packed
+}
+
+func owned(t *testing.T, a *account.Account) []merkle.Digest {
+	records, err := ownership.Get().ListBitmarksFor(a, 0, 100)
+	assert.Nil(t, err, "wrong ListBitmarksFor error")
+	txIds := make([]merkle.Digest, len(records))
+	for i, r := range records {
+		txIds[i] = r.TxId
+	}
+	return txIds
+}
+
+func TestInitialiseWhenInvalid(t *testing.T) {
+	setup(t, newTestKey(1).account)
+	defer teardown()
+
+	_ = light.Finalise()
+
+	err := light.Initialise(&light.Configuration{Enabled: true})
+	assert.Equal(t, fault.MissingWatchedAccounts, err, "wrong error")
+	_ = light.Finalise()
+
+	a := newTestKey(1).account.AccountInterface.(*account.ED25519Account)
+	a.Test = false
+	live := &account.Account{AccountInterface: a}
+	err = light.Initialise(&light.Configuration{Enabled: true, Watch: []string{live.String()}})
+	assert.Equal(t, fault.WrongNetworkForPublicKey, err, "wrong error")
+	_ = light.Finalise()
+
+	err = light.Initialise(&light.Configuration{Enabled: false})
+	assert.Nil(t, err, "wrong error")
+	assert.False(t, light.IsEnabled(), "enabled")
+	assert.False(t, light.IsWatched(newTestKey(1).account), "watched when disabled")
+}
+
+func TestStoreHeaderAndDeleteDownTo(t *testing.T) {
+	watched := newTestKey(1)
+	setup(t, watched.account)
+	defer teardown()
+
+	assert.True(t, light.IsEnabled(), "not enabled")
+	assert.True(t, light.IsWatched(watched.account), "not watched")
+
+	genesisHeight := blockheader.Height()
+
+	b1 := newTestBlock()
+	err := light.StoreHeader(b1.header, b1.digest)
+	assert.Nil(t, err, "wrong error")
+
+	err = light.StoreHeader(b1.header, b1.digest)
+	assert.Equal(t, fault.HeightOutOfSequence, err, "header stored twice")
+
+	b2 := newTestBlock()
+	b2.header.PreviousBlock = blockdigest.Digest{9}
+	err = light.StoreHeader(b2.header, b2.digest)
+	assert.Equal(t, fault.PreviousBlockDigestDoesNotMatch, err, "wrong linkage error")
+
+	b2 = newTestBlock()
+	err = light.StoreHeader(b2.header, b2.digest)
+	assert.Nil(t, err, "wrong error")
+
+	height, digest, _, _ := blockheader.Get()
+	assert.Equal(t, b2.header.Number, height, "wrong height")
+	assert.Equal(t, b2.digest, digest, "wrong digest")
+
+	header, err := blockrecord.StoredHeader(b1.header.Number)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, b1.header.Pack(), header.Pack(), "wrong stored header")
+
+	err = light.DeleteDownTo(b2.header.Number)
+	assert.Nil(t, err, "wrong error")
+	height, digest, _, _ = blockheader.Get()
+	assert.Equal(t, b1.header.Number, height, "wrong height after delete")
+	assert.Equal(t, b1.digest, digest, "wrong digest after delete")
+
+	_, err = blockrecord.StoredHeader(b2.header.Number)
+	assert.Equal(t, fault.BlockNotFound, err, "deleted header found")
+
+	err = light.DeleteDownTo(b1.header.Number)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, genesisHeight, blockheader.Height(), "wrong height after delete to genesis")
+}
+
+func TestScan(t *testing.T) {
+	watched := newTestKey(1)
+	other := newTestKey(2)
+	third := newTestKey(3)
+	setup(t, watched.account)
+	defer teardown()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	fetcher := mocks.NewMockUpstream(ctl)
+
+	// not watched: asset and issue to another account
+	packedAsset, assetId := newTestAsset(t, other, "0123456789abcdef")
+
+	// another asset so that each block has the minimum transactions
+	padding, _ := newTestAsset(t, third, "fedcba9876543210")
+	packedIssue := newTestIssue(t, assetId, other)
+	issueId := packedIssue.MakeLink()
+	b1 := newTestBlock(packedAsset, packedIssue)
+
+	fetcher.EXPECT().GetBlockFilter(b1.header.Number).Return(b1.filter, nil).Times(1)
+	err := light.ScanAndStoreHeader(fetcher, b1.header, b1.digest)
+	assert.Nil(t, err, "wrong error")
+
+	// incoming: provenance is fetched from the issue block
+	packedTransfer := newTestTransfer(t, issueId, other, watched)
+	transferId := packedTransfer.MakeLink()
+	b2 := newTestBlock(padding, packedTransfer)
+
+	fetcher.EXPECT().GetBlockFilter(b2.header.Number).Return(b2.filter, nil).Times(1)
+	fetcher.EXPECT().GetBlockData(b2.header.Number).Return(b2.packed, nil).Times(1)
+	fetcher.EXPECT().LocateRecord(issueId[:]).Return(b1.header.Number, nil).Times(1)
+	fetcher.EXPECT().LocateRecord(assetId[:]).Return(b1.header.Number, nil).Times(1)
+	fetcher.EXPECT().GetBlockData(b1.header.Number).Return(b1.packed, nil).Times(1)
+	err = light.ScanAndStoreHeader(fetcher, b2.header, b2.digest)
+	assert.Nil(t, err, "wrong error")
+
+	assert.Equal(t, []merkle.Digest{transferId}, owned(t, watched.account), "incoming not indexed")
+	_, packed := storage.Pool.Transactions.GetNB(issueId[:])
+	assert.Equal(t, []byte(packedIssue), packed, "issue not stored")
+	_, packed = storage.Pool.Assets.GetNB(assetId[:])
+	assert.Equal(t, []byte(packedAsset), packed, "asset not stored")
+
+	// outgoing: matched by the owned transaction
+	packedOutgoing := newTestTransfer(t, transferId, watched, third)
+	b3 := newTestBlock(padding, packedOutgoing)
+
+	fetcher.EXPECT().GetBlockFilter(b3.header.Number).Return(b3.filter, nil).Times(1)
+	fetcher.EXPECT().GetBlockData(b3.header.Number).Return(b3.packed, nil).Times(1)
+	err = light.ScanAndStoreHeader(fetcher, b3.header, b3.digest)
+	assert.Nil(t, err, "wrong error")
+
+	assert.Equal(t, 0, len(owned(t, watched.account)), "outgoing not indexed")
+
+	// reorganisation: the outgoing transfer is undone
+	err = light.DeleteDownTo(b3.header.Number)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, []merkle.Digest{transferId}, owned(t, watched.account), "ownership not rebuilt")
+}
+
+func TestScanWhenBlockDoesNotMatchHeader(t *testing.T) {
+	watched := newTestKey(1)
+	setup(t, watched.account)
+	defer teardown()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	fetcher := mocks.NewMockUpstream(ctl)
+
+	packedAsset, assetId := newTestAsset(t, watched, "0123456789abcdef")
+	b1 := newTestBlock(packedAsset, newTestIssue(t, assetId, watched))
+	forged := newTestBlock(packedAsset, newTestIssue(t, transactionrecord.AssetIdentifier{1}, watched))
+
+	fetcher.EXPECT().GetBlockFilter(b1.header.Number).Return(b1.filter, nil).Times(1)
+	fetcher.EXPECT().GetBlockData(b1.header.Number).Return(forged.packed, nil).Times(1)
+	height := blockheader.Height()
+	err := light.ScanAndStoreHeader(fetcher, b1.header, b1.digest)
+	assert.Equal(t, fault.BlockDoesNotMatchHeader, err, "wrong error")
+	assert.Equal(t, 0, len(owned(t, watched.account)), "forged block indexed")
+	assert.Equal(t, height, blockheader.Height(), "header stored after failed scan")
+}
+
+func TestScanAndStoreHeaderWhenRetried(t *testing.T) {
+	watched := newTestKey(1)
+	other := newTestKey(2)
+	setup(t, watched.account)
+	defer teardown()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+	fetcher := mocks.NewMockUpstream(ctl)
+
+	packedAsset, assetId := newTestAsset(t, other, "0123456789abcdef")
+	packedIssue := newTestIssue(t, assetId, other)
+	issueId := packedIssue.MakeLink()
+	b1 := newTestBlock(packedAsset, packedIssue)
+
+	fetcher.EXPECT().GetBlockFilter(b1.header.Number).Return(b1.filter, nil).Times(1)
+	err := light.ScanAndStoreHeader(fetcher, b1.header, b1.digest)
+	assert.Nil(t, err, "wrong error")
+
+	// the provenance cannot be fetched: nothing is stored
+	packedTransfer := newTestTransfer(t, issueId, other, watched)
+	transferId := packedTransfer.MakeLink()
+	b2 := newTestBlock(packedAsset, packedTransfer)
+
+	fetcher.EXPECT().GetBlockFilter(b2.header.Number).Return(b2.filter, nil).Times(2)
+	fetcher.EXPECT().GetBlockData(b2.header.Number).Return(b2.packed, nil).Times(2)
+	fetcher.EXPECT().LocateRecord(issueId[:]).Return(b1.header.Number, nil).Times(2)
+	fetcher.EXPECT().LocateRecord(assetId[:]).Return(uint64(0), fault.BlockNotFound).Times(1)
+	fetcher.EXPECT().GetBlockData(b1.header.Number).Return(b1.packed, nil).Times(2)
+	err = light.ScanAndStoreHeader(fetcher, b2.header, b2.digest)
+	assert.Equal(t, fault.BlockNotFound, err, "wrong error")
+	assert.Equal(t, b1.header.Number, blockheader.Height(), "header stored after failed scan")
+	assert.Equal(t, 0, len(owned(t, watched.account)), "partial scan indexed")
+
+	// the retry indexes the transfer once
+	fetcher.EXPECT().LocateRecord(assetId[:]).Return(b1.header.Number, nil).Times(1)
+	err = light.ScanAndStoreHeader(fetcher, b2.header, b2.digest)
+	assert.Nil(t, err, "wrong retry error")
+	assert.Equal(t, b2.header.Number, blockheader.Height(), "header not stored")
+	assert.Equal(t, []merkle.Digest{transferId}, owned(t, watched.account), "wrong indexed ownership")
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/ownership"
+	"github.com/bitmark-inc/bitmarkd/storage"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// delete the records from a block number upwards and all of the
+// ownership, returning the records that remain in block order
+func removeRecords(trx storage.Transaction, finalBlockNumber uint64) ([]record, error) {
+	remaining := make([]record, 0, 100)
+	err := storage.Pool.Transactions.NewFetchCursor().Map(func(key []byte, value []byte) error {
+		if len(value) < 9 {
+			return fault.InvalidLength
+		}
+		blockNumber := binary.BigEndian.Uint64(value[:8])
+		if blockNumber >= finalBlockNumber {
+			trx.Delete(storage.Pool.Transactions, key)
+			return nil
+		}
+		packed := transactionrecord.Packed(value[8:])
+		transaction, _, err := packed.Unpack(mode.IsTesting())
+		if err != nil {
+			return err
+		}
+		r := record{
+			blockNumber: blockNumber,
+			packed:      packed,
+			transaction: transaction,
+		}
+		copy(r.txId[:], key)
+		remaining = append(remaining, r)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = storage.Pool.Assets.NewFetchCursor().Map(func(key []byte, value []byte) error {
+		if len(value) >= 8 && binary.BigEndian.Uint64(value[:8]) >= finalBlockNumber {
+			trx.Delete(storage.Pool.Assets, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pools := []storage.Handle{
+		storage.Pool.OwnerNextCount,
+		storage.Pool.OwnerList,
+		storage.Pool.OwnerTxIndex,
+		storage.Pool.OwnerData,
+	}
+	for _, pool := range pools {
+		err := pool.NewFetchCursor().Map(func(key []byte, value []byte) error {
+			trx.Delete(pool, key)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return remaining[i].blockNumber < remaining[j].blockNumber
+	})
+	return remaining, nil
+}
+
+// rebuild the ownership from records in block order
+//
+// the order of transactions within a block is not stored, so a
+// transfer waits until its link is applied
+func replay(trx storage.Transaction, records []record) error {
+	applied := make(map[merkle.Digest]record)
+
+	for len(records) > 0 {
+		blockNumber := records[0].blockNumber
+		n := 1
+		for n < len(records) && records[n].blockNumber == blockNumber {
+			n += 1
+		}
+		pending := records[:n]
+		records = records[n:]
+
+		for len(pending) > 0 {
+			waiting := pending[:0:0]
+			for _, r := range pending {
+				owner, link, ok := ownerAndLink(r.transaction)
+				if !ok {
+					continue
+				}
+				if link == nil {
+					issue := r.transaction.(*transactionrecord.BitmarkIssue)
+					ownership.CreateAsset(trx, r.txId, r.blockNumber, issue.AssetId, owner)
+				} else if previous, ok := applied[*link]; ok {
+					previousOwner, _, _ := ownerAndLink(previous.transaction)
+					ownership.Transfer(trx, previous.txId, r.txId, r.blockNumber, previousOwner, owner)
+				} else {
+					waiting = append(waiting, r)
+					continue
+				}
+				applied[r.txId] = r
+			}
+			if len(waiting) == len(pending) {
+				globalData.log.Errorf("block number: %d  records without provenance: %d", blockNumber, len(waiting))
+				return fault.LinkToInvalidOrUnconfirmedTransaction
+			}
+			pending = waiting
+		}
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"encoding/binary"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// a transaction of a block
+type record struct {
+	txId        merkle.Digest
+	blockNumber uint64
+	packed      transactionrecord.Packed
+	transaction transactionrecord.Transaction
+}
+
+// the transactions of a packed block, which must have the header and
+// the merkle root of the header
+func verifiedRecords(packedBlock []byte, header *blockrecord.Header) ([]record, error) {
+	packedHeader, err := blockrecord.PackedHeaderOf(packedBlock)
+	if err != nil {
+		return nil, err
+	}
+	if packedHeader != header.Pack() {
+		return nil, fault.BlockDoesNotMatchHeader
+	}
+
+	data := packedBlock[len(packedHeader):]
+	records := make([]record, 0, header.TransactionCount)
+	txIds := make([]merkle.Digest, 0, header.TransactionCount)
+	for i := uint16(0); i < header.TransactionCount; i += 1 {
+		transaction, n, err := transactionrecord.Packed(data).Unpack(mode.IsTesting())
+		if err != nil {
+			return nil, err
+		}
+		packed := transactionrecord.Packed(data[:n])
+		txId := packed.MakeLink()
+		records = append(records, record{
+			txId:        txId,
+			blockNumber: header.Number,
+			packed:      packed,
+			transaction: transaction,
+		})
+		txIds = append(txIds, txId)
+		data = data[n:]
+	}
+
+	tree := merkle.FullMerkleTree(txIds)
+	if len(tree) == 0 || tree[len(tree)-1] != header.MerkleRoot {
+		return nil, fault.MerkleRootDoesNotMatch
+	}
+	return records, nil
+}
+
+// owner and link of a bitmark issue or transfer, an issue has no link
+func ownerAndLink(transaction transactionrecord.Transaction) (*account.Account, *merkle.Digest, bool) {
+	switch tx := transaction.(type) {
+	case *transactionrecord.BitmarkIssue:
+		return tx.Owner, nil, true
+	case *transactionrecord.BitmarkTransferUnratified:
+		return tx.Owner, &tx.Link, true
+	case *transactionrecord.BitmarkTransferCountersigned:
+		return tx.Owner, &tx.Link, true
+	default:
+		return nil, nil, false
+	}
+}
+
+func blockNumberKey(blockNumber uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, blockNumber)
+	return key
+}
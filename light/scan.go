@@ -0,0 +1,317 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockfilter"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/ownership"
+	"github.com/bitmark-inc/bitmarkd/storage"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// Fetcher - a full peer that supplies filters and blocks on demand
+type Fetcher interface {
+	GetBlockData(uint64) ([]byte, error)
+	GetBlockFilter(uint64) ([]byte, error)
+	LocateRecord([]byte) (uint64, error)
+}
+
+// state of one block scan
+type scanner struct {
+	fetcher Fetcher
+	trx     storage.Transaction
+	height  uint64              // block being scanned
+	blocks  map[uint64][]record // verified blocks fetched so far
+}
+
+// ScanAndStoreHeader - index the watched transactions of the block of
+// a validated header and store the header as the new local tip
+//
+// the block is only fetched if its filter matches a watched account
+// or a transaction that a watched account owns.  The records and the
+// header are committed in one transaction, so a failure leaves neither
+// and the block is scanned again when the header is retried
+//
+// the filter is taken from a single peer and is not authenticated by
+// the header: a matching block is verified against its merkle root,
+// but a peer that serves a filter without a match can hide the
+// transactions of a watched account until the block is scanned again
+// from another peer
+func ScanAndStoreHeader(fetcher Fetcher, header *blockrecord.Header, digest blockdigest.Digest) error {
+	if err := follows(header); err != nil {
+		return err
+	}
+
+	records, err := matchedRecords(fetcher, header, digest)
+	if err != nil {
+		return err
+	}
+
+	trx, err := storage.NewDBTransaction()
+	if err != nil {
+		return err
+	}
+
+	s := &scanner{
+		fetcher: fetcher,
+		trx:     trx,
+		height:  header.Number,
+		blocks: map[uint64][]record{
+			header.Number: records,
+		},
+	}
+	for _, r := range records {
+		if err := s.index(r); err != nil {
+			trx.Abort()
+			return err
+		}
+	}
+
+	return commitHeader(trx, header, digest)
+}
+
+// the verified records of a block whose filter matches, or nil
+func matchedRecords(fetcher Fetcher, header *blockrecord.Header, digest blockdigest.Digest) ([]record, error) {
+	items, err := watchedItems()
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := fetcher.GetBlockFilter(header.Number)
+	if err != nil {
+		return nil, err
+	}
+	match, err := blockfilter.MatchAny(filter, blockfilter.KeyOf(digest), items)
+	if err != nil || !match {
+		return nil, err
+	}
+
+	packedBlock, err := fetcher.GetBlockData(header.Number)
+	if err != nil {
+		return nil, err
+	}
+	records, err := verifiedRecords(packedBlock, header)
+	if err != nil {
+		return nil, err
+	}
+
+	globalData.log.Infof("block number: %d  matched filter", header.Number)
+	return records, nil
+}
+
+// filter items: the watched accounts and the transactions they own
+func watchedItems() ([][]byte, error) {
+	accounts := Watched()
+	items := make([][]byte, 0, len(accounts))
+	for _, a := range accounts {
+		items = append(items, a.Bytes())
+
+		start := uint64(0)
+		for {
+			owned, err := ownership.Get().ListBitmarksFor(a, start, 100)
+			if err != nil {
+				return nil, err
+			}
+			if len(owned) == 0 {
+				break
+			}
+			for _, o := range owned {
+				txId := o.TxId
+				items = append(items, txId[:])
+			}
+			start = owned[len(owned)-1].N + 1
+		}
+	}
+	return items, nil
+}
+
+// index a bitmark issue or transfer that concerns a watched account
+func (s *scanner) index(r record) error {
+	owner, link, ok := ownerAndLink(r.transaction)
+	if !ok {
+		return nil
+	}
+
+	if link == nil {
+		if !IsWatched(owner) || s.owns(r) {
+			return nil
+		}
+		return s.create(r)
+	}
+
+	_, previousOwner := ownership.OwnerOf(s.trx, *link)
+	if previousOwner != nil && ownership.CurrentlyOwns(s.trx, previousOwner, *link, storage.Pool.OwnerTxIndex) {
+		if !IsWatched(previousOwner) && !IsWatched(owner) {
+			return nil // filter false positive
+		}
+		s.put(r)
+		ownership.Transfer(s.trx, *link, r.txId, r.blockNumber, previousOwner, owner)
+		return nil
+	}
+
+	if !IsWatched(owner) {
+		return nil
+	}
+
+	// incoming from an account that is not indexed: replay the
+	// provenance from the issue or the last indexed transfer
+	provenance, err := s.provenance(*link)
+	if err != nil {
+		return err
+	}
+	first := provenance[0]
+	if !s.owns(first) {
+		if err := s.create(first); err != nil {
+			return err
+		}
+	}
+	previous := first
+	for _, p := range append(provenance[1:], r) {
+		previousOwner, _, _ := ownerAndLink(previous.transaction)
+		owner, _, _ := ownerAndLink(p.transaction)
+		s.put(p)
+		ownership.Transfer(s.trx, previous.txId, p.txId, p.blockNumber, previousOwner, owner)
+		previous = p
+	}
+	return nil
+}
+
+// true if the owner of a record currently owns it
+func (s *scanner) owns(r record) bool {
+	owner, _, _ := ownerAndLink(r.transaction)
+	return ownership.CurrentlyOwns(s.trx, owner, r.txId, storage.Pool.OwnerTxIndex)
+}
+
+// the ownership of an issue
+func (s *scanner) create(r record) error {
+	issue, ok := r.transaction.(*transactionrecord.BitmarkIssue)
+	if !ok {
+		return fault.LinkToInvalidOrUnconfirmedTransaction
+	}
+	if err := s.storeAsset(issue.AssetId); err != nil {
+		return err
+	}
+	s.put(r)
+	ownership.CreateAsset(s.trx, r.txId, r.blockNumber, issue.AssetId, issue.Owner)
+	return nil
+}
+
+// the chain of records back from a link to its issue or to the first
+// record that is currently owned, oldest first
+func (s *scanner) provenance(link merkle.Digest) ([]record, error) {
+	provenance := make([]record, 0, 4)
+	id := link
+	for {
+		r, err := s.record(id)
+		if err != nil {
+			return nil, err
+		}
+		provenance = append(provenance, r)
+
+		_, previous, ok := ownerAndLink(r.transaction)
+		if !ok {
+			return nil, fault.LinkToInvalidOrUnconfirmedTransaction
+		}
+		if previous == nil || s.owns(r) {
+			break
+		}
+		id = *previous
+	}
+
+	for i, j := 0, len(provenance)-1; i < j; i, j = i+1, j-1 {
+		provenance[i], provenance[j] = provenance[j], provenance[i]
+	}
+	return provenance, nil
+}
+
+// a transaction from storage or from a block of a full peer
+func (s *scanner) record(txId merkle.Digest) (record, error) {
+	if blockNumber, packed := s.trx.GetNB(storage.Pool.Transactions, txId[:]); packed != nil {
+		transaction, _, err := transactionrecord.Packed(packed).Unpack(mode.IsTesting())
+		if err != nil {
+			return record{}, err
+		}
+		return record{
+			txId:        txId,
+			blockNumber: blockNumber,
+			packed:      append(transactionrecord.Packed{}, packed...),
+			transaction: transaction,
+		}, nil
+	}
+
+	blockNumber, err := s.fetcher.LocateRecord(txId[:])
+	if err != nil {
+		return record{}, err
+	}
+	records, err := s.block(blockNumber)
+	if err != nil {
+		return record{}, err
+	}
+	for _, r := range records {
+		if r.txId == txId {
+			return r, nil
+		}
+	}
+	return record{}, fault.LinkToInvalidOrUnconfirmedTransaction
+}
+
+// store the asset of an issue if it is not already stored
+func (s *scanner) storeAsset(assetId transactionrecord.AssetIdentifier) error {
+	if _, packed := s.trx.GetNB(storage.Pool.Assets, assetId[:]); packed != nil {
+		return nil
+	}
+
+	blockNumber, err := s.fetcher.LocateRecord(assetId[:])
+	if err != nil {
+		return err
+	}
+	records, err := s.block(blockNumber)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if asset, ok := r.transaction.(*transactionrecord.AssetData); ok && asset.AssetId() == assetId {
+			s.trx.Put(storage.Pool.Assets, assetId[:], blockNumberKey(r.blockNumber), r.packed)
+			return nil
+		}
+	}
+	return fault.AssetNotFound
+}
+
+// the verified records of a block at or below the scanned block
+func (s *scanner) block(blockNumber uint64) ([]record, error) {
+	if records, ok := s.blocks[blockNumber]; ok {
+		return records, nil
+	}
+	if blockNumber > s.height {
+		return nil, fault.LinkToInvalidOrUnconfirmedTransaction
+	}
+
+	header, err := blockrecord.StoredHeader(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	packedBlock, err := s.fetcher.GetBlockData(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	records, err := verifiedRecords(packedBlock, header)
+	if err != nil {
+		return nil, err
+	}
+	s.blocks[blockNumber] = records
+	return records, nil
+}
+
+// store a transaction
+func (s *scanner) put(r record) {
+	s.trx.Put(storage.Pool.Transactions, r.txId[:], blockNumberKey(r.blockNumber), r.packed)
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package light
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/blockheader"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/storage"
+	"github.com/bitmark-inc/logger"
+)
+
+// Configuration - light mode settings
+type Configuration struct {
+	Enabled bool     `gluamapper:"enabled" json:"enabled"`
+	Watch   []string `gluamapper:"watch" json:"watch"`
+}
+
+type lightData struct {
+	sync.RWMutex
+
+	log *logger.L
+
+	enabled bool
+	watched map[string]*account.Account // key: account bytes
+
+	initialised bool
+}
+
+// global data
+var globalData lightData
+
+// Initialise - setup light mode
+//
+// storage and blockheader must be initialised first, the stored
+// headers then set the local height
+func Initialise(configuration *Configuration) error {
+	globalData.Lock()
+	defer globalData.Unlock()
+
+	// no need to start if already started
+	if globalData.initialised {
+		return fault.AlreadyInitialised
+	}
+
+	log := logger.New("light")
+	globalData.log = log
+	log.Info("starting…")
+
+	globalData.enabled = configuration.Enabled
+	globalData.watched = make(map[string]*account.Account)
+
+	if !globalData.enabled {
+		log.Info("disabled")
+		globalData.initialised = true
+		return nil
+	}
+
+	for _, s := range configuration.Watch {
+		a, err := account.AccountFromBase58(s)
+		if err != nil {
+			log.Errorf("watch account: %q  error: %s", s, err)
+			return err
+		}
+		if a.IsTesting() != mode.IsTesting() {
+			log.Errorf("watch account: %q  error: %s", s, fault.WrongNetworkForPublicKey)
+			return fault.WrongNetworkForPublicKey
+		}
+		globalData.watched[string(a.Bytes())] = a
+		log.Infof("watch account: %s", a)
+	}
+	if len(globalData.watched) == 0 {
+		return fault.MissingWatchedAccounts
+	}
+
+	// a database holding full blocks cannot change mode
+	if _, ok := storage.Pool.Blocks.LastElement(); ok {
+		return fault.BlocksInLightDatabase
+	}
+
+	if last, ok := storage.Pool.Headers.LastElement(); ok {
+		packedHeader, err := blockrecord.PackedHeaderOf(last.Value)
+		if err != nil {
+			return err
+		}
+		header, err := packedHeader.Unpack()
+		if err != nil {
+			return err
+		}
+		if header.Number != binary.BigEndian.Uint64(last.Key) {
+			log.Criticalf("header number: %d  stored as: %x", header.Number, last.Key)
+			return fault.HeightOutOfSequence
+		}
+		digest := blockrecord.DigestFromHashPool(storage.Pool.BlockHeaderHash, last.Key)
+		if digest.IsEmpty() {
+			digest = packedHeader.Digest()
+		}
+		blockheader.Set(header.Number, digest, header.Version, header.Timestamp)
+
+		log.Infof("highest header from storage: %d", header.Number)
+	}
+
+	globalData.initialised = true
+
+	return nil
+}
+
+// Finalise - shutdown light mode
+func Finalise() error {
+	globalData.Lock()
+	defer globalData.Unlock()
+
+	if !globalData.initialised {
+		return fault.NotInitialised
+	}
+
+	globalData.log.Info("shutting down…")
+	globalData.log.Flush()
+
+	globalData.initialised = false
+	globalData.enabled = false
+	globalData.watched = nil
+
+	globalData.log.Info("finished")
+	globalData.log.Flush()
+
+	return nil
+}
+
+// IsEnabled - true if only headers are kept
+func IsEnabled() bool {
+	globalData.RLock()
+	defer globalData.RUnlock()
+	return globalData.enabled
+}
+
+// IsWatched - true if the account is watched
+func IsWatched(a *account.Account) bool {
+	if a == nil || a.AccountInterface == nil {
+		return false
+	}
+	globalData.RLock()
+	_, ok := globalData.watched[string(a.Bytes())]
+	globalData.RUnlock()
+	return ok
+}
+
+// Watched - the watched accounts
+func Watched() []*account.Account {
+	globalData.RLock()
+	defer globalData.RUnlock()
+
+	accounts := make([]*account.Account, 0, len(globalData.watched))
+	for _, a := range globalData.watched {
+		accounts = append(accounts, a)
+	}
+	return accounts
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package merkle
+
+import (
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+// Branch - the sibling digests needed to rebuild the root of
+// FullMerkleTree from the transaction at index, lowest level first
+//
+// an odd final digest of a level is paired with itself, so its sibling
+// is a copy of the digest
+func Branch(txIds []Digest, index int) ([]Digest, error) {
+	if index < 0 || index >= len(txIds) {
+		return nil, fault.TransactionIndexOutOfRange
+	}
+
+	tree := FullMerkleTree(txIds)
+
+	branch := make([]Digest, 0)
+	levelStart := 0
+	for width := len(txIds); width > 1; width = (width + 1) / 2 {
+		sibling := index ^ 1
+		if sibling >= width {
+			sibling = index // compensate for odd number
+		}
+		branch = append(branch, tree[levelStart+sibling])
+
+		levelStart += width
+		index /= 2
+	}
+	return branch, nil
+}
+
+// VerifyProof - true if the transaction at index of a block of count
+// transactions with the branch from Branch hashes to the merkle root
+//
+// the count must come from the block header: duplicating the final
+// digest of a level gives the same root (CVE-2012-2459), so an index
+// past the last transaction could otherwise be proven
+func VerifyProof(txId Digest, index uint64, count uint64, branch []Digest, root Digest) bool {
+	if index >= count {
+		return false
+	}
+
+	digest := txId
+	level := 0
+	for width := count; width > 1; width = (width + 1) / 2 {
+		if level >= len(branch) {
+			return false
+		}
+		sibling := branch[level]
+		level += 1
+
+		if index&1 == 1 {
+			digest = NewDigest(append(sibling[:], digest[:]...))
+		} else {
+			// an odd final digest is paired with itself
+			if index+1 == width && sibling != digest {
+				return false
+			}
+			digest = NewDigest(append(digest[:], sibling[:]...))
+		}
+		index /= 2
+	}
+
+	return level == len(branch) && digest == root
+}
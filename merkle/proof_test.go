@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package merkle_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+)
+
+func testTxIds(n int) []merkle.Digest {
+	txIds := make([]merkle.Digest, n)
+	for i := range txIds {
+		txIds[i] = merkle.NewDigest([]byte{byte(i), byte(i >> 8)})
+	}
+	return txIds
+}
+
+func TestBranch(t *testing.T) {
+	for n := 1; n <= 17; n += 1 {
+		txIds := testTxIds(n)
+		tree := merkle.FullMerkleTree(txIds)
+		root := tree[len(tree)-1]
+
+		for i := 0; i < n; i += 1 {
+			branch, err := merkle.Branch(txIds, i)
+			assert.Nil(t, err, "wrong error")
+			assert.True(t, merkle.VerifyProof(txIds[i], uint64(i), uint64(n), branch, root), "proof of %d in %d not verified", i, n)
+		}
+	}
+}
+
+func TestBranchWhenIndexOutOfRange(t *testing.T) {
+	txIds := testTxIds(3)
+
+	_, err := merkle.Branch(txIds, 3)
+	assert.Equal(t, fault.TransactionIndexOutOfRange, err, "wrong error")
+
+	_, err = merkle.Branch(txIds, -1)
+	assert.Equal(t, fault.TransactionIndexOutOfRange, err, "wrong error")
+}
+
+func TestVerifyProofWhenInvalid(t *testing.T) {
+	txIds := testTxIds(6)
+	tree := merkle.FullMerkleTree(txIds)
+	root := tree[len(tree)-1]
+
+	branch, err := merkle.Branch(txIds, 2)
+	assert.Nil(t, err, "wrong error")
+
+	assert.False(t, merkle.VerifyProof(txIds[3], 2, 6, branch, root), "wrong transaction verified")
+	assert.False(t, merkle.VerifyProof(txIds[2], 1, 6, branch, root), "wrong index verified")
+	assert.False(t, merkle.VerifyProof(txIds[2], 2+8, 6, branch, root), "index outside tree verified")
+	assert.False(t, merkle.VerifyProof(txIds[2], 6, 6, branch, root), "index past the last transaction verified")
+	assert.False(t, merkle.VerifyProof(txIds[2], 2, 6, branch[1:], root), "short branch verified")
+	assert.False(t, merkle.VerifyProof(txIds[2], 2, 6, append(branch, root), root), "long branch verified")
+	assert.False(t, merkle.VerifyProof(txIds[2], 2, 6, branch, txIds[0]), "wrong root verified")
+}
+
+// CVE-2012-2459: duplicating the last transaction gives the same root
+func TestVerifyProofWhenDuplicated(t *testing.T) {
+	txIds := testTxIds(5)
+	tree := merkle.FullMerkleTree(txIds)
+	root := tree[len(tree)-1]
+
+	duplicated := append(txIds, txIds[4])
+	tree = merkle.FullMerkleTree(duplicated)
+	assert.Equal(t, root, tree[len(tree)-1], "duplicate changed the root")
+
+	branch, err := merkle.Branch(duplicated, 5)
+	assert.Nil(t, err, "wrong error")
+	assert.True(t, merkle.VerifyProof(txIds[4], 5, 6, branch, root), "duplicate not verified with the wrong count")
+	assert.False(t, merkle.VerifyProof(txIds[4], 5, 5, branch, root), "duplicate verified")
+
+	// the real last transaction must pair with itself
+	branch, err = merkle.Branch(txIds, 4)
+	assert.Nil(t, err, "wrong error")
+	assert.True(t, merkle.VerifyProof(txIds[4], 4, 5, branch, root), "last transaction not verified")
+}
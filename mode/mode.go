@@ -62,8 +62,12 @@ func Initialise(chainName string) error {
 	case chain.Testing, chain.Local:
 		globalData.testing = true
 	default:
-		globalData.log.Criticalf("mode cannot handle chain: '%s'", chainName)
-		return fault.InvalidChain
+		if chain.Custom(chainName) == nil {
+			globalData.log.Criticalf("mode cannot handle chain: '%s'", chainName)
+			return fault.InvalidChain
+		}
+		// custom chains use testing addresses
+		globalData.testing = true
 	}
 
 	// all data initialised
@@ -73,10 +73,10 @@ type bitcoinHandler struct {
 	state *bitcoinState
 }
 
-func newBitcoinHandler(conf *currencyConfiguration) (*bitcoinHandler, error) {
+func newBitcoinHandler(conf *currencyConfiguration, confirmations int) (*bitcoinHandler, error) {
 	log := logger.New("bitcoin")
 
-	state, err := newBitcoinState(conf.URL)
+	state, err := newBitcoinState(conf.URL, confirmations)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +122,7 @@ func (h *bitcoinHandler) checkLatestBlock(wg *sync.WaitGroup) {
 
 	h.log.Infof("block number: %d confirmations: %d", headers[0].Height, headers[0].Confirmations)
 
-	if h.state.forward && headers[0].Confirmations <= requiredConfirmations {
+	if h.state.forward && headers[0].Confirmations < h.state.confirmations {
 		return
 	}
 
@@ -141,9 +141,12 @@ type bitcoinState struct {
 
 	// scanning direction
 	forward bool
+
+	// blocks, including the paying block, before a payment is accepted
+	confirmations uint64
 }
 
-func newBitcoinState(url string) (*bitcoinState, error) {
+func newBitcoinState(url string, confirmations int) (*bitcoinState, error) {
 	client := &http.Client{}
 
 	var chain bitcoinChainInfo
@@ -157,6 +160,7 @@ func newBitcoinState(url string) (*bitcoinState, error) {
 		latestBlockNumber: chain.Blocks,
 		latestBlockHash:   chain.Hash,
 		forward:           false,
+		confirmations:     uint64(confirmations),
 	}, nil
 }
 
@@ -177,7 +181,7 @@ process_blocks:
 		log.Infof("height: %d hash: %q number of txs: %d", block.Height, block.Hash, len(block.Tx))
 		log.Tracef("block: %#v", block)
 
-		if block.Confirmations <= requiredConfirmations {
+		if block.Confirmations < state.confirmations {
 			if !state.forward {
 				hash = block.PreviousBlockHash
 				state.latestBlockHash = hash
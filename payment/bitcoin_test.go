@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package payment
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bitmark-inc/logger"
+)
+
+// fakeBitcoinRest serves a chain of two blocks through the bitcoind
+// REST interface, the tip with one confirmation
+type fakeBitcoinRest struct {
+	sync.Mutex
+	server    *httptest.Server
+	requested []string // block hashes
+}
+
+func newFakeBitcoinRest() *fakeBitcoinRest {
+	r := &fakeBitcoinRest{}
+	blocks := map[string]bitcoinBlock{
+		"b1": {Hash: "b1", Height: 1, Confirmations: 2, NextBlockHash: "b2"},
+		"b2": {Hash: "b2", Height: 2, Confirmations: 1, PreviousBlockHash: "b1"},
+	}
+
+	r.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/chaininfo.json" {
+			_ = json.NewEncoder(w).Encode(bitcoinChainInfo{Blocks: 2, Hash: "b2"})
+			return
+		}
+
+		hash := strings.TrimSuffix(strings.TrimPrefix(request.URL.Path, "/block/"), ".json")
+		r.Lock()
+		r.requested = append(r.requested, hash)
+		r.Unlock()
+
+		block, ok := blocks[hash]
+		if !ok {
+			http.NotFound(w, request)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(block)
+	}))
+	return r
+}
+
+func TestBitcoinStateConfirmations(t *testing.T) {
+	log := logger.New("bitcoin")
+
+	for _, item := range []struct {
+		confirmations int
+		requested     []string
+		latest        string
+	}{
+		// the tip has too few confirmations and is fetched again later
+		{2, []string{"b1", "b2"}, "b2"},
+
+		// the tip is accepted and the scan moves past it
+		{1, []string{"b1", "b2", ""}, "b1"},
+	} {
+		rest := newFakeBitcoinRest()
+
+		state, err := newBitcoinState(rest.server.URL, item.confirmations)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		state.forward = true
+		state.latestBlockHash = "b1"
+
+		state.process(log)
+		rest.server.Close()
+
+		if !reflect.DeepEqual(rest.requested, item.requested) {
+			t.Errorf("depth: %d  unexpected blocks: %q  expected: %q", item.confirmations, rest.requested, item.requested)
+		}
+		if state.latestBlockHash != item.latest {
+			t.Errorf("depth: %d  unexpected latest block: %q  expected: %q", item.confirmations, state.latestBlockHash, item.latest)
+		}
+	}
+}
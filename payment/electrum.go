@@ -0,0 +1,844 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package payment
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/constants"
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/currency/bitcoin"
+	"github.com/bitmark-inc/bitmarkd/currency/litecoin"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/bitmark-inc/logger"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/patrickmn/go-cache"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	electrumProtocolVersion = "1.4"
+	electrumClientName      = "bitmarkd"
+	electrumTimeout         = 30 * time.Second // dial and per request
+	electrumRetryInterval   = 30 * time.Second // delay before reconnecting
+	electrumRefreshInterval = 20 * time.Second // check for new payment addresses
+	electrumQueueSize       = 100              // buffered incoming messages
+	electrumReorgDepth      = 100              // paid blocks deeper than this are no longer checked
+	electrumMaximumBranch   = 32               // Merkle branch of more than 2^32 transactions
+)
+
+type electrumConfiguration struct {
+	Bitcoin  *electrumServerConfiguration `gluamapper:"bitcoin" json:"bitcoin"`
+	Litecoin *electrumServerConfiguration `gluamapper:"litecoin" json:"litecoin"`
+}
+
+type electrumServerConfiguration struct {
+	Server string `gluamapper:"server" json:"server"` // host:port
+	TLS    bool   `gluamapper:"tls" json:"tls"`
+}
+
+// JSON-RPC request, response and notification
+
+type electrumRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	Id      uint64        `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type electrumError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type electrumMessage struct {
+	Id     *uint64         `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *electrumError  `json:"error"`
+}
+
+type electrumHeader struct {
+	Height int64  `json:"height"`
+	Hex    string `json:"hex"`
+}
+
+type electrumHistory struct {
+	TxHash string `json:"tx_hash"`
+	Height int64  `json:"height"` // zero or negative while in mempool
+}
+
+type electrumHeaders struct {
+	Count int    `json:"count"`
+	Hex   string `json:"hex"` // concatenated headers
+}
+
+type electrumMerkle struct {
+	BlockHeight int64    `json:"block_height"`
+	Merkle      []string `json:"merkle"` // branch from the transaction to the root
+	Pos         int      `json:"pos"`    // of the transaction in the block
+}
+
+// electrumWatcher follows the payment addresses of pending records
+// through an Electrum server and inspects every transaction paying
+// them for a pay id
+//
+// the server is not trusted: each transaction must have the id that
+// was asked for and a Merkle branch to the root of its block's header,
+// and each header must have its proof of work and link to the cached
+// headers around it
+type electrumWatcher struct {
+	log           *logger.L
+	currency      currency.Currency
+	server        string
+	useTLS        bool
+	networkParams *chaincfg.Params
+	confirmations int64
+
+	// sources of addresses and destination of payments
+	addresses func(currency.Currency) []string
+	verify    func(pay.PayId, *reservoir.PaymentDetail)
+	unverify  func(currency.Currency, string) int
+
+	// current connection
+	conn          net.Conn
+	encoder       *json.Encoder
+	incoming      <-chan *electrumMessage
+	done          chan struct{}
+	nextId        uint64
+	notifications []*electrumMessage
+
+	// per connection state
+	height     int64
+	subscribed map[string]string            // script hash → address
+	statuses   map[string]string            // script hash → last status, empty without history
+	pending    map[string][]electrumHistory // script hash → transactions lacking confirmations
+	stale      map[string]struct{}          // script hashes whose history must be fetched again
+
+	// across connections
+	inspected  *cache.Cache                 // currency tx id → struct{}
+	headers    *cache.Cache                 // block height → *wire.BlockHeader
+	paidBlocks map[int64]*electrumPaidBlock // block height → block whose payments were passed on
+}
+
+// a block containing payments passed to the reservoir
+type electrumPaidBlock struct {
+	hash  string
+	txIds []string
+}
+
+func newElectrumWatcher(c currency.Currency, conf *electrumServerConfiguration, confirmations int) (*electrumWatcher, error) {
+	if conf == nil || conf.Server == "" {
+		return nil, fault.MissingPaymentElectrumSection
+	}
+	if _, _, err := net.SplitHostPort(conf.Server); err != nil {
+		return nil, err
+	}
+
+	return &electrumWatcher{
+		log:           logger.New(c.String() + "_electrum"),
+		currency:      c,
+		server:        conf.Server,
+		useTLS:        conf.TLS,
+		networkParams: c.ChainParam(mode.ChainName()),
+		confirmations: int64(confirmations),
+		addresses:     reservoir.PaymentAddresses,
+		verify:        reservoir.SetTransferVerified,
+		unverify:      reservoir.UnverifyPaymentBlock,
+		inspected:     cache.New(constants.ReservoirTimeout, 2*constants.ReservoirTimeout),
+		headers:       cache.New(constants.ReservoirTimeout, 2*constants.ReservoirTimeout),
+		paidBlocks:    make(map[int64]*electrumPaidBlock),
+	}, nil
+}
+
+func (w *electrumWatcher) Run(args interface{}, shutdown <-chan struct{}) {
+	w.log.Infof("starting… server: %s", w.server)
+
+loop:
+	for {
+		err := w.connect()
+		if err == nil {
+			err = w.follow(shutdown)
+		}
+		w.disconnect()
+
+		if err == nil {
+			break loop
+		}
+		w.log.Errorf("server: %s  error: %s", w.server, err)
+
+		select {
+		case <-shutdown:
+			break loop
+		case <-time.After(electrumRetryInterval):
+		}
+	}
+
+	w.log.Info("stopped")
+}
+
+// open a connection and start reading messages from it
+func (w *electrumWatcher) connect() error {
+	dialer := &net.Dialer{
+		Timeout: electrumTimeout,
+	}
+
+	var conn net.Conn
+	var err error
+	if w.useTLS {
+		conn, err = tls.DialWithDialer(dialer, "tcp", w.server, &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		})
+	} else {
+		conn, err = dialer.Dial("tcp", w.server)
+	}
+	if err != nil {
+		return err
+	}
+
+	incoming := make(chan *electrumMessage, electrumQueueSize)
+	done := make(chan struct{})
+
+	go func(conn net.Conn, incoming chan<- *electrumMessage, done <-chan struct{}) {
+		defer close(incoming)
+		decoder := json.NewDecoder(conn)
+		for {
+			var m electrumMessage
+			if err := decoder.Decode(&m); err != nil {
+				return
+			}
+			select {
+			case incoming <- &m:
+			case <-done:
+				return
+			}
+		}
+	}(conn, incoming, done)
+
+	w.conn = conn
+	w.encoder = json.NewEncoder(conn)
+	w.incoming = incoming
+	w.done = done
+	w.notifications = nil
+	w.height = 0
+	w.subscribed = make(map[string]string)
+	w.statuses = make(map[string]string)
+	w.pending = make(map[string][]electrumHistory)
+	w.stale = make(map[string]struct{})
+
+	w.log.Infof("connected to: %s", w.server)
+
+	return nil
+}
+
+func (w *electrumWatcher) disconnect() {
+	if w.conn == nil {
+		return
+	}
+	close(w.done)
+	w.conn.Close()
+	w.conn = nil
+}
+
+// process notifications until shutdown or the connection fails
+//
+// only returns nil on shutdown
+func (w *electrumWatcher) follow(shutdown <-chan struct{}) error {
+	var version []string
+	err := w.call("server.version", &version, electrumClientName, electrumProtocolVersion)
+	if err != nil {
+		return err
+	}
+	w.log.Infof("server version: %v", version)
+
+	var header electrumHeader
+	err = w.call("blockchain.headers.subscribe", &header)
+	if err != nil {
+		return err
+	}
+	w.height = header.Height
+	w.log.Infof("block height: %d", w.height)
+
+	err = w.refresh()
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(electrumRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		// handle anything that arrived during a call
+		for len(w.notifications) > 0 {
+			m := w.notifications[0]
+			w.notifications = w.notifications[1:]
+			err := w.notify(m)
+			if err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-shutdown:
+			return nil
+
+		case <-ticker.C:
+			err := w.refresh()
+			if err != nil {
+				return err
+			}
+
+		case m, ok := <-w.incoming:
+			if !ok {
+				return fault.ElectrumConnectionClosed
+			}
+			if m.Id == nil {
+				w.notifications = append(w.notifications, m)
+			}
+		}
+	}
+}
+
+// send a request and wait for its response, any notifications
+// received while waiting are queued
+func (w *electrumWatcher) call(method string, result interface{}, params ...interface{}) error {
+	id := w.nextId
+	w.nextId += 1
+
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	w.conn.SetWriteDeadline(time.Now().Add(electrumTimeout))
+	err := w.encoder.Encode(electrumRequest{
+		JSONRPC: "2.0",
+		Id:      id,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	timeout := time.After(electrumTimeout)
+	for {
+		select {
+		case <-timeout:
+			return fault.ElectrumRequestTimeout
+
+		case m, ok := <-w.incoming:
+			if !ok {
+				return fault.ElectrumConnectionClosed
+			}
+			if m.Id == nil {
+				w.notifications = append(w.notifications, m)
+				continue
+			}
+			if *m.Id != id {
+				w.log.Warnf("ignore response id: %d  expected: %d", *m.Id, id)
+				continue
+			}
+			if m.Error != nil {
+				w.log.Errorf("%s: error: %d %s", method, m.Error.Code, m.Error.Message)
+				return fault.ElectrumServerError
+			}
+			if err := json.Unmarshal(m.Result, result); err != nil {
+				w.log.Errorf("%s: result: %s  error: %s", method, m.Result, err)
+				return fault.InvalidElectrumResponse
+			}
+			return nil
+		}
+	}
+}
+
+// handle a single server notification
+func (w *electrumWatcher) notify(m *electrumMessage) error {
+	switch m.Method {
+
+	case "blockchain.headers.subscribe":
+		var headers []electrumHeader
+		if err := json.Unmarshal(m.Params, &headers); err != nil || len(headers) == 0 {
+			return fault.InvalidElectrumResponse
+		}
+		w.height = headers[len(headers)-1].Height
+		w.log.Infof("block height: %d", w.height)
+
+		err := w.checkPaidBlocks()
+		if err != nil {
+			return err
+		}
+
+		// a reverted block leaves the status unchanged if its
+		// transactions are mined again at the same height
+		for scriptHash := range w.stale {
+			err := w.history(scriptHash)
+			if err != nil {
+				return err
+			}
+		}
+
+		// more confirmations may now be available
+		scriptHashes := make([]string, 0, len(w.pending))
+		for scriptHash := range w.pending {
+			scriptHashes = append(scriptHashes, scriptHash)
+		}
+		for _, scriptHash := range scriptHashes {
+			err := w.scan(scriptHash, w.pending[scriptHash])
+			if err != nil {
+				return err
+			}
+		}
+
+	case "blockchain.scripthash.subscribe":
+		var params []json.RawMessage
+		if err := json.Unmarshal(m.Params, &params); err != nil || len(params) < 2 {
+			return fault.InvalidElectrumResponse
+		}
+		var scriptHash string
+		if err := json.Unmarshal(params[0], &scriptHash); err != nil {
+			return fault.InvalidElectrumResponse
+		}
+		var status *string
+		if err := json.Unmarshal(params[1], &status); err != nil {
+			return fault.InvalidElectrumResponse
+		}
+		if _, ok := w.subscribed[scriptHash]; !ok {
+			return nil
+		}
+
+		// the status is a digest of the history, so an unchanged
+		// status has nothing new
+		if status == nil || *status == w.statuses[scriptHash] {
+			return nil
+		}
+		w.statuses[scriptHash] = *status
+		return w.history(scriptHash)
+
+	default:
+		w.log.Debugf("ignore notification: %q", m.Method)
+	}
+
+	return nil
+}
+
+// subscribe to any payment addresses not already subscribed
+func (w *electrumWatcher) refresh() error {
+	for _, address := range w.addresses(w.currency) {
+		scriptHash, err := electrumScriptHash(w.currency, address)
+		if err != nil {
+			w.log.Warnf("address: %s  error: %s", address, err)
+			continue
+		}
+		if _, ok := w.subscribed[scriptHash]; ok {
+			continue
+		}
+
+		var status *string
+		err = w.call("blockchain.scripthash.subscribe", &status, scriptHash)
+		if err != nil {
+			return err
+		}
+		w.subscribed[scriptHash] = address
+		w.log.Infof("subscribed address: %s  script hash: %s", address, scriptHash)
+
+		// a null status means the address has no history
+		if status != nil {
+			w.statuses[scriptHash] = *status
+			err = w.history(scriptHash)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// fetch the history of a script hash and scan it
+func (w *electrumWatcher) history(scriptHash string) error {
+	var items []electrumHistory
+	err := w.call("blockchain.scripthash.get_history", &items, scriptHash)
+	if err != nil {
+		return err
+	}
+
+	delete(w.stale, scriptHash)
+	return w.scan(scriptHash, items)
+}
+
+// inspect every sufficiently confirmed transaction of a history that
+// has not already been inspected, keeping the rest pending
+func (w *electrumWatcher) scan(scriptHash string, items []electrumHistory) error {
+	traceStopTime := time.Now().Add(-constants.ReservoirTimeout)
+	delete(w.pending, scriptHash)
+
+history_loop:
+	for _, item := range items {
+		if _, found := w.inspected.Get(item.TxHash); found {
+			continue history_loop
+		}
+
+		if item.Height <= 0 || w.height-item.Height+1 < w.confirmations {
+			w.pending[scriptHash] = append(w.pending[scriptHash], item)
+			continue history_loop
+		}
+
+		// payments older than the reservoir cannot match any record
+		header, err := w.blockHeader(item.Height)
+		if err != nil {
+			return err
+		}
+		if header.Timestamp.Before(traceStopTime) {
+			w.inspected.SetDefault(item.TxHash, struct{}{})
+			continue history_loop
+		}
+
+		var rawTx string
+		err = w.call("blockchain.transaction.get", &rawTx, item.TxHash)
+		if err != nil {
+			return err
+		}
+		w.inspected.SetDefault(item.TxHash, struct{}{})
+
+		data, err := hex.DecodeString(rawTx)
+		if err != nil {
+			w.log.Errorf("tx id: %s  error: %s", item.TxHash, err)
+			continue history_loop
+		}
+		var tx wire.MsgTx
+		if err := tx.Deserialize(bytes.NewReader(data)); err != nil {
+			w.log.Errorf("tx id: %s  error: %s", item.TxHash, err)
+			continue history_loop
+		}
+
+		err = w.checkTransaction(&tx, item, header)
+		if err != nil {
+			w.inspected.Delete(item.TxHash)
+			return err
+		}
+
+		blockHash := header.BlockHash().String()
+		if w.inspect(&tx, blockHash) {
+			paid, ok := w.paidBlocks[item.Height]
+			if !ok || paid.hash != blockHash {
+				paid = &electrumPaidBlock{hash: blockHash}
+				w.paidBlocks[item.Height] = paid
+			}
+			paid.txIds = append(paid.txIds, item.TxHash)
+		}
+	}
+
+	return nil
+}
+
+// check that a transaction from the server is the one in the history
+// and that its Merkle branch leads to the root of its block's header
+func (w *electrumWatcher) checkTransaction(tx *wire.MsgTx, item electrumHistory, header *wire.BlockHeader) error {
+	txHash := tx.TxHash()
+	if txHash.String() != item.TxHash {
+		w.log.Errorf("tx id: %s  server sent tx id: %s", item.TxHash, txHash)
+		return fault.TransactionHashDoesNotMatch
+	}
+
+	// a 64 byte transaction can also be read as an inner node of the
+	// tree, so a branch to it would not prove that it is a transaction
+	if tx.SerializeSizeStripped() == 2*chainhash.HashSize {
+		w.log.Errorf("tx id: %s  has the size of a Merkle node", item.TxHash)
+		return fault.InvalidElectrumResponse
+	}
+
+	var proof electrumMerkle
+	err := w.call("blockchain.transaction.get_merkle", &proof, item.TxHash, item.Height)
+	if err != nil {
+		return err
+	}
+	if proof.BlockHeight != item.Height {
+		w.log.Errorf("tx id: %s  merkle height: %d  expected: %d", item.TxHash, proof.BlockHeight, item.Height)
+		return fault.InvalidElectrumResponse
+	}
+
+	root, err := electrumMerkleRoot(txHash, proof.Merkle, proof.Pos)
+	if err != nil {
+		w.log.Errorf("tx id: %s  merkle branch error: %s", item.TxHash, err)
+		return fault.InvalidElectrumResponse
+	}
+	if !root.IsEqual(&header.MerkleRoot) {
+		w.log.Errorf("tx id: %s  is not in block: %s", item.TxHash, header.BlockHash())
+		return fault.MerkleRootDoesNotMatch
+	}
+	return nil
+}
+
+// header of the block at a given height, the headers of the blocks
+// confirming it are fetched and cached with it
+func (w *electrumWatcher) blockHeader(height int64) (*wire.BlockHeader, error) {
+	if h, found := w.headers.Get(electrumHeightKey(height)); found {
+		return h.(*wire.BlockHeader), nil
+	}
+
+	count := w.confirmations
+	if count < 1 {
+		count = 1
+	}
+	headers, err := w.fetchHeaders(height, count)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, header := range headers {
+		w.headers.SetDefault(electrumHeightKey(height+int64(i)), header)
+	}
+	return headers[0], nil
+}
+
+// read consecutive headers from the server, each must link to the one
+// before it and to any cached header on either side
+func (w *electrumWatcher) fetchHeaders(start int64, count int64) ([]*wire.BlockHeader, error) {
+	var reply electrumHeaders
+	err := w.call("blockchain.block.headers", &reply, start, count)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := hex.DecodeString(reply.Hex)
+	if err != nil || int64(reply.Count) != count || int64(len(data)) != count*wire.MaxBlockHeaderPayload {
+		return nil, fault.InvalidElectrumResponse
+	}
+
+	headers := make([]*wire.BlockHeader, count)
+	for i := range headers {
+		header, err := w.parseHeader(data[i*wire.MaxBlockHeaderPayload : (i+1)*wire.MaxBlockHeaderPayload])
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && header.PrevBlock != headers[i-1].BlockHash() {
+			w.log.Errorf("header height: %d  does not link to the previous header", start+int64(i))
+			return nil, fault.PreviousBlockDigestDoesNotMatch
+		}
+		headers[i] = header
+	}
+
+	// a reorganisation also breaks the links, so the cache is
+	// emptied and filled again from the server's current chain
+	linked := true
+	if h, found := w.headers.Get(electrumHeightKey(start - 1)); found {
+		linked = headers[0].PrevBlock == h.(*wire.BlockHeader).BlockHash()
+	}
+	if h, found := w.headers.Get(electrumHeightKey(start + count)); found && linked {
+		linked = h.(*wire.BlockHeader).PrevBlock == headers[count-1].BlockHash()
+	}
+	if !linked {
+		w.log.Errorf("headers from height: %d  do not link to the cached headers", start)
+		w.headers.Flush()
+		return nil, fault.PreviousBlockDigestDoesNotMatch
+	}
+
+	return headers, nil
+}
+
+// read the header at a given height from the server
+func (w *electrumWatcher) fetchHeader(height int64) (*wire.BlockHeader, error) {
+	var rawHeader string
+	err := w.call("blockchain.block.header", &rawHeader, height)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := hex.DecodeString(rawHeader)
+	if err != nil || len(data) != wire.MaxBlockHeaderPayload {
+		return nil, fault.InvalidElectrumResponse
+	}
+	return w.parseHeader(data)
+}
+
+// decode a header and check its proof of work against its bits and the
+// network's limit
+func (w *electrumWatcher) parseHeader(data []byte) (*wire.BlockHeader, error) {
+	var header wire.BlockHeader
+	if err := header.Deserialize(bytes.NewReader(data)); err != nil {
+		return nil, fault.InvalidElectrumResponse
+	}
+
+	// Litecoin's proof of work is the scrypt of the header
+	powHash := header.BlockHash()
+	if currency.Litecoin == w.currency {
+		digest, err := scrypt.Key(data, data, 1024, 1, 1, chainhash.HashSize)
+		if err != nil {
+			return nil, err
+		}
+		copy(powHash[:], digest)
+	}
+
+	target := blockchain.CompactToBig(header.Bits)
+	if target.Sign() <= 0 || target.Cmp(w.networkParams.PowLimit) > 0 || blockchain.HashToBig(&powHash).Cmp(target) > 0 {
+		w.log.Errorf("block: %s  bits: %08x  has insufficient proof of work", header.BlockHash(), header.Bits)
+		return nil, fault.InvalidBlockHeaderDifficulty
+	}
+	return &header, nil
+}
+
+// return the payments of any block that is no longer on the server's
+// chain to pending, so they must be paid again
+func (w *electrumWatcher) checkPaidBlocks() error {
+	for height, paid := range w.paidBlocks {
+
+		// deep enough that it will not be reorganised
+		if height < w.height-electrumReorgDepth {
+			delete(w.paidBlocks, height)
+			continue
+		}
+
+		header, err := w.fetchHeader(height)
+		if err != nil {
+			return err
+		}
+		if header.BlockHash().String() == paid.hash {
+			continue
+		}
+
+		delete(w.paidBlocks, height)
+		w.headers.Delete(electrumHeightKey(height))
+
+		n := w.unverify(w.currency, paid.hash)
+		w.log.Warnf("reverted: %d payments of block: %s  height: %d", n, paid.hash, height)
+
+		// the transactions may be mined again on the new chain
+		for _, txId := range paid.txIds {
+			w.inspected.Delete(txId)
+		}
+		for scriptHash := range w.subscribed {
+			w.stale[scriptHash] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// pass any payment in the transaction to the reservoir
+//
+// returns true if a payment was found
+func (w *electrumWatcher) inspect(tx *wire.MsgTx, blockHash string) bool {
+	id, amounts := examineTransaction(tx, w.networkParams)
+	if id == nil {
+		return false
+	}
+
+	var payId pay.PayId
+	copy(payId[:], id)
+	txId := tx.TxHash().String()
+
+	w.log.Debugf("Find a potential payment. payId: %s, txId: %s", payId.String(), txId)
+
+	w.verify(
+		payId,
+		&reservoir.PaymentDetail{
+			Currency:  w.currency,
+			TxID:      txId,
+			Amounts:   amounts,
+			BlockHash: blockHash,
+		},
+	)
+	return true
+}
+
+// electrumMerkleRoot - the root reached by a Merkle branch, given in
+// the displayed hex order, from the transaction at pos in its block
+func electrumMerkleRoot(txHash chainhash.Hash, branch []string, pos int) (chainhash.Hash, error) {
+	if len(branch) > electrumMaximumBranch || pos < 0 || pos >= 1<<uint(len(branch)) {
+		return chainhash.Hash{}, fault.InvalidElectrumResponse
+	}
+
+	hash := txHash
+	for _, s := range branch {
+		sibling, err := chainhash.NewHashFromStr(s)
+		if err != nil {
+			return chainhash.Hash{}, err
+		}
+
+		var pair [2 * chainhash.HashSize]byte
+		if 0 == pos&1 {
+			copy(pair[:chainhash.HashSize], hash[:])
+			copy(pair[chainhash.HashSize:], sibling[:])
+		} else {
+			copy(pair[:chainhash.HashSize], sibling[:])
+			copy(pair[chainhash.HashSize:], hash[:])
+		}
+		hash = chainhash.DoubleHashH(pair[:])
+		pos >>= 1
+	}
+	return hash, nil
+}
+
+func electrumHeightKey(height int64) string {
+	return strconv.FormatInt(height, 10)
+}
+
+// electrumScriptHash - the script hash used by Electrum to identify an
+// address: the reversed SHA256 of its output script in hex
+func electrumScriptHash(c currency.Currency, address string) (string, error) {
+	var script []byte
+
+	switch c {
+	case currency.Bitcoin:
+		version, addressBytes, err := bitcoin.ValidateAddress(address)
+		if err != nil {
+			return "", err
+		}
+		switch version {
+		case bitcoin.LivenetScript, bitcoin.TestnetScript:
+			script = payToScriptHash(addressBytes[:])
+		default:
+			script = payToPubKeyHash(addressBytes[:])
+		}
+
+	case currency.Litecoin:
+		version, addressBytes, err := litecoin.ValidateAddress(address)
+		if err != nil {
+			return "", err
+		}
+		switch version {
+		case litecoin.LivenetScript, litecoin.LivenetScript2, litecoin.TestnetScript, litecoin.TestnetScript2:
+			script = payToScriptHash(addressBytes[:])
+		default:
+			script = payToPubKeyHash(addressBytes[:])
+		}
+
+	default:
+		return "", fault.UnsupportedCurrency
+	}
+
+	digest := sha256.Sum256(script)
+	for i, j := 0, len(digest)-1; i < j; i, j = i+1, j-1 {
+		digest[i], digest[j] = digest[j], digest[i]
+	}
+	return hex.EncodeToString(digest[:]), nil
+}
+
+// OP_DUP OP_HASH160 <hash> OP_EQUALVERIFY OP_CHECKSIG
+func payToPubKeyHash(hash []byte) []byte {
+	script := []byte{0x76, 0xa9, byte(len(hash))}
+	script = append(script, hash...)
+	return append(script, 0x88, 0xac)
+}
+
+// OP_HASH160 <hash> OP_EQUAL
+func payToScriptHash(hash []byte) []byte {
+	script := []byte{0xa9, byte(len(hash))}
+	script = append(script, hash...)
+	return append(script, 0x87)
+}
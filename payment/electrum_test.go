@@ -0,0 +1,572 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package payment
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/currency/bitcoin"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+)
+
+const (
+	electrumTestAddress    = "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn"
+	electrumTestHeight     = 100
+	electrumTestPaidHeight = electrumTestHeight - 5
+	electrumTestBits       = 0x207fffff // regtest limit, about one in two hashes
+)
+
+// fakeElectrumServer answers the subset of the Electrum protocol used
+// by the watcher from fixed data
+type fakeElectrumServer struct {
+	sync.Mutex
+
+	listener   net.Listener
+	scriptHash string
+	history    []electrumHistory
+	txs        map[string]string         // tx hash → raw tx hex
+	merkles    map[string]electrumMerkle // tx hash → branch
+	headers    []wire.BlockHeader        // by height
+
+	encoder  *json.Encoder  // of the latest connection
+	requests map[string]int // method → count
+}
+
+func newFakeElectrumServer(t *testing.T) *fakeElectrumServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen error: %s", err)
+	}
+	s := &fakeElectrumServer{
+		listener: listener,
+		txs:      make(map[string]string),
+		merkles:  make(map[string]electrumMerkle),
+		headers:  newElectrumTestChain(),
+		requests: make(map[string]int),
+	}
+	go s.serve()
+	return s
+}
+
+func (s *fakeElectrumServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeElectrumServer) handle(conn net.Conn) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	encoder := json.NewEncoder(conn)
+	s.Lock()
+	s.encoder = encoder
+	s.Unlock()
+
+	for scanner.Scan() {
+		var request struct {
+			Id     uint64            `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &request); err != nil {
+			return
+		}
+
+		s.Lock()
+		s.requests[request.Method] += 1
+
+		var result interface{}
+		switch request.Method {
+		case "server.version":
+			result = []string{"fake 1.0", electrumProtocolVersion}
+		case "blockchain.headers.subscribe":
+			result = electrumHeader{Height: electrumTestHeight, Hex: s.headerHex(electrumTestHeight, 1)}
+		case "blockchain.scripthash.subscribe":
+			var scriptHash string
+			_ = json.Unmarshal(request.Params[0], &scriptHash)
+			if scriptHash == s.scriptHash && len(s.history) > 0 {
+				result = "status"
+			}
+		case "blockchain.scripthash.get_history":
+			result = s.history
+		case "blockchain.block.header":
+			var height int
+			_ = json.Unmarshal(request.Params[0], &height)
+			result = s.headerHex(height, 1)
+		case "blockchain.block.headers":
+			var start, count int
+			_ = json.Unmarshal(request.Params[0], &start)
+			_ = json.Unmarshal(request.Params[1], &count)
+			result = electrumHeaders{Count: count, Hex: s.headerHex(start, count)}
+		case "blockchain.transaction.get":
+			var txHash string
+			_ = json.Unmarshal(request.Params[0], &txHash)
+			result = s.txs[txHash]
+		case "blockchain.transaction.get_merkle":
+			var txHash string
+			_ = json.Unmarshal(request.Params[0], &txHash)
+			result = s.merkles[txHash]
+		}
+
+		_ = encoder.Encode(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      request.Id,
+			"result":  result,
+		})
+		s.Unlock()
+	}
+}
+
+// hex of count headers from start, called with the server locked
+func (s *fakeElectrumServer) headerHex(start int, count int) string {
+	var buffer bytes.Buffer
+	for i := start; i < start+count && i < len(s.headers); i += 1 {
+		_ = s.headers[i].Serialize(&buffer)
+	}
+	return hex.EncodeToString(buffer.Bytes())
+}
+
+// add a transaction as the only one in the block at a height
+func (s *fakeElectrumServer) addTx(tx *wire.MsgTx, height int64) {
+	var rawTx bytes.Buffer
+	_ = tx.Serialize(&rawTx)
+	txHash := tx.TxHash()
+
+	s.txs[txHash.String()] = hex.EncodeToString(rawTx.Bytes())
+	s.merkles[txHash.String()] = electrumMerkle{BlockHeight: height, Merkle: []string{}, Pos: 0}
+
+	s.headers[height].MerkleRoot = txHash
+	relinkElectrumTestChain(s.headers, height)
+}
+
+// send a notification on the latest connection
+func (s *fakeElectrumServer) notify(method string, params ...interface{}) {
+	s.Lock()
+	defer s.Unlock()
+	_ = s.encoder.Encode(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  method,
+		"params":  params,
+	})
+}
+
+// wait until a method has been requested n times
+func (s *fakeElectrumServer) waitFor(t *testing.T, method string, n int) {
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		s.Lock()
+		count := s.requests[method]
+		s.Unlock()
+		if count >= n {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("timeout waiting for: %d of: %s", n, method)
+}
+
+func (s *fakeElectrumServer) count(method string) int {
+	s.Lock()
+	defer s.Unlock()
+	return s.requests[method]
+}
+
+func (s *fakeElectrumServer) Close() {
+	s.listener.Close()
+}
+
+// mined headers linked from height zero to one past the test height
+func newElectrumTestChain() []wire.BlockHeader {
+	headers := make([]wire.BlockHeader, electrumTestHeight+2)
+	for i := range headers {
+		headers[i] = wire.BlockHeader{
+			Version:    1,
+			Timestamp:  time.Unix(time.Now().Unix(), 0),
+			Bits:       electrumTestBits,
+			MerkleRoot: chainhash.Hash{byte(i)},
+		}
+	}
+	relinkElectrumTestChain(headers, 0)
+	return headers
+}
+
+// mine the headers from a height again, so that each links to the one
+// before it
+func relinkElectrumTestChain(headers []wire.BlockHeader, from int64) {
+	for i := from; i < int64(len(headers)); i += 1 {
+		if i > 0 {
+			headers[i].PrevBlock = headers[i-1].BlockHash()
+		}
+		mineElectrumTestHeader(&headers[i], true)
+	}
+}
+
+// change the nonce until the header does, or does not, meet its bits
+func mineElectrumTestHeader(header *wire.BlockHeader, valid bool) {
+	target := blockchain.CompactToBig(header.Bits)
+	for {
+		hash := header.BlockHash()
+		if (blockchain.HashToBig(&hash).Cmp(target) <= 0) == valid {
+			return
+		}
+		header.Nonce += 1
+	}
+}
+
+// a transaction paying the test address with a pay id
+func newElectrumTestTx(payId pay.PayId, amount uint64) *wire.MsgTx {
+	_, addressBytes, _ := bitcoin.ValidateAddress(electrumTestAddress)
+	return &wire.MsgTx{
+		Version: 1,
+		TxIn: []*wire.TxIn{
+			{
+				PreviousOutPoint: wire.OutPoint{Hash: chainhash.Hash{9}, Index: 0},
+				Sequence:         wire.MaxTxInSequenceNum,
+			},
+		},
+		TxOut: []*wire.TxOut{
+			{
+				Value:    int64(amount),
+				PkScript: payToPubKeyHash(addressBytes[:]),
+			},
+			{
+				Value:    0,
+				PkScript: append([]byte{0x6a, 0x30}, payId[:]...),
+			},
+		},
+	}
+}
+
+// a watcher of the test address on the server, on the regression
+// network so that the test headers can be mined
+func newTestElectrumWatcher(t *testing.T, server *fakeElectrumServer, confirmations int) *electrumWatcher {
+	w, err := newElectrumWatcher(currency.Bitcoin, &electrumServerConfiguration{
+		Server: server.listener.Addr().String(),
+	}, confirmations)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	w.networkParams = &chaincfg.RegressionNetParams
+	w.addresses = func(c currency.Currency) []string {
+		return []string{electrumTestAddress}
+	}
+	return w
+}
+
+func TestElectrumScriptHash(t *testing.T) {
+	// P2PKH script for a testnet address
+	_, addressBytes, err := bitcoin.ValidateAddress(electrumTestAddress)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	script := append([]byte{0x76, 0xa9, 0x14}, addressBytes[:]...)
+	script = append(script, 0x88, 0xac)
+	expected := chainhash.HashH(script) // sha256 without reversal
+
+	scriptHash, err := electrumScriptHash(currency.Bitcoin, electrumTestAddress)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// chainhash displays in reversed byte order as Electrum requires
+	if scriptHash != expected.String() {
+		t.Fatalf("unexpected script hash: %s  expected: %s", scriptHash, expected.String())
+	}
+
+	if _, err := electrumScriptHash(currency.Bitcoin, "not-an-address"); err == nil {
+		t.Fatal("expected an error for an invalid address")
+	}
+}
+
+func TestElectrumWatcherVerifiesPayment(t *testing.T) {
+	var paidAmount uint64 = 20000
+
+	payId := pay.PayId{1, 2, 3, 4, 5}
+	tx := newElectrumTestTx(payId, paidAmount)
+	txHash := tx.TxHash().String()
+
+	scriptHash, _ := electrumScriptHash(currency.Bitcoin, electrumTestAddress)
+
+	server := newFakeElectrumServer(t)
+	defer server.Close()
+	server.scriptHash = scriptHash
+	server.history = []electrumHistory{
+		{TxHash: txHash, Height: electrumTestPaidHeight},
+		{TxHash: "unconfirmed", Height: 0},
+	}
+	server.addTx(tx, electrumTestPaidHeight)
+	header := server.headers[electrumTestPaidHeight]
+
+	w := newTestElectrumWatcher(t, server, 1)
+
+	type verified struct {
+		payId  pay.PayId
+		detail *reservoir.PaymentDetail
+	}
+	results := make(chan verified, 5)
+
+	w.verify = func(payId pay.PayId, detail *reservoir.PaymentDetail) {
+		results <- verified{payId, detail}
+	}
+
+	shutdown := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		w.Run(nil, shutdown)
+		close(stopped)
+	}()
+
+	select {
+	case r := <-results:
+		if r.payId != payId {
+			t.Errorf("unexpected pay id: %s  expected: %s", r.payId, payId)
+		}
+		if r.detail.Currency != currency.Bitcoin {
+			t.Errorf("unexpected currency: %s", r.detail.Currency)
+		}
+		if r.detail.TxID != txHash {
+			t.Errorf("unexpected tx id: %s  expected: %s", r.detail.TxID, txHash)
+		}
+		if r.detail.BlockHash != header.BlockHash().String() {
+			t.Errorf("unexpected block hash: %s  expected: %s", r.detail.BlockHash, header.BlockHash())
+		}
+		if v := r.detail.Amounts[electrumTestAddress]; v != paidAmount {
+			t.Errorf("unexpected amount: %d  expected: %d", v, paidAmount)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for payment")
+	}
+
+	close(shutdown)
+	select {
+	case <-stopped:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for watcher to stop")
+	}
+
+	if _, found := w.inspected.Get(txHash); !found {
+		t.Error("paid tx was not marked as inspected")
+	}
+	if _, found := w.inspected.Get("unconfirmed"); found {
+		t.Error("unconfirmed tx was inspected")
+	}
+
+	select {
+	case r := <-results:
+		t.Errorf("unexpected extra payment: %v", r)
+	default:
+	}
+}
+
+func TestElectrumWatcherFollowsStatus(t *testing.T) {
+	scriptHash, _ := electrumScriptHash(currency.Bitcoin, electrumTestAddress)
+
+	server := newFakeElectrumServer(t)
+	defer server.Close()
+	server.scriptHash = scriptHash
+	server.history = []electrumHistory{
+		{TxHash: "mempool", Height: 0},
+	}
+
+	w := newTestElectrumWatcher(t, server, 1)
+	w.verify = func(pay.PayId, *reservoir.PaymentDetail) {}
+
+	shutdown := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		w.Run(nil, shutdown)
+		close(stopped)
+	}()
+	defer func() {
+		close(shutdown)
+		<-stopped
+	}()
+
+	// the subscription result is the status
+	server.waitFor(t, "blockchain.scripthash.get_history", 1)
+
+	// neither an unchanged status nor a new tip fetches the history
+	server.notify("blockchain.scripthash.subscribe", scriptHash, "status")
+	server.notify("blockchain.headers.subscribe", electrumHeader{Height: electrumTestHeight + 1})
+
+	// notifications are handled in order, so the earlier ones are
+	// done once the changed status is fetched
+	server.notify("blockchain.scripthash.subscribe", scriptHash, "changed")
+	server.waitFor(t, "blockchain.scripthash.get_history", 2)
+	if n := server.count("blockchain.scripthash.get_history"); n != 2 {
+		t.Errorf("history fetched: %d times  expected: 2", n)
+	}
+}
+
+// each check of the server's data drops the connection before the
+// payment is passed on
+func TestElectrumWatcherRejectsServerData(t *testing.T) {
+	payId := pay.PayId{1, 2, 3, 4, 5}
+	tx := newElectrumTestTx(payId, 20000)
+	txHash := tx.TxHash().String()
+
+	scriptHash, _ := electrumScriptHash(currency.Bitcoin, electrumTestAddress)
+
+	items := []struct {
+		name          string
+		confirmations int
+		change        func(*fakeElectrumServer, *electrumWatcher)
+		err           error
+	}{
+		{
+			name:          "other transaction",
+			confirmations: 1,
+			change: func(s *fakeElectrumServer, w *electrumWatcher) {
+				other := newElectrumTestTx(payId, 30000)
+				var rawTx bytes.Buffer
+				_ = other.Serialize(&rawTx)
+				s.txs[txHash] = hex.EncodeToString(rawTx.Bytes())
+			},
+			err: fault.TransactionHashDoesNotMatch,
+		},
+		{
+			name:          "not in block",
+			confirmations: 1,
+			change: func(s *fakeElectrumServer, w *electrumWatcher) {
+				s.headers[electrumTestPaidHeight].MerkleRoot = chainhash.Hash{1}
+				relinkElectrumTestChain(s.headers, electrumTestPaidHeight)
+			},
+			err: fault.MerkleRootDoesNotMatch,
+		},
+		{
+			name:          "wrong branch",
+			confirmations: 1,
+			change: func(s *fakeElectrumServer, w *electrumWatcher) {
+				s.merkles[txHash] = electrumMerkle{
+					BlockHeight: electrumTestPaidHeight,
+					Merkle:      []string{chainhash.Hash{2}.String()},
+					Pos:         1,
+				}
+			},
+			err: fault.MerkleRootDoesNotMatch,
+		},
+		{
+			name:          "no proof of work",
+			confirmations: 1,
+			change: func(s *fakeElectrumServer, w *electrumWatcher) {
+				mineElectrumTestHeader(&s.headers[electrumTestPaidHeight], false)
+			},
+			err: fault.InvalidBlockHeaderDifficulty,
+		},
+		{
+			name:          "bits above limit",
+			confirmations: 1,
+			change: func(s *fakeElectrumServer, w *electrumWatcher) {
+				w.networkParams = &chaincfg.TestNet3Params
+			},
+			err: fault.InvalidBlockHeaderDifficulty,
+		},
+		{
+			name:          "unlinked confirmation",
+			confirmations: 2,
+			change: func(s *fakeElectrumServer, w *electrumWatcher) {
+				s.headers[electrumTestPaidHeight+1].PrevBlock = chainhash.Hash{3}
+				mineElectrumTestHeader(&s.headers[electrumTestPaidHeight+1], true)
+			},
+			err: fault.PreviousBlockDigestDoesNotMatch,
+		},
+		{
+			name:          "unlinked cached header",
+			confirmations: 1,
+			change: func(s *fakeElectrumServer, w *electrumWatcher) {
+				previous := s.headers[electrumTestPaidHeight-1]
+				previous.Nonce += 1
+				mineElectrumTestHeader(&previous, true)
+				w.headers.SetDefault(electrumHeightKey(electrumTestPaidHeight-1), &previous)
+			},
+			err: fault.PreviousBlockDigestDoesNotMatch,
+		},
+	}
+
+	for _, item := range items {
+		server := newFakeElectrumServer(t)
+		server.scriptHash = scriptHash
+		server.history = []electrumHistory{
+			{TxHash: txHash, Height: electrumTestPaidHeight},
+		}
+		server.addTx(tx, electrumTestPaidHeight)
+
+		w := newTestElectrumWatcher(t, server, item.confirmations)
+		w.verify = func(pay.PayId, *reservoir.PaymentDetail) {
+			t.Errorf("%s: payment was verified", item.name)
+		}
+		item.change(server, w)
+
+		if err := w.connect(); err != nil {
+			t.Fatalf("%s: connect error: %s", item.name, err)
+		}
+		shutdown := make(chan struct{})
+		timer := time.AfterFunc(10*time.Second, func() { close(shutdown) })
+
+		err := w.follow(shutdown)
+		timer.Stop()
+		w.disconnect()
+		server.Close()
+
+		if err != item.err {
+			t.Errorf("%s: error: %v  expected: %s", item.name, err, item.err)
+		}
+		if _, found := w.inspected.Get(txHash); found {
+			t.Errorf("%s: rejected tx was marked as inspected", item.name)
+		}
+	}
+}
+
+func TestElectrumMerkleRoot(t *testing.T) {
+	txs := []chainhash.Hash{{1}, {2}, {3}}
+
+	// the last transaction is paired with itself
+	left := chainhash.DoubleHashH(append(txs[0][:], txs[1][:]...))
+	right := chainhash.DoubleHashH(append(txs[2][:], txs[2][:]...))
+	root := chainhash.DoubleHashH(append(left[:], right[:]...))
+
+	items := []struct {
+		pos    int
+		branch []string
+	}{
+		{0, []string{txs[1].String(), right.String()}},
+		{1, []string{txs[0].String(), right.String()}},
+		{2, []string{txs[2].String(), left.String()}},
+	}
+	for _, item := range items {
+		r, err := electrumMerkleRoot(txs[item.pos], item.branch, item.pos)
+		if err != nil {
+			t.Fatalf("pos: %d  unexpected error: %s", item.pos, err)
+		}
+		if r != root {
+			t.Errorf("pos: %d  root: %s  expected: %s", item.pos, r, root)
+		}
+	}
+
+	if _, err := electrumMerkleRoot(txs[0], []string{txs[1].String()}, 2); err != fault.InvalidElectrumResponse {
+		t.Errorf("unexpected error: %v  for a position beyond the branch", err)
+	}
+}
@@ -0,0 +1,367 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package payment
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/constants"
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+	"github.com/bitmark-inc/logger"
+)
+
+const (
+	lightningTimeout      = 30 * time.Second // per request
+	lightningPollInterval = 10 * time.Second // check open invoices
+	lightningTxIdPrefix   = "lightning:"     // distinguish payment hashes from tx ids
+)
+
+// access to an LND node's REST interface
+type lightningConfiguration struct {
+	URL         string `gluamapper:"url" json:"url"`                 // e.g. https://127.0.0.1:8080
+	Macaroon    string `gluamapper:"macaroon" json:"macaroon"`       // invoice macaroon file
+	Certificate string `gluamapper:"certificate" json:"certificate"` // LND tls.cert file
+	Address     string `gluamapper:"address" json:"address"`         // Bitcoin address of this node's payments
+}
+
+// Invoice - a BOLT11 invoice for the Bitcoin payment of a pay id
+type Invoice struct {
+	PayId          pay.PayId `json:"payId"`
+	PaymentRequest string    `json:"paymentRequest"`
+	PaymentHash    string    `json:"paymentHash"`
+	Amount         uint64    `json:"amount,string"` // satoshi
+	ExpiresAt      time.Time `json:"expiresAt"`
+}
+
+// an invoice waiting to be settled
+type lightningInvoice struct {
+	Invoice
+	amounts map[string]uint64 // Bitcoin address → satoshi, of the alternative being paid
+}
+
+// lightningWatcher creates invoices for pending pay ids and polls
+// them until they settle
+//
+// the money goes to the operator's LND wallet, so only a Bitcoin
+// payment made entirely to the node's own address can be settled this
+// way, any other payee would never receive their share
+type lightningWatcher struct {
+	sync.Mutex
+
+	log     *logger.L
+	client  *lndClient
+	address string // the only payee that can be settled
+
+	// sources of payments and destination of settled invoices
+	payments func(pay.PayId) ([]transactionrecord.PaymentAlternative, error)
+	verify   func(pay.PayId, *reservoir.PaymentDetail)
+
+	invoices map[pay.PayId]*lightningInvoice
+}
+
+func newLightningWatcher(conf *lightningConfiguration) (*lightningWatcher, error) {
+	log := logger.New("lightning")
+
+	client, err := newLndClient(conf, log)
+	if err != nil {
+		return nil, err
+	}
+	if conf.Address == "" {
+		return nil, fault.MissingLightningAddress
+	}
+
+	return &lightningWatcher{
+		log:      log,
+		client:   client,
+		address:  conf.Address,
+		payments: reservoir.PendingPayments,
+		verify:   reservoir.SetTransferVerified,
+		invoices: make(map[pay.PayId]*lightningInvoice),
+	}, nil
+}
+
+// LightningInvoice - invoice for the Bitcoin payment of a pending pay
+// id, an unexpired invoice is reused
+func LightningInvoice(payId pay.PayId) (*Invoice, error) {
+	globalData.RLock()
+	w := globalData.lightning
+	globalData.RUnlock()
+
+	if w == nil {
+		return nil, fault.LightningNotEnabled
+	}
+	return w.invoice(payId)
+}
+
+func (w *lightningWatcher) invoice(payId pay.PayId) (*Invoice, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	if existing, ok := w.invoices[payId]; ok && time.Now().Before(existing.ExpiresAt) {
+		invoice := existing.Invoice
+		return &invoice, nil
+	}
+
+	alternatives, err := w.payments(payId)
+	if err != nil {
+		return nil, err
+	}
+
+	// only an alternative paid entirely in Bitcoin to this node can be
+	// settled
+	err = fault.NoBitcoinPayment
+	total := uint64(0)
+alternatives_loop:
+	for _, alternative := range alternatives {
+		t := uint64(0)
+		for _, payment := range alternative {
+			if payment.Currency != currency.Bitcoin {
+				continue alternatives_loop
+			}
+			if payment.Address != w.address {
+				err = fault.LightningPayeeIsNotThisNode
+				continue alternatives_loop
+			}
+			t += payment.Amount
+		}
+		if t > 0 {
+			total = t
+			break alternatives_loop
+		}
+	}
+	if total == 0 {
+		return nil, err
+	}
+
+	expiry := constants.ReservoirTimeout
+	added, err := w.client.addInvoice("bitmark pay id: "+payId.String(), total, expiry)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := &lightningInvoice{
+		Invoice: Invoice{
+			PayId:          payId,
+			PaymentRequest: added.PaymentRequest,
+			PaymentHash:    hex.EncodeToString(added.PaymentHash),
+			Amount:         total,
+			ExpiresAt:      time.Now().Add(expiry).UTC(),
+		},
+		amounts: map[string]uint64{w.address: total},
+	}
+	w.invoices[payId] = invoice
+
+	w.log.Infof("invoice payid: %s  hash: %s  amount: %d", payId, invoice.PaymentHash, total)
+
+	result := invoice.Invoice
+	return &result, nil
+}
+
+func (w *lightningWatcher) Run(args interface{}, shutdown <-chan struct{}) {
+	w.log.Infof("starting… url: %s", w.client.url)
+
+loop:
+	for {
+		select {
+		case <-shutdown:
+			break loop
+		case <-time.After(lightningPollInterval):
+			w.poll()
+		}
+	}
+
+	w.log.Info("stopped")
+}
+
+// check every open invoice, passing settled ones to the reservoir
+func (w *lightningWatcher) poll() {
+	w.Lock()
+	open := make([]*lightningInvoice, 0, len(w.invoices))
+	for _, invoice := range w.invoices {
+		open = append(open, invoice)
+	}
+	w.Unlock()
+
+	now := time.Now()
+
+	for _, invoice := range open {
+		state, err := w.client.lookupInvoice(invoice.PaymentHash)
+		if err != nil {
+			w.log.Errorf("lookup hash: %s  error: %s", invoice.PaymentHash, err)
+			state = &lndInvoiceReply{}
+		}
+
+		switch {
+		case state.State == lndStateSettled && state.AmountPaid >= invoice.Amount:
+			w.log.Infof("settled payid: %s  hash: %s", invoice.PayId, invoice.PaymentHash)
+			w.verify(invoice.PayId, &reservoir.PaymentDetail{
+				Currency: currency.Bitcoin,
+				TxID:     lightningTxIdPrefix + invoice.PaymentHash,
+				Amounts:  invoice.amounts,
+			})
+
+		case state.State == lndStateCanceled || now.After(invoice.ExpiresAt):
+			w.log.Debugf("expired payid: %s  hash: %s", invoice.PayId, invoice.PaymentHash)
+
+		default:
+			continue
+		}
+
+		w.Lock()
+		if w.invoices[invoice.PayId] == invoice {
+			delete(w.invoices, invoice.PayId)
+		}
+		w.Unlock()
+	}
+}
+
+// LND REST client
+// ---------------
+
+const (
+	lndStateSettled  = "SETTLED"
+	lndStateCanceled = "CANCELED"
+)
+
+type lndClient struct {
+	log      *logger.L
+	client   *http.Client
+	url      string
+	macaroon string // hex
+}
+
+type lndAddInvoiceRequest struct {
+	Memo   string `json:"memo"`
+	Value  uint64 `json:"value,string"`
+	Expiry int64  `json:"expiry,string"`
+}
+
+type lndAddInvoiceReply struct {
+	PaymentHash    []byte `json:"r_hash"` // base64 in JSON
+	PaymentRequest string `json:"payment_request"`
+}
+
+type lndInvoiceReply struct {
+	State      string `json:"state"`
+	AmountPaid uint64 `json:"amt_paid_sat,string"`
+}
+
+func newLndClient(conf *lightningConfiguration, log *logger.L) (*lndClient, error) {
+	if conf == nil || conf.URL == "" {
+		return nil, fault.MissingPaymentLightningSection
+	}
+
+	transport := &http.Transport{}
+	if conf.Certificate != "" {
+		pem, err := ioutil.ReadFile(conf.Certificate)
+		if err != nil {
+			return nil, err
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(pem) {
+			return nil, fault.InvalidLightningCertificate
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    roots,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	macaroon := ""
+	if conf.Macaroon != "" {
+		data, err := ioutil.ReadFile(conf.Macaroon)
+		if err != nil {
+			return nil, err
+		}
+		macaroon = hex.EncodeToString(data)
+	}
+
+	return &lndClient{
+		log: log,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   lightningTimeout,
+		},
+		url:      conf.URL,
+		macaroon: macaroon,
+	}, nil
+}
+
+// create an invoice for an amount in satoshi
+func (c *lndClient) addInvoice(memo string, amount uint64, expiry time.Duration) (*lndAddInvoiceReply, error) {
+	body, err := json.Marshal(lndAddInvoiceRequest{
+		Memo:   memo,
+		Value:  amount,
+		Expiry: int64(expiry / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var reply lndAddInvoiceReply
+	err = c.do("POST", "/v1/invoices", body, &reply)
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.PaymentHash) == 0 || reply.PaymentRequest == "" {
+		return nil, fault.InvalidLightningResponse
+	}
+	return &reply, nil
+}
+
+// current state of an invoice from its hex payment hash
+func (c *lndClient) lookupInvoice(paymentHash string) (*lndInvoiceReply, error) {
+	var reply lndInvoiceReply
+	err := c.do("GET", "/v1/invoice/"+paymentHash, nil, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+func (c *lndClient) do(method string, path string, body []byte, reply interface{}) error {
+	request, err := http.NewRequest(method, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if c.macaroon != "" {
+		request.Header.Set("Grpc-Metadata-macaroon", c.macaroon)
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := c.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	data, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if http.StatusOK != response.StatusCode {
+		c.log.Errorf("%s %s: status: %q", method, path, response.Status)
+		return fault.LightningRequestFailed
+	}
+	if err := json.Unmarshal(data, reply); err != nil {
+		return fault.InvalidLightningResponse
+	}
+	return nil
+}
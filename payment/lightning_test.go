@@ -0,0 +1,294 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package payment
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// fakeLnd stands in for the parts of the LND REST interface used by
+// the lightning watcher
+type fakeLnd struct {
+	sync.Mutex
+	server   *httptest.Server
+	macaroon string
+	invoices map[string]*fakeLndInvoice // hex payment hash → invoice
+}
+
+type fakeLndInvoice struct {
+	memo  string
+	value uint64
+	paid  uint64
+	state string
+}
+
+func newFakeLnd(t *testing.T) *fakeLnd {
+	l := &fakeLnd{
+		macaroon: "0102",
+		invoices: make(map[string]*fakeLndInvoice),
+	}
+	l.server = httptest.NewServer(http.HandlerFunc(l.handle))
+	return l
+}
+
+func (l *fakeLnd) handle(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Grpc-Metadata-macaroon") != l.macaroon {
+		http.Error(w, "permission denied", http.StatusForbidden)
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	switch {
+	case r.Method == "POST" && r.URL.Path == "/v1/invoices":
+		var request lndAddInvoiceRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		hash := sha256.Sum256([]byte(request.Memo + strconv.Itoa(len(l.invoices))))
+		l.invoices[hex.EncodeToString(hash[:])] = &fakeLndInvoice{
+			memo:  request.Memo,
+			value: request.Value,
+			state: "OPEN",
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"r_hash":          hash[:], // encoded as base64
+			"payment_request": "lntb" + strconv.FormatUint(request.Value, 10),
+		})
+
+	case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/v1/invoice/"):
+		invoice, ok := l.invoices[strings.TrimPrefix(r.URL.Path, "/v1/invoice/")]
+		if !ok {
+			http.Error(w, "unable to locate invoice", http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"memo":         invoice.memo,
+			"state":        invoice.state,
+			"amt_paid_sat": strconv.FormatUint(invoice.paid, 10),
+		})
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// settle the invoice with a payment hash
+func (l *fakeLnd) settle(paymentHash string, paid uint64) {
+	l.Lock()
+	defer l.Unlock()
+	invoice := l.invoices[paymentHash]
+	invoice.state = lndStateSettled
+	invoice.paid = paid
+}
+
+func (l *fakeLnd) Close() {
+	l.server.Close()
+}
+
+const testLightningAddress = "btc-node-address"
+
+func newTestLightningWatcher(t *testing.T, l *fakeLnd) *lightningWatcher {
+	w, err := newLightningWatcher(&lightningConfiguration{
+		URL:     l.server.URL,
+		Address: testLightningAddress,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	w.client.macaroon = l.macaroon
+	return w
+}
+
+func TestLightningInvoiceSettled(t *testing.T) {
+	lnd := newFakeLnd(t)
+	defer lnd.Close()
+
+	w := newTestLightningWatcher(t, lnd)
+
+	payId := pay.PayId{1, 2, 3}
+	w.payments = func(id pay.PayId) ([]transactionrecord.PaymentAlternative, error) {
+		if id != payId {
+			return nil, fault.PayIdIsNotPending
+		}
+		return []transactionrecord.PaymentAlternative{
+			{
+				{Currency: currency.Litecoin, Address: "ltc-address", Amount: 1000},
+			},
+			{
+				{Currency: currency.Bitcoin, Address: testLightningAddress, Amount: 200},
+				{Currency: currency.Bitcoin, Address: testLightningAddress, Amount: 300},
+			},
+		}, nil
+	}
+
+	type verified struct {
+		payId  pay.PayId
+		detail *reservoir.PaymentDetail
+	}
+	results := make([]verified, 0, 1)
+	w.verify = func(payId pay.PayId, detail *reservoir.PaymentDetail) {
+		results = append(results, verified{payId, detail})
+	}
+
+	if _, err := w.invoice(pay.PayId{9}); err != fault.PayIdIsNotPending {
+		t.Fatalf("unexpected error: %v  expected: %s", err, fault.PayIdIsNotPending)
+	}
+
+	invoice, err := w.invoice(payId)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if invoice.Amount != 500 {
+		t.Errorf("unexpected amount: %d  expected: 500", invoice.Amount)
+	}
+	if invoice.PaymentRequest != "lntb500" {
+		t.Errorf("unexpected payment request: %q", invoice.PaymentRequest)
+	}
+	if invoice.PayId != payId {
+		t.Errorf("unexpected pay id: %s", invoice.PayId)
+	}
+
+	// an open invoice is reused
+	again, err := w.invoice(payId)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if again.PaymentHash != invoice.PaymentHash {
+		t.Errorf("new invoice created: %s  expected: %s", again.PaymentHash, invoice.PaymentHash)
+	}
+
+	w.poll()
+	if len(results) != 0 {
+		t.Fatalf("open invoice verified: %v", results)
+	}
+
+	// underpaid invoices are not accepted
+	lnd.settle(invoice.PaymentHash, 499)
+	w.poll()
+	if len(results) != 0 {
+		t.Fatalf("underpaid invoice verified: %v", results)
+	}
+
+	lnd.settle(invoice.PaymentHash, 500)
+	w.poll()
+	if len(results) != 1 {
+		t.Fatalf("unexpected verified count: %d", len(results))
+	}
+
+	r := results[0]
+	if r.payId != payId {
+		t.Errorf("unexpected pay id: %s  expected: %s", r.payId, payId)
+	}
+	if r.detail.Currency != currency.Bitcoin {
+		t.Errorf("unexpected currency: %s", r.detail.Currency)
+	}
+	if r.detail.TxID != lightningTxIdPrefix+invoice.PaymentHash {
+		t.Errorf("unexpected tx id: %s", r.detail.TxID)
+	}
+	if len(r.detail.Amounts) != 1 || r.detail.Amounts[testLightningAddress] != 500 {
+		t.Errorf("unexpected amounts: %v  expected: 500 to: %s", r.detail.Amounts, testLightningAddress)
+	}
+
+	// settled invoices are no longer polled
+	if len(w.invoices) != 0 {
+		t.Errorf("unexpected open invoices: %d", len(w.invoices))
+	}
+}
+
+func TestLightningInvoiceWithoutBitcoin(t *testing.T) {
+	lnd := newFakeLnd(t)
+	defer lnd.Close()
+
+	w := newTestLightningWatcher(t, lnd)
+	w.payments = func(id pay.PayId) ([]transactionrecord.PaymentAlternative, error) {
+		return []transactionrecord.PaymentAlternative{
+			{
+				{Currency: currency.Litecoin, Address: "ltc-address", Amount: 1000},
+			},
+		}, nil
+	}
+
+	if _, err := w.invoice(pay.PayId{1}); err != fault.NoBitcoinPayment {
+		t.Fatalf("unexpected error: %v  expected: %s", err, fault.NoBitcoinPayment)
+	}
+}
+
+func TestLightningInvoiceWhenOtherPayee(t *testing.T) {
+	lnd := newFakeLnd(t)
+	defer lnd.Close()
+
+	w := newTestLightningWatcher(t, lnd)
+	w.payments = func(id pay.PayId) ([]transactionrecord.PaymentAlternative, error) {
+		return []transactionrecord.PaymentAlternative{
+			{
+				{Currency: currency.Bitcoin, Address: testLightningAddress, Amount: 200},
+				{Currency: currency.Bitcoin, Address: "btc-other-address", Amount: 300},
+			},
+		}, nil
+	}
+
+	if _, err := w.invoice(pay.PayId{1}); err != fault.LightningPayeeIsNotThisNode {
+		t.Fatalf("unexpected error: %v  expected: %s", err, fault.LightningPayeeIsNotThisNode)
+	}
+	if len(lnd.invoices) != 0 {
+		t.Errorf("unexpected invoices: %d", len(lnd.invoices))
+	}
+}
+
+func TestLightningWithoutAddress(t *testing.T) {
+	lnd := newFakeLnd(t)
+	defer lnd.Close()
+
+	_, err := newLightningWatcher(&lightningConfiguration{URL: lnd.server.URL})
+	if err != fault.MissingLightningAddress {
+		t.Fatalf("unexpected error: %v  expected: %s", err, fault.MissingLightningAddress)
+	}
+}
+
+func TestLightningMacaroonRejected(t *testing.T) {
+	lnd := newFakeLnd(t)
+	defer lnd.Close()
+
+	w := newTestLightningWatcher(t, lnd)
+	w.client.macaroon = "bad"
+	w.payments = func(id pay.PayId) ([]transactionrecord.PaymentAlternative, error) {
+		return []transactionrecord.PaymentAlternative{
+			{
+				{Currency: currency.Bitcoin, Address: testLightningAddress, Amount: 1000},
+			},
+		}, nil
+	}
+
+	if _, err := w.invoice(pay.PayId{1}); err != fault.LightningRequestFailed {
+		t.Fatalf("unexpected error: %v  expected: %s", err, fault.LightningRequestFailed)
+	}
+	if len(w.invoices) != 0 {
+		t.Errorf("unexpected open invoices: %d", len(w.invoices))
+	}
+}
+
+func TestLightningNotEnabled(t *testing.T) {
+	if _, err := LightningInvoice(pay.PayId{1}); err != fault.LightningNotEnabled {
+		t.Fatalf("unexpected error: %v  expected: %s", err, fault.LightningNotEnabled)
+	}
+}
@@ -77,10 +77,10 @@ type litecoinHandler struct {
 	state *litecoinState
 }
 
-func newLitecoinHandler(conf *currencyConfiguration) (*litecoinHandler, error) {
+func newLitecoinHandler(conf *currencyConfiguration, confirmations int) (*litecoinHandler, error) {
 	log := logger.New("litecoin")
 
-	state, err := newLitecoinState(conf.URL)
+	state, err := newLitecoinState(conf.URL, confirmations)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +126,7 @@ func (h *litecoinHandler) checkLatestBlock(wg *sync.WaitGroup) {
 
 	h.log.Infof("block number: %d confirmations: %d", headers[0].Height, headers[0].Confirmations)
 
-	if h.state.forward && headers[0].Confirmations <= requiredConfirmations {
+	if h.state.forward && headers[0].Confirmations < h.state.confirmations {
 		return
 	}
 
@@ -145,9 +145,12 @@ type litecoinState struct {
 
 	// scanning direction
 	forward bool
+
+	// blocks, including the paying block, before a payment is accepted
+	confirmations uint64
 }
 
-func newLitecoinState(url string) (*litecoinState, error) {
+func newLitecoinState(url string, confirmations int) (*litecoinState, error) {
 	client := &http.Client{}
 
 	var chain litecoinChainInfo
@@ -165,6 +168,7 @@ func newLitecoinState(url string) (*litecoinState, error) {
 		latestBlockNumber: chain.Blocks,
 		latestBlockHash:   chain.Hash,
 		forward:           false,
+		confirmations:     uint64(confirmations),
 	}, nil
 }
 
@@ -185,7 +189,7 @@ process_blocks:
 		log.Infof("height: %d hash: %q number of txs: %d", block.Height, block.Hash, len(block.Tx))
 		log.Tracef("block: %#v", block)
 
-		if block.Confirmations <= requiredConfirmations {
+		if block.Confirmations < state.confirmations {
 			if !state.forward {
 				hash = block.PreviousBlockHash
 				state.latestBlockHash = hash
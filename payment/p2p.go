@@ -43,6 +43,7 @@ type p2pWatcher struct {
 	connectedPeers *PeerMap
 	currency       currency.Currency
 
+	bootstrapLock  sync.Mutex
 	bootstrapNodes []string
 	addrManager    *addrmgr.AddrManager
 	connManager    *connmgr.ConnManager
@@ -58,9 +59,31 @@ type p2pWatcher struct {
 	onHeadersErr chan error
 	stopping     bool
 	shutdown     chan struct{}
+
+	// payments wait for this many blocks, including their own, before
+	// being passed to the reservoir
+	confirmations int32
+	paymentLock   sync.Mutex
+	awaiting      map[chainhash.Hash]*awaitingBlock // block hash → payments lacking confirmations
+	paidBlocks    map[chainhash.Hash]int32          // block hash → height, for blocks whose payments were passed on
+
+	// destination of payments
+	verify   func(pay.PayId, *reservoir.PaymentDetail)
+	unverify func(currency.Currency, string) int
+}
+
+// payments found in a block that is not yet deep enough
+type awaitingBlock struct {
+	height   int32
+	payments []awaitingPayment
 }
 
-func newP2pWatcher(c currency.Currency, peerDirectory string, bootstrapNodes []string) (*p2pWatcher, error) {
+type awaitingPayment struct {
+	payId  pay.PayId
+	detail *reservoir.PaymentDetail
+}
+
+func newP2pWatcher(c currency.Currency, peerDirectory string, bootstrapNodes []string, confirmations int) (*p2pWatcher, error) {
 	var attemptLock sync.Mutex
 	log := logger.New(c.String() + "_watcher")
 	var paymentStore storage.P2PStorage
@@ -98,6 +121,11 @@ func newP2pWatcher(c currency.Currency, peerDirectory string, bootstrapNodes []s
 		log:            log,
 		onHeadersErr:   make(chan error),
 		shutdown:       make(chan struct{}),
+		confirmations:  int32(confirmations),
+		awaiting:       make(map[chainhash.Hash]*awaitingBlock),
+		paidBlocks:     make(map[chainhash.Hash]int32),
+		verify:         reservoir.SetTransferVerified,
+		unverify:       reservoir.UnverifyPaymentBlock,
 	}
 
 	if l := len(networkParams.Checkpoints); l > 0 {
@@ -355,9 +383,13 @@ outer:
 		}
 	}
 
+	w.bootstrapLock.Lock()
+	bootstrapNodes := w.bootstrapNodes
+	w.bootstrapLock.Unlock()
+
 	nodeCount := 0
 bootstrap_loop:
-	for _, hostPort := range w.bootstrapNodes {
+	for _, hostPort := range bootstrapNodes {
 		conn, err := net.Dial("tcp", hostPort)
 		if err != nil {
 			w.log.Warnf("Can not establish connection to nodes. Error: %s", err)
@@ -371,7 +403,7 @@ bootstrap_loop:
 		}
 	}
 
-	if len(w.bootstrapNodes) > 0 && nodeCount == 0 {
+	if len(bootstrapNodes) > 0 && nodeCount == 0 {
 		logger.Panicf("unable to connect to any %s nodes", w.currency)
 	}
 
@@ -536,6 +568,12 @@ loop:
 			firstNewHeight = newHeight
 		}
 
+		// a different block at this height means the chain was reorganised
+		if oldHash, err := w.storage.GetHash(newHeight); err == nil && !oldHash.IsEqual(&newHash) {
+			w.log.Warnf("reorganised at height: %d  old hash: %s  new hash: %s", newHeight, oldHash, newHash)
+			w.revertPayments(newHeight)
+		}
+
 		w.log.Debugf("Add block hash: %s, %d", newHash, newHeight)
 		if err := w.storage.StoreBlock(newHeight, &newHash); err != nil {
 			headersErr = err
@@ -560,6 +598,8 @@ loop:
 	}
 	w.lastHash = &newHash
 	w.lastHeight = newHeight
+
+	w.releasePayments()
 }
 
 func (w *p2pWatcher) rollbackBlock() error {
@@ -570,6 +610,9 @@ func (w *p2pWatcher) rollbackBlock() error {
 		deleteDownTo = w.checkpoint.Height
 	}
 
+	// blocks above the rollback may not be on the chain that is synced next
+	w.revertPayments(deleteDownTo + 1)
+
 	w.log.Infof("Start rolling back blocks to: %d", deleteDownTo)
 	if err := w.storage.RollbackTo(w.lastHeight, deleteDownTo); err != nil {
 		return err
@@ -586,6 +629,12 @@ func (w *p2pWatcher) rollbackBlock() error {
 }
 
 func (w *p2pWatcher) examineTransaction(tx *wire.MsgTx) ([]byte, map[string]uint64) {
+	return examineTransaction(tx, w.networkParams)
+}
+
+// examineTransaction extracts a potential pay id and the amounts paid
+// to each address from a currency transaction
+func examineTransaction(tx *wire.MsgTx, networkParams *chaincfg.Params) ([]byte, map[string]uint64) {
 	var id []byte
 	amounts := map[string]uint64{}
 
@@ -601,7 +650,7 @@ loop:
 				continue loop
 			}
 
-			addr, err := s.Address(w.networkParams)
+			addr, err := s.Address(networkParams)
 			if err != nil {
 				continue loop
 			}
@@ -637,6 +686,7 @@ func (w *p2pWatcher) onPeerBlock(p *peer.Peer, msg *wire.MsgBlock, buf []byte) e
 		return fault.BlockAlreadyProcessed
 	}
 
+	payments := make([]awaitingPayment, 0, 10)
 	for _, tx := range msg.Transactions {
 		id, amounts := w.examineTransaction(tx)
 		if id != nil {
@@ -646,21 +696,94 @@ func (w *p2pWatcher) onPeerBlock(p *peer.Peer, msg *wire.MsgBlock, buf []byte) e
 
 			w.log.Debugf("Find a potential payment. payId: %s, txId: %s", payId.String(), txId)
 
-			reservoir.SetTransferVerified(
-				payId,
-				&reservoir.PaymentDetail{
-					Currency: w.currency,
-					TxID:     txId,
-					Amounts:  amounts,
+			payments = append(payments, awaitingPayment{
+				payId: payId,
+				detail: &reservoir.PaymentDetail{
+					Currency:  w.currency,
+					TxID:      txId,
+					Amounts:   amounts,
+					BlockHash: blockHash,
 				},
-			)
+			})
+		}
+	}
+
+	if len(payments) > 0 {
+		w.paymentLock.Lock()
+		w.awaiting[hash] = &awaitingBlock{
+			height:   blockHeight,
+			payments: payments,
 		}
+		w.paymentLock.Unlock()
 	}
 
 	w.blockCache.Set(blockHash, true, 0)
+
+	w.releasePayments()
 	return nil
 }
 
+// releasePayments passes the payments of every block that has enough
+// confirmations to the reservoir, dropping those of blocks no longer
+// on the chain
+func (w *p2pWatcher) releasePayments() {
+	w.paymentLock.Lock()
+	defer w.paymentLock.Unlock()
+
+	for hash, block := range w.awaiting {
+		if w.lastHeight-block.height+1 < w.confirmations {
+			continue
+		}
+		delete(w.awaiting, hash)
+
+		current, err := w.storage.GetHash(block.height)
+		if err != nil || !current.IsEqual(&hash) {
+			w.log.Warnf("drop payments of block: %s  no longer at height: %d", hash, block.height)
+			continue
+		}
+
+		w.log.Infof("release payments of block: %s  height: %d", hash, block.height)
+		for _, payment := range block.payments {
+			w.verify(payment.payId, payment.detail)
+		}
+		w.paidBlocks[hash] = block.height
+	}
+
+	// blocks this far back can no longer be rolled back
+	for hash, height := range w.paidBlocks {
+		if height < w.lastHeight-checkpointBackLimit {
+			delete(w.paidBlocks, hash)
+		}
+	}
+}
+
+// revertPayments returns the reservoir items paid in blocks from a
+// height upwards to pending, so they must be paid again on the new chain
+func (w *p2pWatcher) revertPayments(fromHeight int32) {
+	w.paymentLock.Lock()
+	defer w.paymentLock.Unlock()
+
+	for hash, height := range w.paidBlocks {
+		if height < fromHeight {
+			continue
+		}
+		delete(w.paidBlocks, hash)
+
+		// allow the block to be processed again if it is still valid
+		w.blockCache.Delete(hash.String())
+
+		n := w.unverify(w.currency, hash.String())
+		w.log.Warnf("reverted: %d payments of block: %s  height: %d", n, hash, height)
+	}
+
+	for hash, block := range w.awaiting {
+		if block.height >= fromHeight {
+			delete(w.awaiting, hash)
+			w.blockCache.Delete(hash.String())
+		}
+	}
+}
+
 // peerConfig returns a payment template. The `ChainParams` will vary between
 // different network settings in `p2pWatcher`.
 func (w *p2pWatcher) peerConfig() *peer.Config {
@@ -701,6 +824,35 @@ func (w *p2pWatcher) peerConfig() *peer.Config {
 	}
 }
 
+// replace the bootstrap nodes and connect to those that are new
+func (w *p2pWatcher) setBootstrapNodes(nodes []string) {
+	w.bootstrapLock.Lock()
+	previous := make(map[string]struct{}, len(w.bootstrapNodes))
+	for _, hostPort := range w.bootstrapNodes {
+		previous[hostPort] = struct{}{}
+	}
+	w.bootstrapNodes = append([]string{}, nodes...)
+	w.bootstrapLock.Unlock()
+
+	for _, hostPort := range nodes {
+		if _, ok := previous[hostPort]; ok {
+			continue
+		}
+		w.log.Infof("new bootstrap node: %s", hostPort)
+
+		go func(hostPort string) {
+			conn, err := net.Dial("tcp", hostPort)
+			if err != nil {
+				w.log.Warnf("Can not establish connection to nodes. Error: %s", err)
+				return
+			}
+			if _, err := w.peerNeogotiate(conn); err != nil {
+				w.log.Warnf("Can not establish connection to nodes. Error: %s", err)
+			}
+		}(hostPort)
+	}
+}
+
 // peerNeogotiate will neogotiate with the remote peer to complete the connection
 func (w *p2pWatcher) peerNeogotiate(conn net.Conn) (*peer.Peer, error) {
 	ipAddr := conn.RemoteAddr().String()
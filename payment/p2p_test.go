@@ -18,6 +18,8 @@ import (
 	"github.com/bitmark-inc/bitmarkd/chain"
 	"github.com/bitmark-inc/bitmarkd/currency"
 	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
 )
 
 func NewDummyMsgBlock(previousBlock *chainhash.Hash, timestamp *time.Time) *wire.MsgBlock {
@@ -39,7 +41,7 @@ func NewDummyMsgBlock(previousBlock *chainhash.Hash, timestamp *time.Time) *wire
 func TestOnPeerBlockEarlyBlocks(t *testing.T) {
 	testCurrency := currency.Bitcoin
 
-	w, err := newP2pWatcher(testCurrency, ".", []string{})
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 1)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -60,7 +62,7 @@ func TestOnPeerBlockEarlyBlocks(t *testing.T) {
 func TestOnPeerBlockHeaderNotFound(t *testing.T) {
 	testCurrency := currency.Bitcoin
 
-	w, err := newP2pWatcher(testCurrency, ".", []string{})
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 1)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -83,7 +85,7 @@ func TestOnPeerBlockHeaderNotFound(t *testing.T) {
 func TestOnPeerBlockProcessed(t *testing.T) {
 	testCurrency := currency.Bitcoin
 
-	w, err := newP2pWatcher(testCurrency, ".", []string{})
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 1)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -145,7 +147,7 @@ func TestExamineTx(t *testing.T) {
 
 	testCurrency := currency.Litecoin
 
-	w, err := newP2pWatcher(testCurrency, ".", []string{})
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 1)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -188,7 +190,7 @@ func TestExamineTxWithoutPayment(t *testing.T) {
 
 	testCurrency := currency.Litecoin
 
-	w, err := newP2pWatcher(testCurrency, ".", []string{})
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 1)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -203,7 +205,7 @@ func TestExamineTxWithoutPayment(t *testing.T) {
 func TestOnPeerNoHeaders(t *testing.T) {
 	testCurrency := currency.Litecoin
 
-	w, err := newP2pWatcher(testCurrency, ".", []string{})
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 1)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -228,7 +230,7 @@ func TestOnPeerNoHeaders(t *testing.T) {
 func TestOnPeerAllOldHeaders(t *testing.T) {
 	testCurrency := currency.Litecoin
 
-	w, err := newP2pWatcher(testCurrency, ".", []string{})
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 1)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -269,7 +271,7 @@ func TestOnPeerAllOldHeaders(t *testing.T) {
 func TestOnPeerInvalidPrevious(t *testing.T) {
 	testCurrency := currency.Litecoin
 
-	w, err := newP2pWatcher(testCurrency, ".", []string{})
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 1)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -309,7 +311,7 @@ func TestOnPeerInvalidPrevious(t *testing.T) {
 func TestRollbackToHeight(t *testing.T) {
 	testCurrency := currency.Litecoin
 
-	w, err := newP2pWatcher(testCurrency, ".", []string{})
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 1)
 	if err != nil {
 		t.Fatalf("unexpected error: %s", err)
 	}
@@ -345,3 +347,76 @@ func TestRollbackToHeight(t *testing.T) {
 		t.Fatalf("unexpected last hash. expected: %d, actual: %d", &fakeHash1, w.lastHash)
 	}
 }
+
+func TestReleaseAndRevertPayments(t *testing.T) {
+	testCurrency := currency.Bitcoin
+
+	w, err := newP2pWatcher(testCurrency, ".", []string{}, 2)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	verified := make([]pay.PayId, 0, 2)
+	w.verify = func(payId pay.PayId, detail *reservoir.PaymentDetail) {
+		verified = append(verified, payId)
+	}
+	reverted := make([]string, 0, 2)
+	w.unverify = func(c currency.Currency, blockHash string) int {
+		if c != testCurrency {
+			t.Errorf("unexpected currency: %s", c)
+		}
+		reverted = append(reverted, blockHash)
+		return 1
+	}
+
+	height := int32(88880000)
+	paidHeader := wire.NewBlockHeader(88880000, &chainhash.Hash{}, &chainhash.Hash{}, 1, 1)
+	paidHash := paidHeader.BlockHash()
+	staleHeader := wire.NewBlockHeader(88880001, &chainhash.Hash{}, &chainhash.Hash{}, 1, 1)
+	staleHash := staleHeader.BlockHash()
+
+	if err := w.storage.StoreBlock(height, &paidHash); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	payId := pay.PayId{1, 2, 3}
+	w.awaiting[paidHash] = &awaitingBlock{
+		height:   height,
+		payments: []awaitingPayment{{payId: payId, detail: &reservoir.PaymentDetail{BlockHash: paidHash.String()}}},
+	}
+	w.awaiting[staleHash] = &awaitingBlock{
+		height:   height,
+		payments: []awaitingPayment{{payId: pay.PayId{4, 5, 6}, detail: &reservoir.PaymentDetail{BlockHash: staleHash.String()}}},
+	}
+
+	// only one confirmation
+	w.lastHeight = height
+	w.releasePayments()
+	if len(verified) != 0 {
+		t.Fatalf("unexpected verified payments: %v", verified)
+	}
+
+	// second confirmation, the stale block is no longer at its height
+	w.lastHeight = height + 1
+	w.releasePayments()
+	if !reflect.DeepEqual(verified, []pay.PayId{payId}) {
+		t.Fatalf("unexpected verified payments: %v", verified)
+	}
+	if len(w.awaiting) != 0 {
+		t.Fatalf("unexpected awaiting blocks: %d", len(w.awaiting))
+	}
+
+	// blocks below the reorganisation are kept
+	w.revertPayments(height + 1)
+	if len(reverted) != 0 {
+		t.Fatalf("unexpected reverted blocks: %v", reverted)
+	}
+
+	w.revertPayments(height)
+	if !reflect.DeepEqual(reverted, []string{paidHash.String()}) {
+		t.Fatalf("unexpected reverted blocks: %v", reverted)
+	}
+	if _, ok := w.paidBlocks[paidHash]; ok {
+		t.Fatal("reverted block is still paid")
+	}
+}
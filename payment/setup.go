@@ -21,8 +21,8 @@ const (
 	MaximumNonceLength = 64
 )
 const (
-	requiredConfirmations = 1
-	maximumBlockRate      = 500.0 // blocks per second
+	defaultConfirmations = 1     // the paying block alone suffices
+	maximumBlockRate     = 500.0 // blocks per second
 )
 
 type P2PCache struct {
@@ -38,6 +38,31 @@ type Configuration struct {
 	BootstrapNodes bootstrapNodesConfiguration `gluamapper:"bootstrap_nodes" json:"bootstrap_nodes"`
 	Bitcoin        *currencyConfiguration      `gluamapper:"bitcoin" json:"bitcoin"`
 	Litecoin       *currencyConfiguration      `gluamapper:"litecoin" json:"litecoin"`
+	Electrum       electrumConfiguration       `gluamapper:"electrum" json:"electrum"`
+	Confirmations  confirmationsConfiguration  `gluamapper:"confirmations" json:"confirmations"`
+	Lightning      *lightningConfiguration     `gluamapper:"lightning" json:"lightning"`
+}
+
+// number of blocks, including the paying block, before a payment is
+// accepted by the rest, p2p and electrum modes
+type confirmationsConfiguration struct {
+	Bitcoin  int `gluamapper:"bitcoin" json:"bitcoin"`
+	Litecoin int `gluamapper:"litecoin" json:"litecoin"`
+}
+
+// confirmation depth for a currency, unset values use the default
+func (conf confirmationsConfiguration) depth(c currency.Currency) int {
+	n := 0
+	switch c {
+	case currency.Bitcoin:
+		n = conf.Bitcoin
+	case currency.Litecoin:
+		n = conf.Litecoin
+	}
+	if n <= 0 {
+		return defaultConfirmations
+	}
+	return n
 }
 
 type bootstrapNodesConfiguration struct {
@@ -54,6 +79,8 @@ type globalDataType struct {
 
 	log        *logger.L
 	handlers   map[string]currencyHandler
+	lightning  *lightningWatcher
+	p2p        map[currency.Currency]*p2pWatcher
 	background *background.T
 
 	// set once during initialise
@@ -82,14 +109,14 @@ func Initialise(configuration *Configuration) error {
 
 	// initialise the handler for each currency
 	globalData.handlers = make(map[string]currencyHandler)
-	if configuration.Mode != "p2p" {
+	if configuration.Mode == "rest" {
 		for c := currency.First; c <= currency.Last; c++ {
 			switch c {
 			case currency.Bitcoin:
 				if configuration.Bitcoin == nil {
 					return fault.MissingPaymentBitcoinSection
 				}
-				handler, err := newBitcoinHandler(configuration.Bitcoin)
+				handler, err := newBitcoinHandler(configuration.Bitcoin, configuration.Confirmations.depth(currency.Bitcoin))
 				if err != nil {
 					return err
 				}
@@ -98,7 +125,7 @@ func Initialise(configuration *Configuration) error {
 				if configuration.Litecoin == nil {
 					return fault.MissingPaymentLitecoinSection
 				}
-				handler, err := newLitecoinHandler(configuration.Litecoin)
+				handler, err := newLitecoinHandler(configuration.Litecoin, configuration.Confirmations.depth(currency.Litecoin))
 				if err != nil {
 					return err
 				}
@@ -120,24 +147,65 @@ func Initialise(configuration *Configuration) error {
 
 		btcP2pWatcher, err := newP2pWatcher(currency.Bitcoin,
 			configuration.P2PCache.BtcDirectory,
-			configuration.BootstrapNodes.Bitcoin)
+			configuration.BootstrapNodes.Bitcoin,
+			configuration.Confirmations.depth(currency.Bitcoin))
 		if err != nil {
 			return err
 		}
 		ltcP2pWatcher, err := newP2pWatcher(currency.Litecoin,
 			configuration.P2PCache.LtcDirectory,
-			configuration.BootstrapNodes.Litecoin)
+			configuration.BootstrapNodes.Litecoin,
+			configuration.Confirmations.depth(currency.Litecoin))
 		if err != nil {
 			return err
 		}
 		processes = append(processes, btcP2pWatcher, ltcP2pWatcher)
+		globalData.p2p = map[currency.Currency]*p2pWatcher{
+			currency.Bitcoin:  btcP2pWatcher,
+			currency.Litecoin: ltcP2pWatcher,
+		}
 	case "rest":
 		globalData.log.Info("checker…")
 		processes = append(processes, &checker{})
+	case "electrum":
+		globalData.log.Info("electrum watcher…")
+
+		btcElectrumWatcher, err := newElectrumWatcher(currency.Bitcoin,
+			configuration.Electrum.Bitcoin,
+			configuration.Confirmations.depth(currency.Bitcoin))
+		if err != nil {
+			return err
+		}
+		processes = append(processes, btcElectrumWatcher)
+
+		// litecoin payments are optional in this mode
+		if configuration.Electrum.Litecoin != nil {
+			ltcElectrumWatcher, err := newElectrumWatcher(currency.Litecoin,
+				configuration.Electrum.Litecoin,
+				configuration.Confirmations.depth(currency.Litecoin))
+			if err != nil {
+				return err
+			}
+			processes = append(processes, ltcElectrumWatcher)
+		} else {
+			globalData.log.Warn("no litecoin electrum server: litecoin payments will not be verified")
+		}
 	default:
 		logger.Panicf("unsupported payment verification mode: %s", configuration.Mode)
 	}
 
+	// lightning invoices supplement any of the above modes
+	if configuration.Lightning != nil && configuration.Lightning.URL != "" {
+		globalData.log.Info("lightning watcher…")
+
+		lightningWatcher, err := newLightningWatcher(configuration.Lightning)
+		if err != nil {
+			return err
+		}
+		globalData.lightning = lightningWatcher
+		processes = append(processes, lightningWatcher)
+	}
+
 	// all data initialised
 	globalData.initialised = true
 
@@ -147,6 +215,30 @@ func Initialise(configuration *Configuration) error {
 	return nil
 }
 
+// SetBootstrapNodes - change the bootstrap nodes of the p2p watchers
+//
+// any new node is connected at once, a removed node is not
+// disconnected but is no longer used.  No effect in other modes.
+func SetBootstrapNodes(configuration *Configuration) error {
+	globalData.RLock()
+	defer globalData.RUnlock()
+
+	if !globalData.initialised {
+		return fault.NotInitialised
+	}
+
+	for c, w := range globalData.p2p {
+		switch c {
+		case currency.Bitcoin:
+			w.setBootstrapNodes(configuration.BootstrapNodes.Bitcoin)
+		case currency.Litecoin:
+			w.setBootstrapNodes(configuration.BootstrapNodes.Litecoin)
+		}
+	}
+
+	return nil
+}
+
 // Finalise - stop all background tasks
 func Finalise() error {
 	if !globalData.initialised {
@@ -162,6 +254,8 @@ func Finalise() error {
 	}
 
 	// finally...
+	globalData.lightning = nil
+	globalData.p2p = nil
 	globalData.initialised = false
 
 	globalData.log.Info("finished")
@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"bytes"
+
+	"github.com/bitmark-inc/bitmarkd/zmqutil"
+)
+
+// allowList - the public keys of the peers of a private network
+//
+// a nil list allows any peer
+type allowList map[string]struct{}
+
+// create the allow list for a private network from the static
+// connections and the extra public keys that may only connect in,
+// this node's own key is never included
+func newAllowList(publicKey []byte, connect []Connection, allow []string) (allowList, error) {
+	a := make(allowList)
+
+	for _, c := range connect {
+		key, err := zmqutil.ReadPublicKey(c.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		a.add(publicKey, key)
+	}
+
+	for _, s := range allow {
+		key, err := zmqutil.ReadPublicKey(s)
+		if err != nil {
+			return nil, err
+		}
+		a.add(publicKey, key)
+	}
+
+	return a, nil
+}
+
+func (a allowList) add(publicKey []byte, key []byte) {
+	if !bytes.Equal(publicKey, key) {
+		a[string(key)] = struct{}{}
+	}
+}
+
+// IsAllowed - check if a peer may connect to or from this node
+func (a allowList) IsAllowed(publicKey []byte) bool {
+	if a == nil {
+		return true
+	}
+	_, ok := a[string(publicKey)]
+	return ok
+}
+
+// IsPrivate - true if only the listed peers are allowed
+func (a allowList) IsPrivate() bool {
+	return a != nil
+}
+
+// Keys - the allowed public keys
+func (a allowList) Keys() [][]byte {
+	keys := make([][]byte, 0, len(a))
+	for k := range a {
+		keys = append(keys, []byte(k))
+	}
+	return keys
+}
+
+// the number of connected clients needed before syncing, a private
+// network may have fewer peers than a public one and a configured
+// minimum overrides both
+func (a allowList) requiredClients(minimum int) int {
+	if minimum > 0 {
+		return minimum
+	}
+	if a != nil && len(a) < minimumClients {
+		if len(a) == 0 {
+			return 1
+		}
+		return len(a)
+	}
+	return minimumClients
+}
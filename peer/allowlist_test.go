@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// a tagged public key filled with one byte value
+func testPublicKey(b byte) ([]byte, string) {
+	key := bytes.Repeat([]byte{b}, 32)
+	return key, "PUBLIC:" + hex.EncodeToString(key)
+}
+
+func TestAllowList(t *testing.T) {
+	self, selfString := testPublicKey(1)
+	static, staticString := testPublicKey(2)
+	inbound, inboundString := testPublicKey(3)
+	other, _ := testPublicKey(4)
+
+	connect := []Connection{
+		{PublicKey: staticString, Address: "127.0.0.1:2136"},
+	}
+	a, err := newAllowList(self, connect, []string{inboundString, selfString})
+	assert.Nil(t, err, "wrong allow list")
+
+	assert.True(t, a.IsPrivate(), "not private")
+	assert.True(t, a.IsAllowed(static), "static peer not allowed")
+	assert.True(t, a.IsAllowed(inbound), "allowed peer not allowed")
+	assert.False(t, a.IsAllowed(other), "unknown peer allowed")
+	assert.False(t, a.IsAllowed(self), "self allowed")
+	assert.Equal(t, 2, len(a.Keys()), "wrong key count")
+	assert.Equal(t, 2, a.requiredClients(0), "wrong required clients")
+	assert.Equal(t, 1, a.requiredClients(1), "wrong configured required clients")
+}
+
+func TestAllowListWhenInvalidKey(t *testing.T) {
+	self, _ := testPublicKey(1)
+
+	_, err := newAllowList(self, nil, []string{"PRIVATE:0102"})
+	assert.NotNil(t, err, "private key accepted")
+
+	_, err = newAllowList(self, []Connection{{PublicKey: "invalid"}}, nil)
+	assert.NotNil(t, err, "invalid key accepted")
+}
+
+func TestAllowListWhenPublic(t *testing.T) {
+	var a allowList
+	other, _ := testPublicKey(4)
+
+	assert.False(t, a.IsPrivate(), "public network is private")
+	assert.True(t, a.IsAllowed(other), "peer not allowed")
+	assert.Equal(t, minimumClients, a.requiredClients(0), "wrong required clients")
+	assert.Equal(t, 3, a.requiredClients(3), "wrong configured required clients")
+}
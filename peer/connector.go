@@ -8,24 +8,31 @@ package peer
 import (
 	"bytes"
 	"container/list"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/rand"
 	"strings"
 	"sync"
+	"syscall"
 	"time"
 
 	"github.com/bitmark-inc/bitmarkd/block"
 	"github.com/bitmark-inc/bitmarkd/blockheader"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/genesis"
+	"github.com/bitmark-inc/bitmarkd/light"
 	"github.com/bitmark-inc/bitmarkd/messagebus"
 	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/peer/forks"
+	"github.com/bitmark-inc/bitmarkd/peer/reputation"
 	"github.com/bitmark-inc/bitmarkd/peer/upstream"
 	"github.com/bitmark-inc/bitmarkd/peer/voting"
 	"github.com/bitmark-inc/bitmarkd/util"
 	"github.com/bitmark-inc/bitmarkd/zmqutil"
 	"github.com/bitmark-inc/logger"
+	zmq "github.com/pebbe/zmq4"
 )
 
 // various timeouts
@@ -42,9 +49,6 @@ const (
 	// number of blocks to fetch in one set
 	fetchBlocksPerCycle = 200
 
-	// fail to fork if height difference is greater than this
-	forkProtection = 60
-
 	// do not proceed unless this many clients are connected
 	minimumClients = 5
 
@@ -66,7 +70,13 @@ type connector struct {
 	log        *logger.L
 	preferIPv6 bool
 
-	staticClients []upstream.Upstream
+	privateKey     []byte
+	publicKey      []byte
+	dynamicEnabled bool
+
+	staticClients     []upstream.Upstream
+	staticConnections []Connection      // configuration of each static client
+	static            chan []Connection // replacement static connections
 
 	dynamicClients list.List
 
@@ -81,6 +91,13 @@ type connector struct {
 	fastSyncEnabled bool   // fast sync mode enabled?
 	blocksPerCycle  int    // number of blocks to fetch per cycle
 	pivotPoint      uint64 // block number to stop fast syncing
+
+	headers *blockrecord.HeaderChain // validated headers of blocks to fetch
+
+	allowed         allowList // peers of a private network
+	requiredClients int       // connected clients needed to sync
+
+	forks forks.Monitor // competing chains and reorganisations
 }
 
 // initialise the connector
@@ -91,6 +108,9 @@ func (conn *connector) initialise(
 	dynamicEnabled bool,
 	preferIPv6 bool,
 	fastSync bool,
+	allowed allowList,
+	maximumReorgDepth uint64,
+	minimumConnections int,
 ) error {
 
 	log := logger.New("connector")
@@ -98,7 +118,19 @@ func (conn *connector) initialise(
 
 	conn.preferIPv6 = preferIPv6
 
-	conn.fastSyncEnabled = fastSync
+	conn.privateKey = privateKey
+	conn.publicKey = publicKey
+	conn.dynamicEnabled = dynamicEnabled
+	conn.staticConnections = append([]Connection{}, connect...)
+	conn.static = make(chan []Connection, 1)
+
+	// a light node checks the digest of every header it stores
+	conn.fastSyncEnabled = fastSync && !light.IsEnabled()
+
+	conn.allowed = allowed
+	conn.requiredClients = allowed.requiredClients(minimumConnections)
+
+	conn.forks = forks.New(log, maximumReorgDepth)
 
 	log.Info("initialising…")
 
@@ -213,7 +245,7 @@ func (conn *connector) initialise(
 		globalData.connectorClients = append(globalData.connectorClients, client)
 	}
 
-	conn.votes = voting.NewVoting()
+	conn.votes = voting.NewVotingWithMinimum(conn.requiredClients)
 
 	// start state machine
 	conn.nextState(cStateConnecting)
@@ -302,6 +334,8 @@ loop:
 		case <-timer: // timer has priority over queue
 			timer = time.After(cycleInterval)
 			conn.process()
+		case connect := <-conn.static:
+			conn.setStatic(connect)
 		case item := <-queue:
 			c, _ := util.PackedConnection(item.Parameters[1]).Unpack()
 			conn.log.Debugf(
@@ -361,10 +395,10 @@ func (conn *connector) runStateMachine() bool {
 		globalData.clientCount = conn.getConnectedClientCount()
 		log.Infof("connections: %d", globalData.clientCount)
 
-		if isConnectionEnough(globalData.clientCount) {
+		if conn.isConnectionEnough(globalData.clientCount) {
 			conn.nextState(cStateHighestBlock)
 		} else {
-			log.Warnf("connections: %d below minimum client count: %d", globalData.clientCount, minimumClients)
+			log.Warnf("connections: %d below minimum client count: %d", globalData.clientCount, conn.requiredClients)
 			messagebus.Bus.Announce.Send("reconnect")
 		}
 		continueLooping = false
@@ -406,13 +440,19 @@ func (conn *connector) runStateMachine() bool {
 
 			// first block number
 			conn.startBlockNumber = genesis.BlockNumber + 1
-			conn.nextState(cStateFetchBlocks) // assume success
+			conn.resetHeaders()
+			conn.nextState(cStateFetchHeaders) // assume success
 			log.Infof("local block number: %d", height)
 
 			blockheader.ClearCache()
 			// check digests of descending blocks (to detect a fork)
 		check_digests:
 			for h := height; h >= genesis.BlockNumber; h -= 1 {
+				// too deep to follow, the fork point is not searched for
+				if height-h > conn.forks.MaximumDepth() {
+					conn.reorganise(height, h+1)
+					break check_digests
+				}
 				digest, err := blockheader.DigestForBlock(h)
 				if err != nil {
 					log.Infof("block number: %d  local digest error: %s", h, err)
@@ -422,30 +462,34 @@ func (conn *connector) runStateMachine() bool {
 				d, err := conn.theClient.RemoteDigestOfHeight(h)
 				if err != nil {
 					log.Infof("block number: %d  fetch digest error: %s", h, err)
+					conn.penaliseFailure(conn.theClient, err)
 					conn.nextState(cStateHighestBlock) // retry
 					break check_digests
 				} else if d == digest {
-					if height-h >= forkProtection {
-						log.Errorf("fork protection at: %d - %d >= %d", height, h, forkProtection)
-						conn.nextState(cStateHighestBlock)
-						break check_digests
-					}
-
-					conn.startBlockNumber = h + 1
-					log.Infof("fork from block number: %d", conn.startBlockNumber)
-
-					// remove old blocks
-					err := block.DeleteDownToBlock(conn.startBlockNumber)
-					if err != nil {
-						log.Errorf("delete down to block number: %d  error: %s", conn.startBlockNumber, err)
-						conn.nextState(cStateHighestBlock) // retry
-					}
+					conn.reorganise(height, h+1)
 					break check_digests
 				}
 			}
 		}
 
+	case cStateHalted:
+		// wait for a new maximum_reorg_depth to be loaded
+		if conn.forks.IsHalted() {
+			continueLooping = false
+			break
+		}
+		log.Info("synchronisation resumed")
+		conn.nextState(cStateConnecting)
+
+	case cStateFetchHeaders:
+		continueLooping = conn.fetchHeaders()
+
 	case cStateFetchBlocks:
+		if light.IsEnabled() {
+			continueLooping = conn.storeHeaders()
+			break
+		}
+
 		continueLooping = false
 		var packedBlock []byte
 		var packedNextBlock []byte
@@ -457,6 +501,14 @@ func (conn *connector) runStateMachine() bool {
 			conn.blocksPerCycle = fetchBlocksPerCycle
 		}
 
+		// spread a long catch-up over the upstreams on the voted chain
+		if conn.startBlockNumber+fetchBlocksPerCycle <= conn.height {
+			if clients := conn.downloadClients(); len(clients) >= downloadMinimumClients {
+				conn.fetchBlocksInParallel(clients)
+				break
+			}
+		}
+
 	fetch_blocks:
 		for i := 0; i < conn.blocksPerCycle; i++ {
 			if conn.startBlockNumber > conn.height {
@@ -476,6 +528,7 @@ func (conn *connector) runStateMachine() bool {
 				p, err := conn.theClient.GetBlockData(conn.startBlockNumber)
 				if err != nil {
 					log.Errorf("fetch block number: %d  error: %s", conn.startBlockNumber, err)
+					conn.penaliseFailure(conn.theClient, err)
 					conn.nextState(cStateHighestBlock) // retry
 					break fetch_blocks
 				}
@@ -498,12 +551,14 @@ func (conn *connector) runStateMachine() bool {
 					d, err := conn.theClient.RemoteDigestOfHeight(h)
 					if err != nil {
 						log.Infof("block number: %d  fetch digest error: %s", h, err)
+						conn.penaliseFailure(conn.theClient, err)
 						conn.nextState(cStateHighestBlock) // retry
 						break fetch_blocks
 					}
 
 					if d != digest {
 						log.Warnf("potetial block forgery: %d", h)
+						conn.penalise(conn.theClient, reputation.InvalidDigest)
 
 						// remove old blocks
 						startingPoint := conn.startBlockNumber - uint64(i)
@@ -530,6 +585,14 @@ func (conn *connector) runStateMachine() bool {
 				packedNextBlock = nil
 			}
 
+			if conn.headers != nil && !conn.headers.Matches(conn.startBlockNumber, packedBlock) {
+				log.Errorf("block number: %d  error: %s", conn.startBlockNumber, fault.BlockDoesNotMatchHeader)
+				conn.penalise(conn.theClient, reputation.InvalidBlock)
+				conn.resetHeaders()
+				conn.nextState(cStateHighestBlock) // retry
+				break fetch_blocks
+			}
+
 			log.Debugf("store block number: %d", conn.startBlockNumber)
 			err := block.StoreIncoming(packedBlock, packedNextBlock, block.NoRescanVerified)
 			if err != nil {
@@ -538,16 +601,25 @@ func (conn *connector) runStateMachine() bool {
 					conn.startBlockNumber,
 					err,
 				)
+				// a mismatched previous digest can be a fork race
+				if err != fault.PreviousBlockDigestDoesNotMatch {
+					conn.penalise(conn.theClient, reputation.InvalidBlock)
+				}
 				conn.nextState(cStateHighestBlock) // retry
 				break fetch_blocks
 			}
 
+			if conn.headers != nil {
+				conn.headers.Release(conn.startBlockNumber)
+			}
+
 			// next block
 			conn.startBlockNumber++
 		}
 
 	case cStateRebuild:
 		// return to normal operations
+		conn.resetHeaders()
 		conn.nextState(cStateSampling)
 		conn.samples = 0 // zero out the counter
 		mode.Set(mode.Normal)
@@ -556,8 +628,8 @@ func (conn *connector) runStateMachine() bool {
 	case cStateSampling:
 		// check peers
 		globalData.clientCount = conn.getConnectedClientCount()
-		if !isConnectionEnough(globalData.clientCount) {
-			log.Warnf("connections: %d below minimum client count: %d", globalData.clientCount, minimumClients)
+		if !conn.isConnectionEnough(globalData.clientCount) {
+			log.Warnf("connections: %d below minimum client count: %d", globalData.clientCount, conn.requiredClients)
 			continueLooping = true
 			conn.nextState(cStateConnecting)
 			return continueLooping
@@ -593,8 +665,74 @@ func (conn *connector) runStateMachine() bool {
 	return continueLooping
 }
 
-func isConnectionEnough(count int) bool {
-	return minimumClients <= count
+// replace the local blocks from start to follow the elected chain,
+// unless it is too deep
+func (conn *connector) reorganise(height uint64, start uint64) {
+	log := conn.log
+
+	r, err := conn.forks.Reorganise(conn.theClient.ServerPublicKey(), height, start)
+	if err != nil {
+		log.Criticalf("synchronisation halted: set maximum_reorg_depth to at least: %d and reload (SIGHUP) to resume", r.Depth)
+		mode.Set(mode.Resynchronise)
+		conn.resetHeaders()
+		conn.nextState(cStateHalted)
+		return
+	}
+
+	conn.startBlockNumber = start
+	log.Infof("fork from block number: %d", conn.startBlockNumber)
+
+	if r.Depth == 0 {
+		return
+	}
+
+	// remove old blocks
+	if light.IsEnabled() {
+		err = light.DeleteDownTo(conn.startBlockNumber)
+	} else {
+		err = block.DeleteDownToBlock(conn.startBlockNumber)
+	}
+	if err != nil {
+		log.Errorf("delete down to block number: %d  error: %s", conn.startBlockNumber, err)
+		conn.nextState(cStateHighestBlock) // retry
+		return
+	}
+
+	startBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(startBytes, r.Start)
+	depthBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(depthBytes, r.Depth)
+	messagebus.Bus.Broadcast.Send("reorg", startBytes, depthBytes, conn.theClient.ServerPublicKey())
+}
+
+// record the tips of the upstreams whose chain differs from the local
+// chain at the local height
+func (conn *connector) observeForks() {
+	localHeight := blockheader.Height()
+	localDigest, err := blockheader.DigestForBlock(localHeight)
+	if err != nil {
+		return
+	}
+
+	conn.allClients(func(client upstream.Upstream, e *list.Element) {
+		if !client.IsConnected() || client.LocalHeight() != localHeight {
+			return
+		}
+		digest := client.CachedRemoteDigestOfLocalHeight()
+		if digest.IsEmpty() || digest == localDigest {
+			return
+		}
+		height := client.CachedRemoteHeight()
+		tip, err := client.RemoteDigestOfHeight(height)
+		if err != nil {
+			return
+		}
+		conn.forks.Observe(client.ServerPublicKey(), height, tip)
+	})
+}
+
+func (conn *connector) isConnectionEnough(count int) bool {
+	return conn.requiredClients <= count
 }
 
 func (conn *connector) isSameChain() bool {
@@ -657,6 +795,7 @@ func (conn *connector) updateHeightAndClient() bool {
 	conn.votes.Reset()
 	conn.votes.SetMinHeight(blockheader.Height())
 	conn.startElection()
+	conn.observeForks()
 	elected, height := conn.elected()
 	if height == 0 {
 		conn.height = 0
@@ -687,7 +826,7 @@ func (conn *connector) updateHeightAndClient() bool {
 
 func (conn *connector) startElection() {
 	conn.allClients(func(client upstream.Upstream, e *list.Element) {
-		if client.IsConnected() && client.ActiveInThePast(activeTime) {
+		if client.IsConnected() && client.ActiveInThePast(activeTime) && !reputation.IsBanned(client.ServerPublicKey(), "") {
 			conn.votes.VoteBy(client)
 		}
 	})
@@ -718,6 +857,118 @@ func (conn *connector) elected() (upstream.Upstream, uint64) {
 	return elected, height
 }
 
+// upstreams that agree with the elected client and can supply all
+// blocks up to the voted height, the elected client first
+func (conn *connector) downloadClients() []upstream.Upstream {
+	if conn.theClient == nil {
+		return nil
+	}
+
+	digest := conn.theClient.CachedRemoteDigestOfLocalHeight()
+	localHeight := conn.theClient.LocalHeight()
+
+	clients := []upstream.Upstream{conn.theClient}
+	conn.allClients(func(client upstream.Upstream, e *list.Element) {
+		if len(clients) >= downloadMaximumClients || client == conn.theClient {
+			return
+		}
+		if client.IsConnected() &&
+			client.ActiveInThePast(activeTime) &&
+			client.LocalHeight() == localHeight &&
+			client.CachedRemoteDigestOfLocalHeight() == digest &&
+			client.CachedRemoteHeight() >= conn.height &&
+			!reputation.IsBanned(client.ServerPublicKey(), "") {
+			clients = append(clients, client)
+		}
+	})
+	return clients
+}
+
+// fetch one cycle of blocks from several upstreams at once, storing
+// them in order
+func (conn *connector) fetchBlocksInParallel(clients []upstream.Upstream) {
+	log := conn.log
+
+	first := conn.startBlockNumber
+	last := first + uint64(conn.blocksPerCycle*len(clients)) - 1
+	if last > conn.height {
+		last = conn.height
+	}
+
+	log.Warnf("fetch block numbers: %d to: %d  from: %d upstreams", first, last, len(clients))
+
+	d := newDownloader(log, clients, first, last)
+	d.failed = conn.penaliseFailure
+
+	next, supplier, err := d.run(func(blockNumber uint64, packedBlock []byte, packedNextBlock []byte) error {
+		if conn.headers != nil {
+			if !conn.headers.Matches(blockNumber, packedBlock) {
+				return fault.BlockDoesNotMatchHeader
+			}
+			// the digest was validated with the header
+		} else if !conn.fastSyncEnabled {
+			packedNextBlock = nil
+		}
+		return block.StoreIncoming(packedBlock, packedNextBlock, block.NoRescanVerified)
+	})
+	conn.startBlockNumber = next
+	if conn.headers != nil {
+		conn.headers.Release(next - 1)
+	}
+
+	if err != nil {
+		// a mismatched previous digest can be a fork race
+		if err != fault.PreviousBlockDigestDoesNotMatch {
+			conn.penalise(supplier, reputation.InvalidBlock)
+		}
+		conn.resetHeaders()
+		conn.nextState(cStateHighestBlock) // retry
+		return
+	}
+
+	if conn.fastSyncEnabled {
+		// test random blocks for forgery against the elected client
+		for i := uint64(fastSyncSkipPerBlocks); i <= next-first; i += fastSyncSkipPerBlocks {
+			h := first + i - 1 - uint64(rand.Intn(fastSyncSkipPerBlocks))
+			log.Debugf("select random block: %d to test for forgery", h)
+			digest, err := blockheader.DigestForBlock(h)
+			if err != nil {
+				log.Infof("block number: %d  local digest error: %s", h, err)
+				conn.nextState(cStateHighestBlock) // retry
+				return
+			}
+			remote, err := conn.theClient.RemoteDigestOfHeight(h)
+			if err != nil {
+				log.Infof("block number: %d  fetch digest error: %s", h, err)
+				conn.penaliseFailure(conn.theClient, err)
+				conn.nextState(cStateHighestBlock) // retry
+				return
+			}
+
+			if remote != digest {
+				log.Warnf("potetial block forgery: %d", h)
+				conn.penalise(d.supplier(h), reputation.InvalidDigest)
+
+				// remove the blocks of this cycle
+				err := block.DeleteDownToBlock(first)
+				if err != nil {
+					log.Errorf("delete down to block number: %d  error: %s", first, err)
+				}
+
+				conn.fastSyncEnabled = false
+				conn.nextState(cStateHighestBlock)
+				conn.startBlockNumber = first
+				return
+			}
+		}
+	}
+
+	if next <= last {
+		// every upstream failed, vote again
+		conn.nextState(cStateHighestBlock)
+	}
+}
+
 func (conn *connector) connectUpstream(
 	priority string,
 	serverPublicKey []byte,
@@ -746,6 +997,16 @@ func (conn *connector) connectUpstream(
 		return fault.AddressIsNil
 	}
 
+	if reputation.IsBanned(serverPublicKey, address.String()) {
+		log.Warnf("skip banned: %x @ %s", serverPublicKey, address)
+		return fault.PeerIsBanned
+	}
+
+	if !conn.allowed.IsAllowed(serverPublicKey) {
+		log.Debugf("skip not allowed: %x @ %s", serverPublicKey, address)
+		return fault.PeerNotAllowed
+	}
+
 	log.Infof("connect: %s to: %x @ %s", priority, serverPublicKey, address)
 
 	// see if already connected to this node
@@ -802,6 +1063,33 @@ func (conn *connector) releaseServerKey(serverPublicKey []byte) error {
 	return nil
 }
 
+// lower the reputation of an upstream for an offence, releasing it
+// if it becomes banned
+func (conn *connector) penalise(client upstream.Upstream, offence reputation.Offence) {
+	if client == nil {
+		return
+	}
+	serverPublicKey := client.ServerPublicKey()
+	address, _ := client.RemoteAddr()
+	if reputation.Penalise(serverPublicKey, address, offence) {
+		conn.log.Warnf("banned: %x @ %s  offence: %s", serverPublicKey, address, offence)
+		conn.releaseServerKey(serverPublicKey)
+	}
+}
+
+// charge a failed request by its cause: an unanswered request is a
+// timeout and an undecodable reply an invalid response, a missing block
+// is a normal answer and a broken connection is reconnected rather
+// than charged as it is as likely to be local as the peer's fault
+func (conn *connector) penaliseFailure(client upstream.Upstream, err error) {
+	switch {
+	case zmq.Errno(syscall.EAGAIN) == zmq.AsErrno(err):
+		conn.penalise(client, reputation.Timeout)
+	case err == fault.InvalidPeerResponse:
+		conn.penalise(client, reputation.InvalidResponse)
+	}
+}
+
 func (conn *connector) nextState(newState connectorState) {
 	conn.state = newState
 }
@@ -19,6 +19,9 @@ const (
 	// read block hashes to check for possible fork
 	cStateForkDetect connectorState = iota
 
+	// fetch and validate headers from current or fork point
+	cStateFetchHeaders connectorState = iota
+
 	// fetch blocks from current or fork point
 	cStateFetchBlocks connectorState = iota
 
@@ -27,6 +30,9 @@ const (
 
 	// signal resync complete and sample nodes to see if out of sync occurs
 	cStateSampling connectorState = iota
+
+	// stop syncing after refusing a reorganisation that is too deep
+	cStateHalted connectorState = iota
 )
 
 func (state connectorState) String() string {
@@ -37,12 +43,16 @@ func (state connectorState) String() string {
 		return "HighestBlock"
 	case cStateForkDetect:
 		return "ForkDetect"
+	case cStateFetchHeaders:
+		return "FetchHeaders"
 	case cStateFetchBlocks:
 		return "FetchBlocks"
 	case cStateRebuild:
 		return "Rebuild"
 	case cStateSampling:
 		return "Sampling"
+	case cStateHalted:
+		return "Halted"
 	default:
 		return "*Unknown*"
 	}
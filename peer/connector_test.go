@@ -11,7 +11,10 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/peer/mocks"
+	"github.com/bitmark-inc/bitmarkd/util"
+	"github.com/bitmark-inc/logger"
 )
 
 func newTestConnector() *connector {
@@ -45,3 +48,23 @@ func TestGetConnectedClientCount(t *testing.T) {
 	actual = c.getConnectedClientCount()
 	assert.Equal(t, 0, actual, "wrong connected client count")
 }
+
+func TestConnectUpstreamWhenNotAllowed(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	allowed, _ := testPublicKey(2)
+	other, _ := testPublicKey(3)
+
+	c := newTestConnector()
+	c.log = logger.New("testing")
+	c.allowed = allowList{string(allowed): struct{}{}}
+
+	ctl, mockUpstream := newTestMockUpstream(t)
+	defer ctl.Finish()
+	c.dynamicClients.PushBack(mockUpstream)
+
+	address, _ := util.NewConnection("127.0.0.1:2136")
+	err := c.connectUpstream("test", other, address.Pack())
+	assert.Equal(t, fault.PeerNotAllowed, err, "wrong error")
+}
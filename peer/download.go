@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"sync"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/peer/upstream"
+	"github.com/bitmark-inc/logger"
+)
+
+// parallel download settings
+const (
+	// blocks requested from one upstream at a time
+	downloadRangeBlocks = 25
+
+	// ranges downloaded ahead of the next block to store, per upstream
+	downloadWindowPerClient = 4
+
+	// upstreams used for one download
+	downloadMaximumClients = 8
+
+	// fewer agreeing upstreams than this use the sequential fetch
+	downloadMinimumClients = 2
+)
+
+// function to store a block in chain order, packedNextBlock is the
+// following block when it has already been downloaded
+type storeFunc func(blockNumber uint64, packedBlock []byte, packedNextBlock []byte) error
+
+// a set of consecutive blocks
+type blockRange struct {
+	start uint64
+	count int
+}
+
+// result of fetching a range from one upstream
+type downloadResult struct {
+	r      blockRange
+	blocks [][]byte
+	client upstream.Upstream
+	err    error
+}
+
+// a downloaded block waiting to be stored
+type downloadedBlock struct {
+	packed   []byte
+	supplier upstream.Upstream
+}
+
+// downloader - fetch a span of blocks by spreading ranges over
+// several upstreams and store them in order
+type downloader struct {
+	log     *logger.L
+	clients []upstream.Upstream
+	first   uint64 // first block to fetch
+	last    uint64 // last block to fetch
+
+	// called for each upstream that failed a request, it will not be
+	// used again for this download
+	failed func(upstream.Upstream, error)
+
+	suppliers map[uint64]upstream.Upstream // block number → upstream, of stored blocks
+}
+
+func newDownloader(log *logger.L, clients []upstream.Upstream, first uint64, last uint64) *downloader {
+	return &downloader{
+		log:       log,
+		clients:   clients,
+		first:     first,
+		last:      last,
+		failed:    func(upstream.Upstream, error) {},
+		suppliers: make(map[uint64]upstream.Upstream),
+	}
+}
+
+// supplier - the upstream that supplied a stored block
+func (d *downloader) supplier(blockNumber uint64) upstream.Upstream {
+	return d.suppliers[blockNumber]
+}
+
+// run - download and store blocks until all are stored, a block is
+// rejected or no upstreams remain
+//
+// returns the number of the next block to fetch, and on a store error
+// the error with the upstream that supplied the rejected block
+func (d *downloader) run(store storeFunc) (uint64, upstream.Upstream, error) {
+	if d.last < d.first || len(d.clients) == 0 {
+		return d.first, nil, nil
+	}
+
+	// ranges still to be requested, retries go to the front
+	queue := make([]blockRange, 0, int(d.last-d.first)/downloadRangeBlocks+1)
+	for n := d.first; n <= d.last; n += downloadRangeBlocks {
+		count := downloadRangeBlocks
+		if remaining := d.last - n + 1; remaining < uint64(count) {
+			count = int(remaining)
+		}
+		queue = append(queue, blockRange{start: n, count: count})
+	}
+
+	work := make(chan blockRange)
+	results := make(chan downloadResult, len(d.clients))
+	done := make(chan struct{})
+
+	wg := sync.WaitGroup{}
+	for _, client := range d.clients {
+		wg.Add(1)
+		go func(client upstream.Upstream) {
+			defer wg.Done()
+			fetchRanges(client, work, results, done)
+		}(client)
+	}
+
+	// stop any remaining fetches before returning
+	defer wg.Wait()
+	defer close(done)
+
+	active := len(d.clients)
+	window := uint64(len(d.clients) * downloadWindowPerClient * downloadRangeBlocks)
+
+	buffer := make(map[uint64]downloadedBlock)
+	next := d.first  // next block to store
+	outstanding := 0 // ranges being fetched
+
+	for active > 0 && next <= d.last {
+
+		// only hand out work within the window to bound memory
+		var send chan blockRange
+		var r blockRange
+		if len(queue) > 0 && queue[0].start < next+window {
+			send = work
+			r = queue[0]
+		} else if outstanding == 0 {
+			// nothing can arrive
+			break
+		}
+
+		select {
+		case send <- r:
+			queue = queue[1:]
+			outstanding += 1
+
+		case result := <-results:
+			outstanding -= 1
+			if result.err != nil {
+				d.log.Warnf("fetch range: %d+%d  from: %s  error: %s", result.r.start, result.r.count, result.client.Name(), result.err)
+				queue = append([]blockRange{result.r}, queue...)
+				active -= 1
+				d.failed(result.client, result.err)
+				continue
+			}
+			for i, packed := range result.blocks {
+				buffer[result.r.start+uint64(i)] = downloadedBlock{
+					packed:   packed,
+					supplier: result.client,
+				}
+			}
+		}
+
+		// store every block whose successor is available, so that
+		// a fast sync can check the linkage
+		for {
+			current, ok := buffer[next]
+			if !ok {
+				break
+			}
+			var packedNextBlock []byte
+			if following, ok := buffer[next+1]; ok {
+				packedNextBlock = following.packed
+			} else if next < d.last {
+				break
+			}
+
+			d.log.Debugf("store block number: %d", next)
+			if err := store(next, current.packed, packedNextBlock); err != nil {
+				d.log.Errorf("store block number: %d  error: %s", next, err)
+				return next, current.supplier, err
+			}
+			d.suppliers[next] = current.supplier
+			delete(buffer, next)
+			next += 1
+		}
+	}
+
+	if next <= d.last {
+		d.log.Warnf("stopped at block number: %d  of: %d  upstreams remaining: %d", next, d.last, active)
+	}
+	return next, nil, nil
+}
+
+// fetch each range handed out until told to stop or a request fails
+func fetchRanges(client upstream.Upstream, work <-chan blockRange, results chan<- downloadResult, done <-chan struct{}) {
+	for {
+		var r blockRange
+		select {
+		case <-done:
+			return
+		case r = <-work:
+		}
+
+		result := downloadResult{
+			r:      r,
+			blocks: make([][]byte, 0, r.count),
+			client: client,
+		}
+
+		// the upstream may return fewer blocks than asked for
+	fetch_loop:
+		for len(result.blocks) < r.count {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			blocks, err := client.GetBlockRange(r.start+uint64(len(result.blocks)), r.count-len(result.blocks))
+			if err == nil && len(blocks) == 0 {
+				err = fault.BlockNotFound
+			}
+			for _, packed := range blocks {
+				if err == nil && len(packed) == 0 {
+					err = fault.BlockNotFound
+				}
+			}
+			if err != nil {
+				result.blocks = nil
+				result.err = err
+				break fetch_loop
+			}
+			result.blocks = append(result.blocks, blocks...)
+		}
+
+		select {
+		case <-done:
+			return
+		case results <- result:
+		}
+		if result.err != nil {
+			return
+		}
+	}
+}
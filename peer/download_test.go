@@ -0,0 +1,225 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"encoding/binary"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/peer/mocks"
+	"github.com/bitmark-inc/bitmarkd/peer/upstream"
+	"github.com/bitmark-inc/logger"
+)
+
+const (
+	testingDirName = "testing"
+)
+
+func setupTestLogger() {
+	removeFiles()
+	_ = os.Mkdir(testingDirName, 0o700)
+
+	logging := logger.Configuration{
+		Directory: testingDirName,
+		File:      "testing.log",
+		Size:      1048576,
+		Count:     10,
+		Console:   false,
+		Levels: map[string]string{
+			logger.DefaultTag: "critical",
+		},
+	}
+
+	// start logging
+	_ = logger.Initialise(logging)
+}
+
+func teardownTestLogger() {
+	removeFiles()
+}
+
+func removeFiles() {
+	os.RemoveAll(testingDirName)
+}
+
+// a fake packed block holding only its number
+func testPackedBlock(blockNumber uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, blockNumber)
+	return b
+}
+
+// fake packed blocks, limited to ten as a server would limit a reply
+func testPackedBlocks(blockNumber uint64, count int) [][]byte {
+	if count > 10 {
+		count = 10
+	}
+	blocks := make([][]byte, count)
+	for i := range blocks {
+		blocks[i] = testPackedBlock(blockNumber + uint64(i))
+	}
+	return blocks
+}
+
+// an upstream serving blocks, failing from a block number if non-zero
+func newTestDownloadUpstream(ctl *gomock.Controller, name string, failFrom uint64) *mocks.MockUpstream {
+	u := mocks.NewMockUpstream(ctl)
+	u.EXPECT().Name().Return(name).AnyTimes()
+	u.EXPECT().GetBlockRange(gomock.Any(), gomock.Any()).DoAndReturn(func(blockNumber uint64, count int) ([][]byte, error) {
+		if failFrom != 0 && blockNumber+uint64(count) > failFrom {
+			return nil, fault.InvalidPeerResponse
+		}
+		return testPackedBlocks(blockNumber, count), nil
+	}).AnyTimes()
+	return u
+}
+
+// collect the blocks stored by a downloader
+type testStore struct {
+	sync.Mutex
+	blocks []uint64
+	nexts  []bool
+	failAt uint64
+}
+
+func (s *testStore) store(blockNumber uint64, packedBlock []byte, packedNextBlock []byte) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if blockNumber == s.failAt {
+		return fault.InvalidBlockHeaderDifficulty
+	}
+	if binary.BigEndian.Uint64(packedBlock) != blockNumber {
+		return fault.PreviousBlockDigestDoesNotMatch
+	}
+	if packedNextBlock != nil && binary.BigEndian.Uint64(packedNextBlock) != blockNumber+1 {
+		return fault.PreviousBlockDigestDoesNotMatch
+	}
+	s.blocks = append(s.blocks, blockNumber)
+	s.nexts = append(s.nexts, packedNextBlock != nil)
+	return nil
+}
+
+func TestDownloadInOrder(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	clients := []upstream.Upstream{
+		newTestDownloadUpstream(ctl, "one", 0),
+		newTestDownloadUpstream(ctl, "two", 0),
+		newTestDownloadUpstream(ctl, "three", 0),
+	}
+
+	d := newDownloader(logger.New("testing"), clients, 10, 509)
+	s := &testStore{}
+	next, supplier, err := d.run(s.store)
+
+	assert.Nil(t, err, "wrong run")
+	assert.Nil(t, supplier, "unexpected supplier")
+	assert.Equal(t, uint64(510), next, "wrong next block")
+	assert.Equal(t, 500, len(s.blocks), "wrong stored count")
+	for i, n := range s.blocks {
+		assert.Equal(t, uint64(10+i), n, "block stored out of order")
+	}
+
+	// all but the final block are stored with their successor
+	for i, hasNext := range s.nexts {
+		assert.Equal(t, i != len(s.nexts)-1, hasNext, "wrong next block at: %d", i)
+	}
+
+	assert.NotNil(t, d.supplier(10), "missing supplier")
+}
+
+func TestDownloadRetriesFailedRanges(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	// the good upstream waits until the bad one has failed a range
+	badFailed := make(chan struct{})
+	bad := mocks.NewMockUpstream(ctl)
+	bad.EXPECT().Name().Return("bad").AnyTimes()
+	bad.EXPECT().GetBlockRange(gomock.Any(), gomock.Any()).DoAndReturn(func(blockNumber uint64, count int) ([][]byte, error) {
+		close(badFailed)
+		return nil, fault.InvalidPeerResponse
+	}).Times(1)
+
+	good := mocks.NewMockUpstream(ctl)
+	good.EXPECT().Name().Return("good").AnyTimes()
+	good.EXPECT().GetBlockRange(gomock.Any(), gomock.Any()).DoAndReturn(func(blockNumber uint64, count int) ([][]byte, error) {
+		<-badFailed
+		return testPackedBlocks(blockNumber, count), nil
+	}).AnyTimes()
+
+	clients := []upstream.Upstream{bad, good}
+
+	failed := make([]upstream.Upstream, 0, 1)
+	d := newDownloader(logger.New("testing"), clients, 1, 300)
+	d.failed = func(client upstream.Upstream, err error) {
+		failed = append(failed, client)
+	}
+	s := &testStore{}
+	next, _, err := d.run(s.store)
+
+	assert.Nil(t, err, "wrong run")
+	assert.Equal(t, uint64(301), next, "wrong next block")
+	assert.Equal(t, 300, len(s.blocks), "wrong stored count")
+	assert.Equal(t, []upstream.Upstream{bad}, failed, "wrong failed upstreams")
+}
+
+func TestDownloadWhenAllUpstreamsFail(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	clients := []upstream.Upstream{
+		newTestDownloadUpstream(ctl, "one", 120),
+		newTestDownloadUpstream(ctl, "two", 120),
+	}
+
+	d := newDownloader(logger.New("testing"), clients, 1, 300)
+	s := &testStore{}
+	next, _, err := d.run(s.store)
+
+	assert.Nil(t, err, "wrong run")
+	assert.Equal(t, uint64(100), next, "wrong next block")
+	assert.Equal(t, 99, len(s.blocks), "wrong stored count")
+}
+
+func TestDownloadStoreError(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	clients := []upstream.Upstream{
+		newTestDownloadUpstream(ctl, "one", 0),
+		newTestDownloadUpstream(ctl, "two", 0),
+	}
+
+	d := newDownloader(logger.New("testing"), clients, 1, 300)
+	s := &testStore{failAt: 57}
+	next, supplier, err := d.run(s.store)
+
+	assert.Equal(t, fault.InvalidBlockHeaderDifficulty, err, "wrong error")
+	assert.Equal(t, uint64(57), next, "wrong next block")
+	assert.NotNil(t, supplier, "missing supplier")
+	assert.Equal(t, 56, len(s.blocks), "wrong stored count")
+}
@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package forks
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/logger"
+)
+
+const (
+	// DefaultMaximumDepth - deepest automatic reorganisation when
+	// not configured
+	DefaultMaximumDepth = 60
+
+	// competing tips remembered, the least recently seen are forgotten
+	maximumTips = 100
+
+	// reorganisations remembered
+	maximumReorgs = 20
+)
+
+// Tip - the highest block of a chain that differs from the local chain
+type Tip struct {
+	Height    uint64             `json:"height,string"`
+	Digest    blockdigest.Digest `json:"digest"`
+	Peers     []string           `json:"peers"` // hex public keys of the peers last seen on it
+	FirstSeen time.Time          `json:"firstSeen"`
+	LastSeen  time.Time          `json:"lastSeen"`
+}
+
+// Reorg - a roll back of the local chain to follow another chain
+type Reorg struct {
+	Height    uint64    `json:"height,string"` // local height before
+	Start     uint64    `json:"start,string"`  // first block replaced
+	Depth     uint64    `json:"depth,string"`  // blocks replaced
+	Peer      string    `json:"peer"`          // hex public key of the elected peer
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Report - observed forks and reorganisations
+//
+// Halted is the refused reorganisation that stopped synchronisation,
+// if its fork point is deeper than the maximum it was not searched for
+// and its depth is the maximum plus one
+type Report struct {
+	MaximumDepth uint64  `json:"maximumDepth,string"`
+	Tips         []Tip   `json:"tips"`
+	Reorgs       []Reorg `json:"reorgs"`
+	Halted       *Reorg  `json:"halted,omitempty"`
+}
+
+// Monitor - interface for the fork monitor
+type Monitor interface {
+	Observe([]byte, uint64, blockdigest.Digest)
+	Reorganise([]byte, uint64, uint64) (*Reorg, error)
+	MaximumDepth() uint64
+	SetMaximumDepth(uint64)
+	IsHalted() bool
+	Report() Report
+}
+
+type monitor struct {
+	sync.RWMutex
+
+	log          *logger.L
+	maximumDepth uint64
+
+	tips   map[tipKey]*Tip
+	reorgs []Reorg
+	halted *Reorg
+}
+
+type tipKey struct {
+	height uint64
+	digest blockdigest.Digest
+}
+
+// New - create a fork monitor, zero maximum depth uses the default
+func New(log *logger.L, maximumDepth uint64) Monitor {
+	if maximumDepth == 0 {
+		maximumDepth = DefaultMaximumDepth
+	}
+	return &monitor{
+		log:          log,
+		maximumDepth: maximumDepth,
+		tips:         make(map[tipKey]*Tip),
+		reorgs:       make([]Reorg, 0, maximumReorgs),
+	}
+}
+
+// Observe - record the tip of a peer whose chain differs from the
+// local chain
+func (m *monitor) Observe(publicKey []byte, height uint64, digest blockdigest.Digest) {
+	m.Lock()
+	defer m.Unlock()
+
+	peer := hex.EncodeToString(publicKey)
+	now := time.Now()
+
+	// a peer is only on its latest tip
+	for _, tip := range m.tips {
+		tip.Peers = remove(tip.Peers, peer)
+	}
+
+	key := tipKey{height: height, digest: digest}
+	tip, ok := m.tips[key]
+	if !ok {
+		m.log.Warnf("competing chain height: %d  digest: %s  from peer: %s", height, digest, peer)
+		tip = &Tip{
+			Height:    height,
+			Digest:    digest,
+			FirstSeen: now,
+		}
+		m.tips[key] = tip
+		m.forget()
+	}
+	tip.Peers = append(tip.Peers, peer)
+	tip.LastSeen = now
+}
+
+func remove(peers []string, peer string) []string {
+	for i, p := range peers {
+		if p == peer {
+			return append(peers[:i], peers[i+1:]...)
+		}
+	}
+	return peers
+}
+
+// forget the least recently seen tip if there are too many
+func (m *monitor) forget() {
+	if len(m.tips) <= maximumTips {
+		return
+	}
+	var oldest tipKey
+	var oldestTime time.Time
+	for key, tip := range m.tips {
+		if oldestTime.IsZero() || tip.LastSeen.Before(oldestTime) {
+			oldest = key
+			oldestTime = tip.LastSeen
+		}
+	}
+	delete(m.tips, oldest)
+}
+
+// Reorganise - record replacing the local blocks from start up to
+// height with those of another chain
+//
+// a reorganisation deeper than the maximum is refused and halts
+// synchronisation until the maximum is changed by SetMaximumDepth
+func (m *monitor) Reorganise(publicKey []byte, height uint64, start uint64) (*Reorg, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	depth := uint64(0)
+	if height >= start {
+		depth = height - start + 1
+	}
+
+	r := Reorg{
+		Height:    height,
+		Start:     start,
+		Depth:     depth,
+		Peer:      hex.EncodeToString(publicKey),
+		Timestamp: time.Now(),
+	}
+
+	if depth > m.maximumDepth {
+		m.log.Criticalf("reorganisation depth: %d from block: %d exceeds maximum: %d  synchronisation halted", depth, start, m.maximumDepth)
+		m.halted = &r
+		return &r, fault.ReorganisationTooDeep
+	}
+
+	if depth == 0 {
+		return &r, nil
+	}
+
+	m.log.Warnf("reorganisation depth: %d from block: %d to peer: %s", depth, start, r.Peer)
+
+	if len(m.reorgs) >= maximumReorgs {
+		m.reorgs = append(m.reorgs[:0], m.reorgs[1:]...)
+	}
+	m.reorgs = append(m.reorgs, r)
+
+	return &r, nil
+}
+
+// MaximumDepth - deepest automatic reorganisation
+func (m *monitor) MaximumDepth() uint64 {
+	m.RLock()
+	defer m.RUnlock()
+	return m.maximumDepth
+}
+
+// SetMaximumDepth - change the deepest automatic reorganisation, zero
+// uses the default
+//
+// this is the operator's acknowledgement of a halt, so any refused
+// reorganisation is cleared and synchronisation resumes, deeper forks
+// are then checked against the new maximum
+func (m *monitor) SetMaximumDepth(maximumDepth uint64) {
+	if maximumDepth == 0 {
+		maximumDepth = DefaultMaximumDepth
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	m.maximumDepth = maximumDepth
+	if m.halted != nil {
+		m.log.Warnf("maximum reorganisation depth: %d  synchronisation resumed", maximumDepth)
+		m.halted = nil
+	}
+}
+
+// IsHalted - true if a reorganisation was refused
+func (m *monitor) IsHalted() bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.halted != nil
+}
+
+// Report - copy of the current state, most recently seen tips first
+func (m *monitor) Report() Report {
+	m.RLock()
+	defer m.RUnlock()
+
+	r := Report{
+		MaximumDepth: m.maximumDepth,
+		Tips:         make([]Tip, 0, len(m.tips)),
+		Reorgs:       make([]Reorg, len(m.reorgs)),
+	}
+	for _, tip := range m.tips {
+		t := *tip
+		t.Peers = append([]string{}, tip.Peers...)
+		r.Tips = append(r.Tips, t)
+	}
+	sort.Slice(r.Tips, func(i, j int) bool {
+		return r.Tips[i].LastSeen.After(r.Tips[j].LastSeen)
+	})
+	copy(r.Reorgs, m.reorgs)
+	if m.halted != nil {
+		halted := *m.halted
+		r.Halted = &halted
+	}
+	return r
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package forks_test
+
+import (
+	"encoding/hex"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/peer/forks"
+	"github.com/bitmark-inc/logger"
+)
+
+const (
+	testingDirName = "testing"
+)
+
+func setupTestLogger() {
+	removeFiles()
+	_ = os.Mkdir(testingDirName, 0o700)
+
+	logging := logger.Configuration{
+		Directory: testingDirName,
+		File:      "testing.log",
+		Size:      1048576,
+		Count:     10,
+		Console:   false,
+		Levels: map[string]string{
+			logger.DefaultTag: "critical",
+		},
+	}
+
+	// start logging
+	_ = logger.Initialise(logging)
+}
+
+func teardownTestLogger() {
+	removeFiles()
+}
+
+func removeFiles() {
+	os.RemoveAll(testingDirName)
+}
+
+func newTestMonitor(maximumDepth uint64) forks.Monitor {
+	return forks.New(logger.New("testing"), maximumDepth)
+}
+
+func TestNewWhenDefaultDepth(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	m := newTestMonitor(0)
+	assert.Equal(t, uint64(forks.DefaultMaximumDepth), m.MaximumDepth(), "wrong maximum depth")
+	assert.False(t, m.IsHalted(), "wrong halted")
+
+	m = newTestMonitor(5)
+	assert.Equal(t, uint64(5), m.MaximumDepth(), "wrong maximum depth")
+}
+
+func TestObserve(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	m := newTestMonitor(10)
+
+	peer1 := []byte{1, 2, 3}
+	peer2 := []byte{4, 5, 6}
+	digest1 := blockdigest.Digest{1}
+	digest2 := blockdigest.Digest{2}
+
+	m.Observe(peer1, 100, digest1)
+	m.Observe(peer2, 100, digest1)
+
+	r := m.Report()
+	assert.Equal(t, 1, len(r.Tips), "wrong tip count")
+	assert.Equal(t, uint64(100), r.Tips[0].Height, "wrong height")
+	assert.Equal(t, digest1, r.Tips[0].Digest, "wrong digest")
+	assert.Equal(t, []string{hex.EncodeToString(peer1), hex.EncodeToString(peer2)}, r.Tips[0].Peers, "wrong peers")
+
+	// peer moves to a higher tip
+	m.Observe(peer1, 101, digest2)
+
+	r = m.Report()
+	assert.Equal(t, 2, len(r.Tips), "wrong tip count")
+	assert.Equal(t, uint64(101), r.Tips[0].Height, "most recent tip not first")
+	assert.Equal(t, []string{hex.EncodeToString(peer1)}, r.Tips[0].Peers, "wrong peers")
+	assert.Equal(t, []string{hex.EncodeToString(peer2)}, r.Tips[1].Peers, "peer not moved")
+}
+
+func TestReorganise(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	m := newTestMonitor(10)
+	peer := []byte{1, 2, 3}
+
+	r, err := m.Reorganise(peer, 100, 101)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, uint64(0), r.Depth, "wrong depth")
+	assert.Equal(t, 0, len(m.Report().Reorgs), "no reorganisation recorded")
+
+	r, err = m.Reorganise(peer, 100, 96)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, uint64(5), r.Depth, "wrong depth")
+	assert.Equal(t, hex.EncodeToString(peer), r.Peer, "wrong peer")
+
+	report := m.Report()
+	assert.Equal(t, 1, len(report.Reorgs), "wrong reorganisation count")
+	assert.Equal(t, uint64(96), report.Reorgs[0].Start, "wrong start")
+	assert.Nil(t, report.Halted, "wrong halted")
+	assert.False(t, m.IsHalted(), "wrong halted")
+}
+
+func TestReorganiseWhenTooDeep(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	m := newTestMonitor(10)
+
+	r, err := m.Reorganise([]byte{1}, 100, 90)
+	assert.Equal(t, fault.ReorganisationTooDeep, err, "wrong error")
+	assert.Equal(t, uint64(11), r.Depth, "wrong depth")
+	assert.True(t, m.IsHalted(), "not halted")
+
+	report := m.Report()
+	assert.Equal(t, 0, len(report.Reorgs), "refused reorganisation recorded")
+	assert.NotNil(t, report.Halted, "halted not reported")
+	assert.Equal(t, uint64(90), report.Halted.Start, "wrong start")
+}
+
+func TestSetMaximumDepthWhenHalted(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	m := newTestMonitor(10)
+
+	_, err := m.Reorganise([]byte{1}, 100, 90)
+	assert.Equal(t, fault.ReorganisationTooDeep, err, "wrong error")
+	assert.True(t, m.IsHalted(), "not halted")
+
+	m.SetMaximumDepth(20)
+	assert.Equal(t, uint64(20), m.MaximumDepth(), "wrong maximum depth")
+	assert.False(t, m.IsHalted(), "still halted")
+	assert.Nil(t, m.Report().Halted, "halted still reported")
+
+	r, err := m.Reorganise([]byte{1}, 100, 90)
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, uint64(11), r.Depth, "wrong depth")
+
+	m.SetMaximumDepth(0)
+	assert.Equal(t, uint64(forks.DefaultMaximumDepth), m.MaximumDepth(), "wrong default depth")
+}
+
+func TestReorganiseWhenMany(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	m := newTestMonitor(10)
+
+	for i := uint64(1); i <= 30; i += 1 {
+		_, err := m.Reorganise([]byte{1}, 100+i, 100+i)
+		assert.Nil(t, err, "wrong error")
+	}
+
+	report := m.Report()
+	assert.Equal(t, 20, len(report.Reorgs), "wrong reorganisation count")
+	assert.Equal(t, uint64(111), report.Reorgs[0].Start, "oldest not forgotten")
+	assert.Equal(t, uint64(130), report.Reorgs[19].Start, "wrong newest")
+}
@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"github.com/bitmark-inc/bitmarkd/blockheader"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/genesis"
+	"github.com/bitmark-inc/bitmarkd/light"
+	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/peer/reputation"
+)
+
+// header sync settings
+const (
+	// headers validated in one cycle when the Argon2 digest is checked
+	verifiedHeadersPerCycle = 1000
+
+	// headers validated in one cycle during fast sync
+	fastSyncHeadersPerCycle = 20000
+)
+
+// forget any validated headers
+func (conn *connector) resetHeaders() {
+	conn.headers = nil
+	setHeaderHeight(0)
+}
+
+// publish the height of the validated headers
+func setHeaderHeight(height uint64) {
+	globalData.Lock()
+	globalData.headerHeight = height
+	globalData.Unlock()
+}
+
+// fetch and validate the headers from the elected client before any
+// blocks are downloaded, so that a bad chain is rejected early
+//
+// a client without header support goes straight to fetching blocks
+//
+// returns true to continue looping
+func (conn *connector) fetchHeaders() bool {
+	log := conn.log
+
+	if conn.headers == nil {
+		previous := conn.startBlockNumber - 1
+		header, err := storedHeader(previous)
+		if err != nil {
+			log.Errorf("block number: %d  header error: %s", previous, err)
+			conn.nextState(cStateFetchBlocks)
+			return true
+		}
+		digest, err := blockheader.DigestForBlock(previous)
+		if err != nil {
+			log.Errorf("block number: %d  digest error: %s", previous, err)
+			conn.nextState(cStateFetchBlocks)
+			return true
+		}
+		conn.headers = blockrecord.NewHeaderChain(mode.ChainName(), header, digest, storedHeader)
+	}
+
+	limit := verifiedHeadersPerCycle
+	if conn.fastSyncEnabled && conn.pivotPoint > conn.headers.Height() {
+		limit = fastSyncHeadersPerCycle
+	}
+
+fetch_headers:
+	for fetched := 0; fetched < limit && conn.headers.Height() < conn.height; {
+		start := conn.headers.Height() + 1
+		count := limit - fetched
+		if remaining := conn.height - conn.headers.Height(); remaining < uint64(count) {
+			count = int(remaining)
+		}
+
+		headers, err := conn.theClient.GetHeaderRange(start, count)
+		if err == fault.HeaderRangeNotSupported {
+			log.Infof("client: %s  does not support headers", conn.theClient.Name())
+			conn.resetHeaders()
+			conn.nextState(cStateFetchBlocks)
+			return true
+		}
+		if err != nil {
+			log.Errorf("fetch headers from: %d  error: %s", start, err)
+			conn.penaliseFailure(conn.theClient, err)
+			conn.resetHeaders()
+			conn.nextState(cStateHighestBlock) // retry
+			return false
+		}
+
+		for _, packed := range headers {
+			n := conn.headers.Height() + 1
+
+			// the digest is checked as for blocks
+			checkDigest := !conn.fastSyncEnabled || n >= conn.pivotPoint
+
+			if err := conn.headers.Add(packed, checkDigest); err != nil {
+				log.Errorf("header block number: %d  error: %s", n, err)
+				if !blockrecord.IsLocalError(err) {
+					conn.penalise(conn.theClient, reputation.InvalidBlock)
+				}
+				conn.resetHeaders()
+				conn.nextState(cStateHighestBlock)
+				return false
+			}
+			if conn.headers.Height() >= conn.height {
+				break fetch_headers
+			}
+		}
+		fetched += len(headers)
+	}
+
+	setHeaderHeight(conn.headers.Height())
+
+	if conn.headers.Height() < conn.height {
+		log.Infof("headers validated to: %d  of: %d", conn.headers.Height(), conn.height)
+		return false
+	}
+
+	log.Warnf("headers validated to: %d", conn.headers.Height())
+	conn.nextState(cStateFetchBlocks)
+	return true
+}
+
+// store the validated headers of a light node in place of their
+// blocks, scanning each for the watched accounts as it is stored
+//
+// returns true to continue looping
+func (conn *connector) storeHeaders() bool {
+	log := conn.log
+
+	if conn.headers == nil {
+		log.Errorf("client: %s  cannot serve headers to a light node", conn.theClient.Name())
+		conn.nextState(cStateHighestBlock) // retry
+		return false
+	}
+
+	for n := conn.startBlockNumber; n <= conn.headers.Height(); n += 1 {
+		header, digest, err := conn.headers.Header(n)
+		if err == nil {
+			err = light.ScanAndStoreHeader(conn.theClient, header, digest)
+		}
+		if err != nil {
+			log.Errorf("store header block number: %d  error: %s", n, err)
+			conn.penaliseFailure(conn.theClient, err)
+			conn.resetHeaders()
+			conn.nextState(cStateHighestBlock) // retry
+			return false
+		}
+		conn.headers.Release(n)
+		conn.startBlockNumber = n + 1
+	}
+
+	log.Infof("headers stored to: %d", conn.startBlockNumber-1)
+	conn.nextState(cStateHighestBlock)
+	return true
+}
+
+// header of a stored block, the genesis block is not in the database
+func storedHeader(height uint64) (*blockrecord.Header, error) {
+	if height > genesis.BlockNumber {
+		return blockrecord.StoredHeader(height)
+	}
+
+	packedHeader, err := blockrecord.PackedHeaderOf(genesis.Block(mode.ChainName()))
+	if err != nil {
+		return nil, err
+	}
+	return packedHeader.Unpack()
+}
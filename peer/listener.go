@@ -12,10 +12,15 @@ import (
 	"syscall"
 
 	"github.com/bitmark-inc/bitmarkd/announce"
+	"github.com/bitmark-inc/bitmarkd/blockfilter"
 	"github.com/bitmark-inc/bitmarkd/blockheader"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
 	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/peer/reputation"
 	"github.com/bitmark-inc/bitmarkd/storage"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 	"github.com/bitmark-inc/bitmarkd/util"
 	"github.com/bitmark-inc/bitmarkd/zmqutil"
 	"github.com/bitmark-inc/logger"
@@ -27,6 +32,15 @@ const (
 	listenerSignal            = "inproc://bitmark-listener-signal"
 	listenerIPv4MonitorSignal = "inproc://listener-ipv4-monitor-signal"
 	listenerIPv6MonitorSignal = "inproc://listener-ipv6-monitor-signal"
+
+	// limits of a block range reply, at least one block is always sent
+	// and the whole reply must be accepted by the receiving socket,
+	// leaving room for the function frame and the framing of each block
+	blockRangeMaximumBlocks = 500
+	blockRangeMaximumBytes  = zmqutil.MaximumPacketSize - 64*1024
+
+	// limit of a header range reply
+	headerRangeMaximumHeaders = 2000
 )
 
 type listener struct {
@@ -40,6 +54,7 @@ type listener struct {
 	monitor4    *zmq.Socket // IPv4 socket monitor
 	monitor6    *zmq.Socket // IPv6 socket monitor
 	connections uint64      // total incoming connections
+	allowed     allowList   // peers of a private network
 }
 
 // type to hold server info
@@ -48,10 +63,16 @@ type serverInfo struct {
 	Chain   string `json:"chain"`
 	Normal  bool   `json:"normal"`
 	Height  uint64 `json:"height"`
+
+	// maximum blocks in a "G" reply, absent if not supported
+	BlockRange uint64 `json:"blockRange,omitempty"`
+
+	// maximum headers in a "P" reply, absent if not supported
+	HeaderRange uint64 `json:"headerRange,omitempty"`
 }
 
 // initialise the listener
-func (lstn *listener) initialise(privateKey []byte, publicKey []byte, listen []string, version string) error {
+func (lstn *listener) initialise(privateKey []byte, publicKey []byte, listen []string, version string, allowed allowList) error {
 
 	log := logger.New("listener")
 
@@ -59,6 +80,7 @@ func (lstn *listener) initialise(privateKey []byte, publicKey []byte, listen []s
 	lstn.log = log
 	lstn.version = version
 	lstn.connections = 0
+	lstn.allowed = allowed
 
 	log.Info("initialising…")
 
@@ -81,6 +103,11 @@ func (lstn *listener) initialise(privateKey []byte, publicKey []byte, listen []s
 		return err
 	}
 
+	// a private network refuses unknown peers during the handshake
+	if allowed.IsPrivate() {
+		zmqutil.RestrictCurveKeys(listenerZapDomain, allowed.Keys())
+	}
+
 	if lstn.socket4 != nil {
 		lstn.monitor4, err = zmqutil.NewMonitor(lstn.socket4, listenerIPv4MonitorSignal, zmq.EVENT_ALL)
 		if err != nil {
@@ -167,7 +194,7 @@ func (lstn *listener) process(socket *zmq.Socket) {
 func (lstn *listener) processOne(i int, socket *zmq.Socket) bool {
 	log := lstn.log
 
-	data, err := socket.RecvMessageBytes(zmq.DONTWAIT)
+	data, metadata, err := socket.RecvMessageBytesWithMetadata(zmq.DONTWAIT, "Peer-Address")
 	if zmq.Errno(syscall.EAGAIN) == zmq.AsErrno(err) {
 		lstn.log.Infof("processed: %d events", i)
 		return false
@@ -177,6 +204,14 @@ func (lstn *listener) processOne(i int, socket *zmq.Socket) bool {
 		return false
 	}
 
+	// the REP socket must still reply to a banned peer
+	peerAddress := metadata["Peer-Address"]
+	if reputation.IsBanned(nil, peerAddress) {
+		log.Debugf("banned peer: %q", peerAddress)
+		listenerSendError(socket, fault.PeerIsBanned)
+		return true
+	}
+
 	if len(data) < 2 {
 		listenerSendError(socket, fmt.Errorf("packet too short"))
 		return true
@@ -185,6 +220,7 @@ func (lstn *listener) processOne(i int, socket *zmq.Socket) bool {
 	theChain := string(data[0])
 	if theChain != lstn.chain {
 		log.Errorf("invalid chain: actual: %q  expect: %s", theChain, lstn.chain)
+		reputation.Penalise(nil, peerAddress, reputation.WrongChain)
 		listenerSendError(socket, fmt.Errorf("invalid chain: actual: %q  expect: %s", theChain, lstn.chain))
 		return true
 	}
@@ -204,6 +240,9 @@ func (lstn *listener) processOne(i int, socket *zmq.Socket) bool {
 			Chain:   mode.ChainName(),
 			Normal:  mode.Is(mode.Normal),
 			Height:  blockheader.Height(),
+
+			BlockRange:  blockRangeMaximumBlocks,
+			HeaderRange: headerRangeMaximumHeaders,
 		}
 		result, err = json.Marshal(info)
 		logger.PanicIfError("JSON encode error: %s", err)
@@ -225,6 +264,81 @@ func (lstn *listener) processOne(i int, socket *zmq.Socket) bool {
 			err = fault.BlockNotFound
 		}
 
+	case "G": // get packed blocks: start block number, count
+		if len(parameters) != 2 {
+			listenerSendError(socket, fault.MissingParameters)
+			return true
+		}
+		if len(parameters[0]) != 8 || len(parameters[1]) != 8 {
+			listenerSendError(socket, fault.InvalidParameters)
+			return true
+		}
+		start := binary.BigEndian.Uint64(parameters[0])
+		count := binary.BigEndian.Uint64(parameters[1])
+		blocks := packedBlockRange(storage.Pool.Blocks.Get, start, count)
+		if len(blocks) == 0 {
+			listenerSendError(socket, fault.BlockNotFound)
+			return true
+		}
+
+		_, err = socket.Send(fn, zmq.SNDMORE)
+		logger.PanicIfError("Listener", err)
+		for i, packed := range blocks {
+			flags := zmq.SNDMORE
+			if i == len(blocks)-1 {
+				flags = 0
+			}
+			_, err = socket.SendBytes(packed, flags)
+			logger.PanicIfError("Listener", err)
+		}
+
+		log.Infof("sent: %q  blocks: %d from: %d", fn, len(blocks), start)
+		return true
+
+	case "P": // get packed headers: start block number, count
+		if len(parameters) != 2 {
+			err = fault.MissingParameters
+		} else if len(parameters[0]) == 8 && len(parameters[1]) == 8 {
+			start := binary.BigEndian.Uint64(parameters[0])
+			count := binary.BigEndian.Uint64(parameters[1])
+			result = packedHeaderRange(storage.Pool.Blocks.Get, start, count)
+			if len(result) == 0 {
+				err = fault.BlockNotFound
+			}
+		} else {
+			err = fault.BlockNotFound
+		}
+
+	case "F": // get compact block filter
+		if len(parameters) != 1 {
+			err = fault.MissingParameters
+		} else if len(parameters[0]) == 8 {
+			number := binary.BigEndian.Uint64(parameters[0])
+			result, _, err = blockfilter.Get(storage.Pool.BlockFilters, storage.Pool.Blocks, number, mode.IsTesting())
+		} else {
+			err = fault.BlockNotFound
+		}
+
+	case "L": // locate the block of a transaction or an asset
+		if len(parameters) != 1 {
+			err = fault.MissingParameters
+		} else {
+			var packed []byte
+			var number uint64
+			switch len(parameters[0]) {
+			case merkle.DigestLength:
+				number, packed = storage.Pool.Transactions.GetNB(parameters[0])
+			case transactionrecord.AssetIdentifierLength:
+				number, packed = storage.Pool.Assets.GetNB(parameters[0])
+			}
+			if packed == nil {
+				err = fault.RecordNotFound
+			} else {
+				result = make([]byte, 8)
+				binary.BigEndian.PutUint64(result, number)
+			}
+		}
+
 	case "H": // get block hash
 		if len(parameters) != 1 {
 			err = fault.MissingParameters
@@ -247,12 +361,27 @@ func (lstn *listener) processOne(i int, socket *zmq.Socket) bool {
 		}
 		chain := mode.ChainName()
 		if string(parameters[0]) != chain {
+			reputation.Penalise(parameters[1], peerAddress, reputation.WrongChain)
 			listenerSendError(socket, fault.IncorrectChain)
 			return true
 		}
 
+		if reputation.IsBanned(parameters[1], "") {
+			listenerSendError(socket, fault.PeerIsBanned)
+			return true
+		}
+
+		if !lstn.allowed.IsAllowed(parameters[1]) {
+			log.Warnf("peer not allowed: %x  address: %q", parameters[1], peerAddress)
+			listenerSendError(socket, fault.PeerNotAllowed)
+			return true
+		}
+
+		// registering again before a rebroadcast is due is not accepted
 		timestamp := binary.BigEndian.Uint64(parameters[3])
-		announce.AddPeer(parameters[1], parameters[2], timestamp) // publicKey, listeners, timestamp
+		if !announce.AddPeer(parameters[1], parameters[2], timestamp) { // publicKey, listeners, timestamp
+			reputation.Penalise(parameters[1], "", reputation.SpamAnnouncement)
+		}
 		publicKey, listeners, ts, err := announce.GetRandom(parameters[1])
 		if err != nil {
 			listenerSendError(socket, err)
@@ -295,6 +424,51 @@ func (lstn *listener) processOne(i int, socket *zmq.Socket) bool {
 	return true
 }
 
+// consecutive packed blocks from a start block number, stopping at
+// the first missing block or when the reply limits are reached
+func packedBlockRange(get func([]byte) []byte, start uint64, count uint64) [][]byte {
+	if count > blockRangeMaximumBlocks {
+		count = blockRangeMaximumBlocks
+	}
+
+	blocks := make([][]byte, 0, count)
+	size := 0
+	key := make([]byte, 8)
+	for n := start; n < start+count; n += 1 {
+		binary.BigEndian.PutUint64(key, n)
+		packed := get(key)
+		if packed == nil {
+			break
+		}
+		if len(blocks) > 0 && size+len(packed) > blockRangeMaximumBytes {
+			break
+		}
+		size += len(packed)
+		blocks = append(blocks, packed)
+	}
+	return blocks
+}
+
+// concatenated packed headers from a start block number, stopping at
+// the first missing block
+func packedHeaderRange(get func([]byte) []byte, start uint64, count uint64) []byte {
+	if count > headerRangeMaximumHeaders {
+		count = headerRangeMaximumHeaders
+	}
+
+	headers := make([]byte, 0, count*uint64(len(blockrecord.PackedHeader{})))
+	key := make([]byte, 8)
+	for n := start; n < start+count; n += 1 {
+		binary.BigEndian.PutUint64(key, n)
+		packedHeader, err := blockrecord.PackedHeaderOf(get(key))
+		if err != nil {
+			break
+		}
+		headers = append(headers, packedHeader[:]...)
+	}
+	return headers
+}
+
 // process the socket events
 func (lstn *listener) handleEvent(socket *zmq.Socket) {
 
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/zmqutil"
+)
+
+// a block store holding blocks 1 to 10 with the given size
+func testBlockGetter(size int) func([]byte) []byte {
+	return func(key []byte) []byte {
+		n := binary.BigEndian.Uint64(key)
+		if n < 1 || n > 10 {
+			return nil
+		}
+		packed := make([]byte, size)
+		packed[0] = byte(n)
+		return packed
+	}
+}
+
+func TestPackedBlockRange(t *testing.T) {
+	blocks := packedBlockRange(testBlockGetter(10), 3, 4)
+	assert.Equal(t, 4, len(blocks), "wrong block count")
+	for i, packed := range blocks {
+		assert.Equal(t, byte(3+i), packed[0], "wrong block")
+	}
+
+	// stops at the last block
+	blocks = packedBlockRange(testBlockGetter(10), 8, 100)
+	assert.Equal(t, 3, len(blocks), "wrong block count at end")
+
+	blocks = packedBlockRange(testBlockGetter(10), 11, 5)
+	assert.Equal(t, 0, len(blocks), "blocks beyond end")
+}
+
+func TestPackedBlockRangeLimits(t *testing.T) {
+	// byte limit
+	size := blockRangeMaximumBytes / 3
+	blocks := packedBlockRange(testBlockGetter(size), 1, 10)
+	assert.Equal(t, 3, len(blocks), "wrong block count for size limit")
+
+	// a full reply is accepted by the receiving socket
+	size = 1000 * 1000
+	blocks = packedBlockRange(testBlockGetter(size), 1, 10)
+	total := 0
+	for _, packed := range blocks {
+		total += len(packed)
+	}
+	assert.Less(t, total, zmqutil.MaximumPacketSize, "reply larger than a packet")
+
+	// a single oversized block is still sent
+	blocks = packedBlockRange(testBlockGetter(blockRangeMaximumBytes+1), 1, 10)
+	assert.Equal(t, 1, len(blocks), "oversized block not sent")
+
+	// block limit
+	get := func(key []byte) []byte {
+		return []byte{1}
+	}
+	blocks = packedBlockRange(get, 1, blockRangeMaximumBlocks+100)
+	assert.Equal(t, blockRangeMaximumBlocks, len(blocks), "wrong block count for block limit")
+}
+
+func TestPackedHeaderRange(t *testing.T) {
+	size := len(blockrecord.PackedHeader{})
+
+	headers := packedHeaderRange(testBlockGetter(size+20), 2, 3)
+	assert.Equal(t, 3*size, len(headers), "wrong header bytes")
+	assert.Equal(t, byte(2), headers[0], "wrong first header")
+	assert.Equal(t, byte(4), headers[2*size], "wrong last header")
+
+	// stops at the last block
+	headers = packedHeaderRange(testBlockGetter(size), 9, 100)
+	assert.Equal(t, 2*size, len(headers), "wrong header bytes at end")
+
+	// blocks too short for a header are not sent
+	headers = packedHeaderRange(testBlockGetter(size-1), 1, 5)
+	assert.Equal(t, 0, len(headers), "short block sent")
+}
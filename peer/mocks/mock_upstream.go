@@ -17,6 +17,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 
 	blockdigest "github.com/bitmark-inc/bitmarkd/blockdigest"
+	blockrecord "github.com/bitmark-inc/bitmarkd/blockrecord"
 	util "github.com/bitmark-inc/bitmarkd/util"
 	zmqutil "github.com/bitmark-inc/bitmarkd/zmqutil"
 )
@@ -141,6 +142,51 @@ func (mr *MockUpstreamMockRecorder) GetBlockData(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockData", reflect.TypeOf((*MockUpstream)(nil).GetBlockData), arg0)
 }
 
+// GetBlockFilter mocks base method
+func (m *MockUpstream) GetBlockFilter(arg0 uint64) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockFilter", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockFilter indicates an expected call of GetBlockFilter
+func (mr *MockUpstreamMockRecorder) GetBlockFilter(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockFilter", reflect.TypeOf((*MockUpstream)(nil).GetBlockFilter), arg0)
+}
+
+// GetBlockRange mocks base method
+func (m *MockUpstream) GetBlockRange(arg0 uint64, arg1 int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockRange", arg0, arg1)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockRange indicates an expected call of GetBlockRange
+func (mr *MockUpstreamMockRecorder) GetBlockRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockRange", reflect.TypeOf((*MockUpstream)(nil).GetBlockRange), arg0, arg1)
+}
+
+// GetHeaderRange mocks base method
+func (m *MockUpstream) GetHeaderRange(arg0 uint64, arg1 int) ([]blockrecord.PackedHeader, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeaderRange", arg0, arg1)
+	ret0, _ := ret[0].([]blockrecord.PackedHeader)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeaderRange indicates an expected call of GetHeaderRange
+func (mr *MockUpstreamMockRecorder) GetHeaderRange(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeaderRange", reflect.TypeOf((*MockUpstream)(nil).GetHeaderRange), arg0, arg1)
+}
+
 // IsConnected mocks base method
 func (m *MockUpstream) IsConnected() bool {
 	m.ctrl.T.Helper()
@@ -183,6 +229,21 @@ func (mr *MockUpstreamMockRecorder) LocalHeight() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalHeight", reflect.TypeOf((*MockUpstream)(nil).LocalHeight))
 }
 
+// LocateRecord mocks base method
+func (m *MockUpstream) LocateRecord(arg0 []byte) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LocateRecord", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LocateRecord indicates an expected call of LocateRecord
+func (mr *MockUpstreamMockRecorder) LocateRecord(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocateRecord", reflect.TypeOf((*MockUpstream)(nil).LocateRecord), arg0)
+}
+
 // Name mocks base method
 func (m *MockUpstream) Name() string {
 	m.ctrl.T.Helper()
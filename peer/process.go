@@ -11,6 +11,7 @@ import (
 	"github.com/bitmark-inc/bitmarkd/announce"
 	"github.com/bitmark-inc/bitmarkd/asset"
 	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/messagebus"
 	"github.com/bitmark-inc/bitmarkd/mode"
 	"github.com/bitmark-inc/bitmarkd/pay"
@@ -78,6 +79,19 @@ func processSubscription(log *logger.L, command string, arguments [][]byte) {
 			messagebus.Bus.Broadcast.Send("transfer", arguments[0])
 		}
 
+	case "replace":
+		if dataLength < 3 {
+			log.Debugf("replace with too few data: %d items", dataLength)
+			return
+		}
+		log.Infof("received replace: %x  transfer: %x", arguments[0], arguments[1])
+		err := processReplace(arguments[0], arguments[1], arguments[2])
+		if err != nil {
+			log.Debugf("failed replace: error: %s", err)
+		} else {
+			messagebus.Bus.Broadcast.Send("replace", arguments[0:3]...)
+		}
+
 	case "proof":
 		if dataLength < 1 {
 			log.Debugf("proof with too few data: %d items", dataLength)
@@ -92,6 +106,10 @@ func processSubscription(log *logger.L, command string, arguments [][]byte) {
 		}
 
 	case "rpc":
+		if globalData.allowed.IsPrivate() {
+			log.Debug("private network ignores rpc announcements")
+			return
+		}
 		if dataLength < 3 {
 			log.Debugf("rpc with too few data: %d items", dataLength)
 			return
@@ -107,6 +125,10 @@ func processSubscription(log *logger.L, command string, arguments [][]byte) {
 		}
 
 	case "peer":
+		if globalData.allowed.IsPrivate() {
+			log.Debug("private network ignores peer announcements")
+			return
+		}
 		if dataLength < 3 {
 			log.Debugf("peer with too few data: %d items", dataLength)
 			return
@@ -265,6 +287,48 @@ func processTransfer(packed []byte) error {
 	return nil
 }
 
+// unpack a replacement transfer and process it
+func processReplace(txId []byte, packed []byte, signature []byte) error {
+
+	if len(packed) == 0 || len(signature) == 0 {
+		return fault.MissingParameters
+	}
+
+	if !mode.Is(mode.Normal) {
+		return fault.NotAvailableDuringSynchronise
+	}
+
+	var previousTxId merkle.Digest
+	err := merkle.DigestFromBytes(&previousTxId, txId)
+	if err != nil {
+		return err
+	}
+
+	transaction, _, err := transactionrecord.Packed(packed).Unpack(mode.IsTesting())
+	if err != nil {
+		return err
+	}
+
+	transfer, ok := transaction.(transactionrecord.BitmarkTransfer)
+	if !ok {
+		return fault.TransactionIsNotATransfer
+	}
+
+	rsvr := reservoir.Get()
+	_, err = rsvr.ReplaceTransfer(previousTxId, transfer, signature)
+
+	// this node never saw the original, so treat as a new transfer
+	if fault.TransactionIsNotPending == err {
+		_, duplicate, err := rsvr.StoreTransfer(transfer)
+		if err == nil && duplicate {
+			return fault.TransactionAlreadyExists
+		}
+		return err
+	}
+
+	return err
+}
+
 // process proof block
 func processProof(packed []byte) error {
 
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reputation
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+)
+
+// save the unexpired bans to a file
+// ensure lock is held before calling
+func backup(fileName string) error {
+	if fileName == "" {
+		return nil
+	}
+
+	now := time.Now()
+	bans := make([]*BanInfo, 0, len(globalData.bans))
+	for _, ban := range globalData.bans {
+		if now.Before(ban.ExpiresAt) {
+			bans = append(bans, ban)
+		}
+	}
+
+	data, err := json.Marshal(bans)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName, data, 0600)
+}
+
+// load the unexpired bans from a file, a missing file is not an error
+// ensure lock is held before calling
+func restore(fileName string) error {
+	if fileName == "" {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var bans []*BanInfo
+	if err := json.Unmarshal(data, &bans); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, ban := range bans {
+		if !now.Before(ban.ExpiresAt) {
+			continue
+		}
+		if ban.PublicKey != "" {
+			globalData.bans[publicKeyPrefix+ban.PublicKey] = ban
+		} else if ban.Address != "" {
+			globalData.bans[addressPrefix+ban.Address] = ban
+		}
+	}
+	return nil
+}
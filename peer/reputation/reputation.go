@@ -0,0 +1,382 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reputation
+
+import (
+	"encoding/hex"
+	"math"
+	"net"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/logger"
+)
+
+const (
+	loggerCategory = "reputation"
+
+	// file in the cache directory to persist bans
+	banFile = "bans.json"
+
+	// score at which a peer is banned
+	banThreshold = 100
+
+	// length of a ban
+	banDuration = 24 * time.Hour
+
+	// points forgiven per hour of good behaviour
+	decayPerHour = 20
+
+	// scores tracked before idle ones are forgotten
+	maximumScores = 4096
+
+	// prefixes to distinguish the two kinds of key
+	publicKeyPrefix = "key:"
+	addressPrefix   = "address:"
+)
+
+// Offence - misbehaviour that lowers a peer's reputation
+type Offence int
+
+// list of offences
+const (
+	InvalidBlock     Offence = iota // block failed validation
+	InvalidDigest    Offence = iota // block digest did not match
+	Timeout          Offence = iota // request was not answered
+	WrongChain       Offence = iota // peer is on a different chain
+	SpamAnnouncement Offence = iota // announced too frequently
+	InvalidResponse  Offence = iota // reply could not be decoded
+)
+
+// points for each offence
+//
+// a peer on another chain is usually misconfigured rather than
+// hostile, so it is banned only if it keeps connecting
+var penalties = map[Offence]float64{
+	InvalidBlock:     50,
+	InvalidDigest:    50,
+	Timeout:          5,
+	WrongChain:       50,
+	SpamAnnouncement: 10,
+	InvalidResponse:  10,
+}
+
+// String - name of an offence
+func (o Offence) String() string {
+	switch o {
+	case InvalidBlock:
+		return "invalid block"
+	case InvalidDigest:
+		return "invalid digest"
+	case Timeout:
+		return "timeout"
+	case WrongChain:
+		return "wrong chain"
+	case SpamAnnouncement:
+		return "spam announcement"
+	case InvalidResponse:
+		return "invalid response"
+	default:
+		return "unknown"
+	}
+}
+
+// BanInfo - a banned public key or address
+type BanInfo struct {
+	PublicKey string    `json:"publicKey,omitempty"` // hex
+	Address   string    `json:"address,omitempty"`   // IP address
+	Reason    string    `json:"reason"`
+	BannedAt  time.Time `json:"bannedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// Reputation - interface for ban administration
+type Reputation interface {
+	Bans() []BanInfo
+	Clear(string) int
+}
+
+// accumulated points of a public key or address
+type score struct {
+	points  float64
+	updated time.Time
+}
+
+type reputationData struct {
+	sync.RWMutex
+
+	log      *logger.L
+	fileName string
+
+	scores map[string]*score
+	bans   map[string]*BanInfo
+
+	initialised bool
+}
+
+var globalData reputationData
+
+// Initialise - restore saved bans from the cache directory
+func Initialise(cacheDirectory string) error {
+	globalData.Lock()
+	defer globalData.Unlock()
+
+	if globalData.initialised {
+		return fault.AlreadyInitialised
+	}
+
+	globalData.log = logger.New(loggerCategory)
+	globalData.log.Info("starting…")
+
+	globalData.fileName = ""
+	if cacheDirectory != "" {
+		globalData.fileName = path.Join(cacheDirectory, banFile)
+	}
+	globalData.scores = make(map[string]*score)
+	globalData.bans = make(map[string]*BanInfo)
+
+	if err := restore(globalData.fileName); err != nil {
+		globalData.log.Errorf("restore bans: %q  error: %s", globalData.fileName, err)
+	}
+
+	globalData.initialised = true
+
+	return nil
+}
+
+// Finalise - save the current bans
+func Finalise() error {
+	globalData.Lock()
+	defer globalData.Unlock()
+
+	if !globalData.initialised {
+		return fault.NotInitialised
+	}
+
+	globalData.log.Info("shutting down…")
+
+	if err := backup(globalData.fileName); err != nil {
+		globalData.log.Errorf("backup bans: %q  error: %s", globalData.fileName, err)
+	}
+
+	globalData.initialised = false
+
+	globalData.log.Info("finished")
+	globalData.log.Flush()
+
+	return nil
+}
+
+// Get - return the ban administration interface
+func Get() Reputation {
+	return &globalData
+}
+
+// Penalise - record an offence by a peer identified by its public key,
+// its address or both, banning it once the score is high enough
+//
+// loopback and private addresses are shared by every peer on the host
+// or network, so only their public keys are scored
+//
+// returns true if the peer is now banned
+func Penalise(publicKey []byte, address string, offence Offence) bool {
+	globalData.Lock()
+	defer globalData.Unlock()
+
+	if !globalData.initialised {
+		return false
+	}
+
+	now := time.Now()
+	banned := false
+	added := false
+
+	for _, k := range keys(publicKey, address) {
+		if ban, ok := globalData.bans[k]; ok {
+			if now.Before(ban.ExpiresAt) {
+				banned = true
+				continue
+			}
+			delete(globalData.bans, k)
+		}
+
+		s := globalData.scores[k]
+		if s == nil {
+			if len(globalData.scores) >= maximumScores {
+				forgetIdle(now)
+			}
+			s = &score{updated: now}
+			globalData.scores[k] = s
+		}
+		s.points = decayed(s, now) + penalties[offence]
+		s.updated = now
+
+		globalData.log.Infof("penalise: %s  offence: %s  score: %.0f", k, offence, s.points)
+
+		if s.points >= banThreshold {
+			delete(globalData.scores, k)
+			globalData.bans[k] = newBan(k, offence, now)
+			globalData.log.Warnf("ban: %s  offence: %s  until: %s", k, offence, now.Add(banDuration).Format(time.RFC3339))
+			banned = true
+			added = true
+		}
+	}
+
+	if added {
+		if err := backup(globalData.fileName); err != nil {
+			globalData.log.Errorf("backup bans: %q  error: %s", globalData.fileName, err)
+		}
+	}
+
+	return banned
+}
+
+// IsBanned - check if either the public key or the address is banned
+func IsBanned(publicKey []byte, address string) bool {
+	globalData.RLock()
+	defer globalData.RUnlock()
+
+	if !globalData.initialised {
+		return false
+	}
+
+	now := time.Now()
+	for _, k := range keys(publicKey, address) {
+		if ban, ok := globalData.bans[k]; ok && now.Before(ban.ExpiresAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// Bans - list the current bans, oldest first
+func (r *reputationData) Bans() []BanInfo {
+	r.Lock()
+	defer r.Unlock()
+
+	now := time.Now()
+	bans := make([]BanInfo, 0, len(r.bans))
+	for k, ban := range r.bans {
+		if !now.Before(ban.ExpiresAt) {
+			delete(r.bans, k)
+			continue
+		}
+		bans = append(bans, *ban)
+	}
+
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].BannedAt.Before(bans[j].BannedAt)
+	})
+
+	return bans
+}
+
+// Clear - remove the ban on a hex public key or an address, an empty
+// target removes all bans
+//
+// returns the number of bans removed
+func (r *reputationData) Clear(target string) int {
+	r.Lock()
+	defer r.Unlock()
+
+	address := normaliseAddress(target)
+
+	n := 0
+	for k, ban := range r.bans {
+		if target == "" || strings.EqualFold(target, ban.PublicKey) || (address != "" && address == ban.Address) {
+			delete(r.bans, k)
+			n += 1
+		}
+	}
+
+	if n > 0 && r.log != nil {
+		r.log.Infof("cleared: %d bans  target: %q", n, target)
+		if err := backup(r.fileName); err != nil {
+			r.log.Errorf("backup bans: %q  error: %s", r.fileName, err)
+		}
+	}
+
+	return n
+}
+
+// keys identifying a peer, without a local address
+func keys(publicKey []byte, address string) []string {
+	k := make([]string, 0, 2)
+	if len(publicKey) > 0 {
+		k = append(k, publicKeyPrefix+hex.EncodeToString(publicKey))
+	}
+	if a := normaliseAddress(address); a != "" && !isLocal(a) {
+		k = append(k, addressPrefix+a)
+	}
+	return k
+}
+
+// true for an address of this host or of a private network
+func isLocal(address string) bool {
+	ip := net.ParseIP(address)
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified()
+}
+
+func newBan(k string, offence Offence, now time.Time) *BanInfo {
+	ban := &BanInfo{
+		Reason:    offence.String(),
+		BannedAt:  now.UTC(),
+		ExpiresAt: now.Add(banDuration).UTC(),
+	}
+	if strings.HasPrefix(k, publicKeyPrefix) {
+		ban.PublicKey = strings.TrimPrefix(k, publicKeyPrefix)
+	} else {
+		ban.Address = strings.TrimPrefix(k, addressPrefix)
+	}
+	return ban
+}
+
+// reduce the points for the time since the last offence, in whole
+// points so that offences in quick succession add up exactly
+func decayed(s *score, now time.Time) float64 {
+	p := s.points - math.Floor(decayPerHour*now.Sub(s.updated).Hours())
+	if p < 0 {
+		return 0
+	}
+	return p
+}
+
+// drop scores that have decayed away, or all if none have
+// ensure lock is held before calling
+func forgetIdle(now time.Time) {
+	for k, s := range globalData.scores {
+		if decayed(s, now) == 0 {
+			delete(globalData.scores, k)
+		}
+	}
+	if len(globalData.scores) >= maximumScores {
+		globalData.scores = make(map[string]*score)
+	}
+}
+
+// reduce an address to its IP, accepting "tcp://ip:port", "ip:port",
+// "[ipv6]:port" or a bare IP
+func normaliseAddress(address string) string {
+	if address == "" {
+		return ""
+	}
+	if u, err := url.Parse(address); err == nil && u.Host != "" {
+		address = u.Host
+	}
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		address = host
+	}
+	ip := net.ParseIP(strings.Trim(address, "[]"))
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reputation
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/logger"
+)
+
+const (
+	testingDirName = "testing"
+)
+
+var (
+	testPublicKey = []byte{
+		0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08,
+		0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10,
+		0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18,
+		0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20,
+	}
+	testPublicKeyHex = "0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"
+)
+
+func setupTestLogger() {
+	removeFiles()
+	_ = os.Mkdir(testingDirName, 0o700)
+
+	logging := logger.Configuration{
+		Directory: testingDirName,
+		File:      "testing.log",
+		Size:      1048576,
+		Count:     10,
+		Console:   false,
+		Levels: map[string]string{
+			logger.DefaultTag: "critical",
+		},
+	}
+
+	// start logging
+	_ = logger.Initialise(logging)
+}
+
+func teardownTestLogger() {
+	removeFiles()
+}
+
+func removeFiles() {
+	os.RemoveAll(testingDirName)
+}
+
+func TestPenaliseUntilBanned(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	err := Initialise(testingDirName)
+	assert.Nil(t, err, "wrong Initialise")
+	defer Finalise()
+
+	assert.False(t, IsBanned(testPublicKey, "tcp://192.0.2.2:2136"), "banned before any offence")
+
+	// two invalid blocks reach the threshold
+	banned := Penalise(testPublicKey, "tcp://192.0.2.2:2136", InvalidBlock)
+	assert.False(t, banned, "banned after one invalid block")
+	banned = Penalise(testPublicKey, "tcp://192.0.2.2:2136", InvalidBlock)
+	assert.True(t, banned, "not banned after two invalid blocks")
+
+	assert.True(t, IsBanned(testPublicKey, ""), "public key not banned")
+	assert.True(t, IsBanned(nil, "192.0.2.2"), "address not banned")
+	assert.True(t, IsBanned(nil, "192.0.2.2:1234"), "address with other port not banned")
+	assert.False(t, IsBanned(nil, "192.0.2.3"), "other address banned")
+
+	bans := Get().Bans()
+	assert.Equal(t, 2, len(bans), "wrong ban count")
+	for _, ban := range bans {
+		assert.Equal(t, InvalidBlock.String(), ban.Reason, "wrong reason")
+		assert.True(t, ban.ExpiresAt.After(ban.BannedAt), "wrong expiry")
+		if ban.PublicKey != "" {
+			assert.Equal(t, testPublicKeyHex, ban.PublicKey, "wrong public key")
+		} else {
+			assert.Equal(t, "192.0.2.2", ban.Address, "wrong address")
+		}
+	}
+}
+
+func TestScoresDecay(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	err := Initialise("")
+	assert.Nil(t, err, "wrong Initialise")
+	defer Finalise()
+
+	Penalise(nil, "198.51.100.1", InvalidDigest)
+
+	// pretend the offence was long ago
+	s := globalData.scores[addressPrefix+"198.51.100.1"]
+	s.updated = s.updated.Add(-3 * time.Hour)
+
+	banned := Penalise(nil, "198.51.100.1", InvalidDigest)
+	assert.False(t, banned, "banned after decay")
+
+	// a peer on a different chain is banned if it persists
+	banned = Penalise(nil, "198.51.100.2", WrongChain)
+	assert.False(t, banned, "banned for one wrong chain")
+	banned = Penalise(nil, "198.51.100.2", WrongChain)
+	assert.True(t, banned, "not banned for repeated wrong chain")
+}
+
+func TestPenaliseWhenBanExpired(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	err := Initialise("")
+	assert.Nil(t, err, "wrong Initialise")
+	defer Finalise()
+
+	Penalise(nil, "198.51.100.4", InvalidBlock)
+	Penalise(nil, "198.51.100.4", InvalidBlock)
+	assert.True(t, IsBanned(nil, "198.51.100.4"), "not banned")
+
+	globalData.bans[addressPrefix+"198.51.100.4"].ExpiresAt = time.Now().Add(-time.Minute)
+
+	// the expired ban is dropped and scoring starts again
+	banned := Penalise(nil, "198.51.100.4", Timeout)
+	assert.False(t, banned, "expired ban still active")
+	assert.Equal(t, 0, len(Get().Bans()), "expired ban listed")
+}
+
+func TestPenaliseWhenLocalAddress(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	err := Initialise("")
+	assert.Nil(t, err, "wrong Initialise")
+	defer Finalise()
+
+	for _, address := range []string{"tcp://127.0.0.1:2136", "[::1]:2136", "10.0.0.1", "192.168.1.2", "fe80::1"} {
+		Penalise(nil, address, InvalidBlock)
+		banned := Penalise(nil, address, InvalidBlock)
+		assert.False(t, banned, "local address banned: %s", address)
+		assert.False(t, IsBanned(nil, address), "local address banned: %s", address)
+	}
+
+	// the public key of a local peer is still scored
+	Penalise(testPublicKey, "127.0.0.1", InvalidBlock)
+	banned := Penalise(testPublicKey, "127.0.0.1", InvalidBlock)
+	assert.True(t, banned, "public key not banned")
+	assert.True(t, IsBanned(testPublicKey, "127.0.0.2"), "public key not banned")
+	assert.False(t, IsBanned(nil, "127.0.0.1"), "local address banned")
+}
+
+func TestClearAndPersist(t *testing.T) {
+	setupTestLogger()
+	defer teardownTestLogger()
+
+	err := Initialise(testingDirName)
+	assert.Nil(t, err, "wrong Initialise")
+
+	for i := 0; i < 2; i += 1 {
+		Penalise(testPublicKey, "", WrongChain)
+		Penalise(nil, "[2001:db8::1]:2136", WrongChain)
+		Penalise(nil, "198.51.100.3", WrongChain)
+	}
+
+	// expired bans are not restored
+	globalData.bans[addressPrefix+"198.51.100.3"].ExpiresAt = time.Now().Add(-time.Minute)
+
+	err = Finalise()
+	assert.Nil(t, err, "wrong Finalise")
+
+	assert.False(t, IsBanned(testPublicKey, ""), "banned when not initialised")
+
+	err = Initialise(testingDirName)
+	assert.Nil(t, err, "wrong Initialise")
+	defer Finalise()
+
+	assert.Equal(t, 2, len(Get().Bans()), "wrong restored ban count")
+	assert.True(t, IsBanned(testPublicKey, ""), "public key ban not restored")
+	assert.True(t, IsBanned(nil, "2001:db8::1"), "address ban not restored")
+	assert.False(t, IsBanned(nil, "198.51.100.3"), "expired ban restored")
+
+	assert.Equal(t, 0, Get().Clear("unknown"), "cleared unknown target")
+	assert.Equal(t, 1, Get().Clear("[2001:db8::1]:1"), "wrong address clear count")
+	assert.False(t, IsBanned(nil, "2001:db8::1"), "address still banned")
+
+	assert.Equal(t, 1, Get().Clear(""), "wrong clear all count")
+	assert.Equal(t, 0, len(Get().Bans()), "bans remain")
+}
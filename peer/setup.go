@@ -11,6 +11,8 @@ import (
 	"github.com/bitmark-inc/bitmarkd/announce"
 	"github.com/bitmark-inc/bitmarkd/background"
 	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/light"
+	"github.com/bitmark-inc/bitmarkd/peer/forks"
 	"github.com/bitmark-inc/bitmarkd/peer/upstream"
 	"github.com/bitmark-inc/bitmarkd/util"
 	"github.com/bitmark-inc/bitmarkd/zmqutil"
@@ -34,6 +36,17 @@ type Configuration struct {
 	PrivateKey         string       `gluamapper:"private_key" json:"private_key"`
 	PublicKey          string       `gluamapper:"public_key" json:"public_key"`
 	Connect            []Connection `gluamapper:"connect" json:"connect,omitempty"`
+
+	// private network: only the static connections and the allowed
+	// public keys may connect, and nothing is announced
+	Private bool     `gluamapper:"private" json:"private"`
+	Allow   []string `gluamapper:"allow" json:"allow,omitempty"`
+
+	// deepest automatic reorganisation, a deeper fork halts syncing
+	MaximumReorgDepth uint64 `gluamapper:"maximum_reorg_depth" json:"maximum_reorg_depth"`
+
+	// connected peers needed to synchronise, zero for the default
+	MinimumConnections int `gluamapper:"minimum_connections" json:"minimum_connections"`
 }
 
 // globals for background process
@@ -49,8 +62,11 @@ type peerData struct {
 
 	publicKey []byte
 
-	clientCount int
-	blockHeight uint64
+	allowed allowList // nil unless a private network
+
+	clientCount  int
+	blockHeight  uint64
+	headerHeight uint64
 
 	// for background
 	background *background.T
@@ -92,16 +108,28 @@ func Initialise(configuration *Configuration, version string, fastsync bool) err
 
 	globalData.publicKey = publicKey
 
+	if configuration.Private {
+		globalData.allowed, err = newAllowList(publicKey, configuration.Connect, configuration.Allow)
+		if err != nil {
+			globalData.log.Errorf("read allowed public keys error: %s", err)
+			return err
+		}
+		globalData.log.Infof("private network of: %d peers", len(globalData.allowed))
+	}
+
 	// set up announcer before any connections
 	err = setAnnounce(configuration, publicKey)
 	if err != nil {
 		return err
 	}
 
-	if err := globalData.lstn.initialise(privateKey, publicKey, configuration.Listen, version); err != nil {
-		return err
+	// a light node has no blocks to serve
+	if !light.IsEnabled() {
+		if err := globalData.lstn.initialise(privateKey, publicKey, configuration.Listen, version, globalData.allowed); err != nil {
+			return err
+		}
 	}
-	if err := globalData.conn.initialise(privateKey, publicKey, configuration.Connect, configuration.DynamicConnections, configuration.PreferIPv6, fastsync); err != nil {
+	if err := globalData.conn.initialise(privateKey, publicKey, configuration.Connect, configuration.DynamicConnections, configuration.PreferIPv6, fastsync, globalData.allowed, configuration.MaximumReorgDepth, configuration.MinimumConnections); err != nil {
 		return err
 	}
 
@@ -116,6 +144,11 @@ func Initialise(configuration *Configuration, version string, fastsync bool) err
 		&globalData.lstn,
 		&globalData.conn,
 	}
+	if light.IsEnabled() {
+		processes = background.Processes{
+			&globalData.conn,
+		}
+	}
 
 	globalData.background = background.Start(processes, globalData.log)
 
@@ -130,7 +163,7 @@ func setAnnounce(configuration *Configuration, publicKey []byte) error {
 
 process_listen:
 	for i, address := range configuration.Announce {
-		if address == "" {
+		if address == "" || light.IsEnabled() {
 			continue process_listen
 		}
 		c, err := util.NewConnection(address)
@@ -186,3 +219,35 @@ func GetCounts() (uint64, uint64) {
 func BlockHeight() uint64 {
 	return globalData.blockHeight
 }
+
+// Forks - competing chains seen from upstreams and the reorganisations
+// of the local chain
+func Forks() forks.Report {
+	if globalData.conn.forks == nil {
+		return forks.Report{}
+	}
+	return globalData.conn.forks.Report()
+}
+
+// SetMaximumReorgDepth - change the deepest automatic reorganisation
+// while running, which also resumes synchronisation halted by a
+// deeper fork
+func SetMaximumReorgDepth(depth uint64) error {
+	globalData.RLock()
+	initialised := globalData.initialised
+	globalData.RUnlock()
+
+	if !initialised {
+		return fault.NotInitialised
+	}
+	globalData.conn.forks.SetMaximumDepth(depth)
+	return nil
+}
+
+// HeaderHeight - return the height of the validated headers during
+// synchronisation, zero if headers are not being fetched
+func HeaderHeight() uint64 {
+	globalData.RLock()
+	defer globalData.RUnlock()
+	return globalData.headerHeight
+}
@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package peer
+
+import (
+	"bytes"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/peer/upstream"
+	"github.com/bitmark-inc/bitmarkd/util"
+	"github.com/bitmark-inc/bitmarkd/zmqutil"
+)
+
+// SetStaticConnections - replace the static connections while running
+//
+// unchanged connections are kept, removed ones are closed and new
+// ones are connected by the connector between its cycles.  The peers
+// of a private network cannot be changed this way.
+func SetStaticConnections(connect []Connection) error {
+	globalData.RLock()
+	initialised := globalData.initialised
+	private := globalData.allowed.IsPrivate()
+	publicKey := globalData.publicKey
+	globalData.RUnlock()
+
+	if !initialised {
+		return fault.NotInitialised
+	}
+	if private {
+		return fault.PeersFixedInPrivateNetwork
+	}
+
+	if 0 == len(connect) && !globalData.conn.dynamicEnabled {
+		return fault.NoConnectionsAvailable
+	}
+
+	// check everything before anything is changed
+	for _, c := range connect {
+		if _, err := util.NewConnection(c.Address); err != nil {
+			return err
+		}
+		serverPublicKey, err := zmqutil.ReadPublicKey(c.PublicKey)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(publicKey, serverPublicKey) {
+			return fault.ConnectingToSelfForbidden
+		}
+	}
+
+	// only the latest change is needed
+	select {
+	case <-globalData.conn.static:
+	default:
+	}
+	globalData.conn.static <- append([]Connection{}, connect...)
+
+	return nil
+}
+
+// change the static clients, called from the connector's goroutine
+func (conn *connector) setStatic(connect []Connection) {
+	log := conn.log
+
+	wanted := make(map[Connection]struct{}, len(connect))
+	for _, c := range connect {
+		wanted[c] = struct{}{}
+	}
+
+	clients := make([]upstream.Upstream, 0, len(connect))
+	connections := make([]Connection, 0, len(connect))
+	existing := make(map[Connection]struct{}, len(conn.staticConnections))
+	removed := make([]upstream.Upstream, 0)
+
+	for i, c := range conn.staticConnections {
+		client := conn.staticClients[i]
+		if _, ok := wanted[c]; ok && nil != client {
+			clients = append(clients, client)
+			connections = append(connections, c)
+			existing[c] = struct{}{}
+			continue
+		}
+		log.Infof("remove static connection: %q", c.Address)
+		if nil != client {
+			removed = append(removed, client)
+		}
+	}
+
+	for _, c := range connect {
+		if _, ok := existing[c]; ok {
+			continue
+		}
+		existing[c] = struct{}{}
+
+		address, _ := util.NewConnection(c.Address)
+		serverPublicKey, _ := zmqutil.ReadPublicKey(c.PublicKey)
+
+		client, err := upstream.New(conn.privateKey, conn.publicKey, connectorTimeout)
+		if err != nil {
+			log.Errorf("static client: %q  error: %s", c.Address, err)
+			continue
+		}
+		err = client.Connect(address, serverPublicKey)
+		if err != nil {
+			log.Errorf("connect: %q  error: %s", c.Address, err)
+			client.Destroy()
+			continue
+		}
+		log.Infof("add static connection: public key: %x  at: %q", serverPublicKey, c.Address)
+
+		clients = append(clients, client)
+		connections = append(connections, c)
+	}
+
+	conn.Lock()
+	conn.staticClients = clients
+	conn.staticConnections = connections
+	conn.Unlock()
+
+	// static clients first, as for initialise
+	globalData.Lock()
+	all := make([]upstream.Upstream, 0, len(clients)+len(globalData.connectorClients))
+	all = append(all, clients...)
+	for _, client := range globalData.connectorClients {
+		if !containsClient(clients, client) && !containsClient(removed, client) {
+			all = append(all, client)
+		}
+	}
+	globalData.connectorClients = all
+	globalData.Unlock()
+
+	for _, client := range removed {
+		client.Destroy()
+	}
+
+	// the client used for syncing may have gone
+	if 0 != len(removed) {
+		conn.nextState(cStateConnecting)
+	}
+}
+
+func containsClient(clients []upstream.Upstream, client upstream.Upstream) bool {
+	for _, c := range clients {
+		if c == client {
+			return true
+		}
+	}
+	return false
+}
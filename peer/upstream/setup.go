@@ -7,6 +7,7 @@ package upstream
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
@@ -14,9 +15,11 @@ import (
 	"github.com/bitmark-inc/bitmarkd/announce"
 	"github.com/bitmark-inc/bitmarkd/blockdigest"
 	"github.com/bitmark-inc/bitmarkd/blockheader"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
 	"github.com/bitmark-inc/bitmarkd/counter"
 	"github.com/bitmark-inc/bitmarkd/messagebus"
 	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/peer/reputation"
 	"github.com/bitmark-inc/bitmarkd/util"
 	"github.com/bitmark-inc/bitmarkd/zmqutil"
 	"github.com/bitmark-inc/logger"
@@ -32,9 +35,13 @@ type Upstream interface {
 	ConnectedTo() *zmqutil.Connected
 	Destroy()
 	GetBlockData(uint64) ([]byte, error)
+	GetBlockFilter(uint64) ([]byte, error)
+	GetBlockRange(uint64, int) ([][]byte, error)
+	GetHeaderRange(uint64, int) ([]blockrecord.PackedHeader, error)
 	IsConnectedTo([]byte) bool
 	IsConnected() bool
 	LocalHeight() uint64
+	LocateRecord([]byte) (uint64, error)
 	Name() string
 	RemoteAddr() (string, error)
 	RemoteDigestOfHeight(uint64) (blockdigest.Digest, error)
@@ -55,6 +62,8 @@ type upstreamData struct {
 	remoteHeight              uint64
 	localHeight               uint64
 	remoteDigestOfLocalHeight blockdigest.Digest
+	blockRange                uint64 // maximum blocks per range request, zero if unsupported
+	headerRange               uint64 // maximum headers per range request, zero if unsupported
 	shutdown                  chan<- struct{}
 	lastResponseTime          time.Time
 }
@@ -155,6 +164,11 @@ loop:
 		case item := <-queue:
 			log.Debugf("from queue: %q  %x", item.Command, item.Parameters)
 
+			// events for local subscribers are not sent to peers
+			if item.Command == "reorg" {
+				continue loop
+			}
+
 			u.RLock()
 			if u.connected {
 				u.RUnlock()
@@ -241,8 +255,11 @@ func (u *upstreamData) handleEvent(event zmqutil.Event, state *connectedState) {
 			err := u.requestBlockchainInfo()
 			if err == nil {
 				*state = stateConnected
+				info := u.requestServerInfo()
 				u.Lock()
 				u.connected = true
+				u.blockRange = info.BlockRange
+				u.headerRange = info.HeaderRange
 				u.Unlock()
 			} else {
 				u.log.Debugf("request peer connection error: %s", err)
@@ -291,6 +308,7 @@ func (u *upstreamData) requestBlockchainInfo() error {
 		received := string(data[1])
 		if received != chain {
 			log.Errorf("connection refused. Expected chain: %q but received: %q", chain, received)
+			reputation.Penalise(client.ServerPublicKey(), client.String(), reputation.WrongChain)
 			return fmt.Errorf("connection refused.  expected chain: %q but received: %q ", chain, received)
 		}
 		timestamp := binary.BigEndian.Uint64(data[4])
@@ -302,6 +320,49 @@ func (u *upstreamData) requestBlockchainInfo() error {
 	}
 }
 
+// server information reply, only the fields used here
+type serverInfo struct {
+	Chain       string `json:"chain"`
+	BlockRange  uint64 `json:"blockRange"`
+	HeaderRange uint64 `json:"headerRange"`
+}
+
+// ask the server for its information to find the limits of range
+// requests, older servers do not support the requests so zero limits
+// are returned on any error
+func (u *upstreamData) requestServerInfo() serverInfo {
+	log := u.log
+	client := u.client
+
+	u.RLock()
+	err := client.Send("I")
+	if err != nil {
+		u.RUnlock()
+		log.Errorf("server info: %s send error: %s", client, err)
+		return serverInfo{}
+	}
+	data, err := client.Receive(0)
+	u.RUnlock()
+
+	if err != nil {
+		log.Errorf("server info: %s receive error: %s", client, err)
+		return serverInfo{}
+	}
+	if len(data) != 2 || string(data[0]) != "I" {
+		log.Warnf("server info: %s unexpected response: %q", client, data)
+		return serverInfo{}
+	}
+
+	var info serverInfo
+	if err := json.Unmarshal(data[1], &info); err != nil {
+		log.Warnf("server info: %s decode error: %s", client, err)
+		return serverInfo{}
+	}
+
+	log.Infof("server info: block range: %d  header range: %d", info.BlockRange, info.HeaderRange)
+	return info
+}
+
 func (u *upstreamData) height() (uint64, error) {
 	log := u.log
 	client := u.client
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/mode"
 	"github.com/bitmark-inc/bitmarkd/util"
@@ -150,6 +151,189 @@ func (u *upstreamData) GetBlockData(blockNumber uint64) ([]byte, error) {
 	return nil, fault.InvalidPeerResponse
 }
 
+// GetBlockFilter - fetch the compact filter of a specific block number
+func (u *upstreamData) GetBlockFilter(blockNumber uint64) ([]byte, error) {
+
+	parameter := make([]byte, 8)
+	binary.BigEndian.PutUint64(parameter, blockNumber)
+
+	// critical section - lock out the runner process
+	u.Lock()
+	var data [][]byte
+	err := u.client.Send("F", parameter)
+	if err == nil {
+		data, err = u.client.Receive(0)
+	}
+	u.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) != 2 {
+		return nil, fault.InvalidPeerResponse
+	}
+
+	switch string(data[0]) {
+	case "E":
+		return nil, fault.BlockNotFound
+	case "F":
+		return data[1], nil
+	default:
+	}
+	return nil, fault.InvalidPeerResponse
+}
+
+// LocateRecord - fetch the number of the block holding a transaction
+// id or an asset id
+func (u *upstreamData) LocateRecord(id []byte) (uint64, error) {
+
+	// critical section - lock out the runner process
+	u.Lock()
+	var data [][]byte
+	err := u.client.Send("L", id)
+	if err == nil {
+		data, err = u.client.Receive(0)
+	}
+	u.Unlock()
+
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data) != 2 {
+		return 0, fault.InvalidPeerResponse
+	}
+
+	switch string(data[0]) {
+	case "E":
+		return 0, fault.RecordNotFound
+	case "L":
+		if len(data[1]) == 8 {
+			return binary.BigEndian.Uint64(data[1]), nil
+		}
+	default:
+	}
+	return 0, fault.InvalidPeerResponse
+}
+
+// GetBlockRange - fetch up to count consecutive blocks from a specific
+// block number
+//
+// fewer blocks are returned if the server limits the reply, a server
+// without range support returns a single block
+func (u *upstreamData) GetBlockRange(blockNumber uint64, count int) ([][]byte, error) {
+
+	u.RLock()
+	maximum := u.blockRange
+	u.RUnlock()
+
+	if maximum == 0 || count <= 1 {
+		packed, err := u.GetBlockData(blockNumber)
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{packed}, nil
+	}
+	if uint64(count) > maximum {
+		count = int(maximum)
+	}
+
+	start := make([]byte, 8)
+	binary.BigEndian.PutUint64(start, blockNumber)
+	n := make([]byte, 8)
+	binary.BigEndian.PutUint64(n, uint64(count))
+
+	// critical section - lock out the runner process
+	u.Lock()
+	var data [][]byte
+	err := u.client.Send("G", start, n)
+	if err == nil {
+		data, err = u.client.Receive(0)
+	}
+	u.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < 2 {
+		return nil, fault.InvalidPeerResponse
+	}
+
+	switch string(data[0]) {
+	case "E":
+		return nil, fault.BlockNotFound
+	case "G":
+		if len(data)-1 > count {
+			return nil, fault.InvalidPeerResponse
+		}
+		return data[1:], nil
+	default:
+	}
+	return nil, fault.InvalidPeerResponse
+}
+
+// GetHeaderRange - fetch up to count consecutive packed headers from a
+// specific block number
+//
+// fewer headers are returned if the server limits the reply
+func (u *upstreamData) GetHeaderRange(blockNumber uint64, count int) ([]blockrecord.PackedHeader, error) {
+
+	u.RLock()
+	maximum := u.headerRange
+	u.RUnlock()
+
+	if maximum == 0 {
+		return nil, fault.HeaderRangeNotSupported
+	}
+	if count < 1 {
+		count = 1
+	}
+	if uint64(count) > maximum {
+		count = int(maximum)
+	}
+
+	start := make([]byte, 8)
+	binary.BigEndian.PutUint64(start, blockNumber)
+	n := make([]byte, 8)
+	binary.BigEndian.PutUint64(n, uint64(count))
+
+	// critical section - lock out the runner process
+	u.Lock()
+	var data [][]byte
+	err := u.client.Send("P", start, n)
+	if err == nil {
+		data, err = u.client.Receive(0)
+	}
+	u.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) != 2 {
+		return nil, fault.InvalidPeerResponse
+	}
+
+	switch string(data[0]) {
+	case "E":
+		return nil, fault.BlockNotFound
+	case "P":
+		size := len(blockrecord.PackedHeader{})
+		if len(data[1]) == 0 || len(data[1])%size != 0 || len(data[1])/size > count {
+			return nil, fault.InvalidPeerResponse
+		}
+		headers := make([]blockrecord.PackedHeader, len(data[1])/size)
+		for i := range headers {
+			copy(headers[i][:], data[1][i*size:])
+		}
+		return headers, nil
+	default:
+	}
+	return nil, fault.InvalidPeerResponse
+}
+
 // must have lock held before calling
 func (u *upstreamData) RemoteHeight() (uint64, error) {
 	u.log.Infof("RemoteHeight: client: %s", u.client)
@@ -17,6 +17,8 @@ import (
 
 	"github.com/bitmark-inc/bitmarkd/announce"
 	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/peer/mocks"
 	"github.com/bitmark-inc/logger"
 )
@@ -80,7 +82,7 @@ func newTestUpstream(t *testing.T) (Upstream, *gomock.Controller, *mocks.MockCli
 
 func TestCachedRemoteDigestOfLocalHeight(t *testing.T) {
 	u, ctl, _ := newTestUpstream(t)
-	_ = announce.Initialise("test", "", net.LookupTXT)
+	_ = announce.Initialise("test", "", false, net.LookupTXT)
 	defer ctl.Finish()
 	defer teardownTestUpstreamLogger()
 	defer announce.Finalise()
@@ -191,3 +193,152 @@ func TestLocalHeight(t *testing.T) {
 	actual := u.LocalHeight()
 	assert.Equal(t, height, actual, "wrong local height")
 }
+
+func TestGetBlockRange(t *testing.T) {
+	u, ctl, mock := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	u.(*upstreamData).blockRange = 3
+
+	start := []byte{0, 0, 0, 0, 0, 0, 0, 5}
+	count := []byte{0, 0, 0, 0, 0, 0, 0, 3}
+	mock.EXPECT().Send("G", start, count).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("G"), []byte("b5"), []byte("b6")}, nil).Times(1)
+
+	// the count is limited to the server maximum
+	blocks, err := u.GetBlockRange(5, 10)
+	assert.Nil(t, err, "wrong GetBlockRange")
+	assert.Equal(t, [][]byte{[]byte("b5"), []byte("b6")}, blocks, "wrong blocks")
+}
+
+func TestGetBlockRangeWhenTooManyBlocks(t *testing.T) {
+	u, ctl, mock := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	u.(*upstreamData).blockRange = 100
+
+	mock.EXPECT().Send("G", gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("G"), []byte("b1"), []byte("b2"), []byte("b3")}, nil).Times(1)
+
+	_, err := u.GetBlockRange(1, 2)
+	assert.Equal(t, fault.InvalidPeerResponse, err, "wrong error")
+}
+
+func TestGetBlockRangeWhenNotSupported(t *testing.T) {
+	u, ctl, mock := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	start := []byte{0, 0, 0, 0, 0, 0, 0, 7}
+	mock.EXPECT().Send("B", start).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("B"), []byte("b7")}, nil).Times(1)
+
+	blocks, err := u.GetBlockRange(7, 50)
+	assert.Nil(t, err, "wrong GetBlockRange")
+	assert.Equal(t, [][]byte{[]byte("b7")}, blocks, "wrong blocks")
+}
+
+func TestGetHeaderRange(t *testing.T) {
+	u, ctl, mock := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	u.(*upstreamData).headerRange = 2000
+
+	h1 := blockrecord.PackedHeader{1}
+	h2 := blockrecord.PackedHeader{2}
+	reply := append(append([]byte{}, h1[:]...), h2[:]...)
+
+	start := []byte{0, 0, 0, 0, 0, 0, 0, 9}
+	count := []byte{0, 0, 0, 0, 0, 0, 0, 2}
+	mock.EXPECT().Send("P", start, count).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("P"), reply}, nil).Times(1)
+
+	headers, err := u.GetHeaderRange(9, 2)
+	assert.Nil(t, err, "wrong GetHeaderRange")
+	assert.Equal(t, []blockrecord.PackedHeader{h1, h2}, headers, "wrong headers")
+}
+
+func TestGetHeaderRangeWhenInvalidReply(t *testing.T) {
+	u, ctl, mock := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	u.(*upstreamData).headerRange = 2000
+
+	mock.EXPECT().Send("P", gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("P"), []byte{1, 2, 3}}, nil).Times(1)
+
+	_, err := u.GetHeaderRange(9, 2)
+	assert.Equal(t, fault.InvalidPeerResponse, err, "wrong error")
+}
+
+func TestGetHeaderRangeWhenNotSupported(t *testing.T) {
+	u, ctl, _ := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	_, err := u.GetHeaderRange(9, 2)
+	assert.Equal(t, fault.HeaderRangeNotSupported, err, "wrong error")
+}
+
+func TestGetBlockFilter(t *testing.T) {
+	u, ctl, mock := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	filter := []byte{1, 2, 3}
+	number := []byte{0, 0, 0, 0, 0, 0, 0, 9}
+	mock.EXPECT().Send("F", number).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("F"), filter}, nil).Times(1)
+
+	f, err := u.GetBlockFilter(9)
+	assert.Nil(t, err, "wrong GetBlockFilter")
+	assert.Equal(t, filter, f, "wrong filter")
+}
+
+func TestGetBlockFilterWhenNotFound(t *testing.T) {
+	u, ctl, mock := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	mock.EXPECT().Send("F", gomock.Any()).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("E"), []byte("block not found")}, nil).Times(1)
+
+	_, err := u.GetBlockFilter(9)
+	assert.Equal(t, fault.BlockNotFound, err, "wrong error")
+}
+
+func TestLocateRecord(t *testing.T) {
+	u, ctl, mock := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	id := []byte(defaultStringDigest)
+	mock.EXPECT().Send("L", id).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("L"), []byte{0, 0, 0, 0, 0, 0, 1, 2}}, nil).Times(1)
+
+	n, err := u.LocateRecord(id)
+	assert.Nil(t, err, "wrong LocateRecord")
+	assert.Equal(t, uint64(258), n, "wrong block number")
+}
+
+func TestLocateRecordWhenInvalidReply(t *testing.T) {
+	u, ctl, mock := newTestUpstream(t)
+	defer ctl.Finish()
+	defer teardownTestUpstreamLogger()
+
+	mock.EXPECT().Send("L", gomock.Any()).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("E"), []byte("record not found")}, nil).Times(1)
+
+	_, err := u.LocateRecord([]byte(defaultStringDigest))
+	assert.Equal(t, fault.RecordNotFound, err, "wrong error")
+
+	mock.EXPECT().Send("L", gomock.Any()).Return(nil).Times(1)
+	mock.EXPECT().Receive(gomock.Any()).Return([][]byte{[]byte("L"), []byte{1, 2}}, nil).Times(1)
+
+	_, err = u.LocateRecord([]byte(defaultStringDigest))
+	assert.Equal(t, fault.InvalidPeerResponse, err, "wrong error")
+}
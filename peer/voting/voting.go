@@ -44,17 +44,25 @@ type electionResult struct {
 type VotingData struct {
 	Voting
 
-	votes     records
-	minHeight uint64
-	result    *electionResult
-	log       *logger.L
+	votes      records
+	minHeight  uint64
+	minClients int
+	result     *electionResult
+	log        *logger.L
 }
 
 // NewVoting - new voting object
 func NewVoting() Voting {
+	return NewVotingWithMinimum(minimumClients)
+}
+
+// NewVotingWithMinimum - new voting object for a network expected to
+// have fewer than the usual number of clients
+func NewVotingWithMinimum(clients int) Voting {
 	return &VotingData{
-		votes:     make(records),
-		minHeight: uint64(0),
+		votes:      make(records),
+		minHeight:  uint64(0),
+		minClients: clients,
 		result: &electionResult{
 			highestNumVotes: 0,
 			winner:          nil,
@@ -173,7 +181,7 @@ func (v *VotingData) countVotes() error {
 
 func (v *VotingData) sufficientVotes() bool {
 	if !v.result.draw {
-		return v.result.highestNumVotes >= (1+minimumClients)/2
+		return v.result.highestNumVotes >= (1+v.minClients)/2
 	}
 
 	return v.sufficientVotesInDraw()
@@ -187,7 +195,7 @@ func (v *VotingData) sufficientVotesInDraw() bool {
 			drawVotes += counts
 		}
 	}
-	return drawVotes >= (1+minimumClients)/2
+	return drawVotes >= (1+v.minClients)/2
 }
 
 func (v *VotingData) updateTemporarilyVoteSummary(voters []*voters) {
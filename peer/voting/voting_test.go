@@ -86,9 +86,10 @@ func newTestVotingZmqClient(t *testing.T) (*gomock.Controller, *mocks.MockClient
 func newTestVoting() *VotingData {
 	setupTestLogger()
 	return &VotingData{
-		votes:  make(records),
-		result: &electionResult{},
-		log:    logger.New("testVoting"),
+		votes:      make(records),
+		minClients: minimumClients,
+		result:     &electionResult{},
+		log:        logger.New("testVoting"),
 	}
 }
 
@@ -236,6 +237,23 @@ func TestElectedCandidateWhenInSufficient(t *testing.T) {
 	assert.NotEqual(t, nil, err, "insufficient votes w/o error")
 }
 
+func TestElectedCandidateWhenFewerClientsExpected(t *testing.T) {
+	v := newTestVoting()
+	defer teardownTestLogger()
+	v.minClients = 2
+
+	ctl, mock := newTestVotingUpstream(t)
+	mock.EXPECT().CachedRemoteHeight().Return(testHeight).Times(1)
+	defer ctl.Finish()
+
+	v.votes[defaultDigest] = []*voters{{candidate: mock, height: testHeight}}
+
+	elected, height, err := v.ElectedCandidate()
+	assert.Nil(t, err, "insufficient votes")
+	assert.Equal(t, testHeight, height, "wrong height")
+	assert.Equal(t, mock, elected, "wrong candidate")
+}
+
 func TestElectedCandidateWhenDraw(t *testing.T) {
 	v := newTestVoting()
 	defer teardownTestLogger()
@@ -6,6 +6,7 @@
 package reservoir
 
 import (
+	"github.com/bitmark-inc/bitmarkd/chain"
 	"github.com/bitmark-inc/bitmarkd/difficulty"
 	"github.com/bitmark-inc/bitmarkd/mode"
 )
@@ -49,7 +50,9 @@ func ScaledDifficulty(count int) *difficulty.Difficulty {
 		factor = otherf
 	}
 	initialDifficulty := initialBitmarkDifficulty
-	if mode.IsTesting() {
+	if p := chain.Custom(mode.ChainName()); p != nil && p.Difficulty > 0 {
+		initialDifficulty = p.Difficulty
+	} else if mode.IsTesting() {
 		initialDifficulty = initialTestingDifficulty
 	}
 	d.Set(float64(count) * initialDifficulty * factor)
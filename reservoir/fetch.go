@@ -9,6 +9,7 @@ import (
 	"github.com/bitmark-inc/bitmarkd/asset"
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/pay"
 	"github.com/bitmark-inc/bitmarkd/storage"
 	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 	"github.com/bitmark-inc/logger"
@@ -28,7 +29,7 @@ type freeIssueIter struct {
 	run     bool
 }
 
-func newFreeIssueIter() *freeIssueIter {
+func newFreeIssueIter(pool map[pay.PayId]*issueFreeData) *freeIssueIter {
 
 	data := make(chan *issueFreeData)
 	control := make(chan struct{})
@@ -41,7 +42,7 @@ func newFreeIssueIter() *freeIssueIter {
 
 	go func(data chan<- *issueFreeData, control <-chan struct{}) {
 	loop:
-		for _, issue := range globalData.verifiedFreeIssues {
+		for _, issue := range pool {
 			if _, ok := <-control; !ok {
 				break loop
 			}
@@ -76,7 +77,7 @@ type paidIssueIter struct {
 	run     bool
 }
 
-func newPaidIssueIter() *paidIssueIter {
+func newPaidIssueIter(pool map[pay.PayId]*issuePaymentData) *paidIssueIter {
 
 	data := make(chan *issuePaymentData)
 	control := make(chan struct{})
@@ -89,7 +90,7 @@ func newPaidIssueIter() *paidIssueIter {
 
 	go func(data chan<- *issuePaymentData, control <-chan struct{}) {
 	loop:
-		for _, issue := range globalData.verifiedPaidIssues {
+		for _, issue := range pool {
 			if _, ok := <-control; !ok {
 				break loop
 			}
@@ -124,7 +125,7 @@ type transactionIter struct {
 	run     bool
 }
 
-func newTransactionIter() *transactionIter {
+func newTransactionIter(pool map[pay.PayId]*transactionData) *transactionIter {
 
 	data := make(chan *transactionData)
 	control := make(chan struct{})
@@ -137,7 +138,7 @@ func newTransactionIter() *transactionIter {
 
 	go func(data chan<- *transactionData, control <-chan struct{}) {
 	loop:
-		for _, issue := range globalData.verifiedTransactions {
+		for _, issue := range pool {
 			if _, ok := <-control; !ok {
 				break loop
 			}
@@ -164,6 +165,54 @@ func (iter *transactionIter) Close() {
 	close(iter.control)
 }
 
+// pending transactions
+
+type pendingTransactionIter struct {
+	data    <-chan *transactionPaymentData
+	control chan<- struct{}
+	run     bool
+}
+
+func newPendingTransactionIter(pool map[pay.PayId]*transactionPaymentData) *pendingTransactionIter {
+
+	data := make(chan *transactionPaymentData)
+	control := make(chan struct{})
+
+	iter := &pendingTransactionIter{
+		data:    data,
+		control: control,
+		run:     true,
+	}
+
+	go func(data chan<- *transactionPaymentData, control <-chan struct{}) {
+	loop:
+		for _, tx := range pool {
+			if _, ok := <-control; !ok {
+				break loop
+			}
+			data <- tx
+		}
+		<-control
+		iter.run = false
+		close(data)
+	}(data, control)
+
+	return iter
+}
+
+func (iter *pendingTransactionIter) Get() (*transactionPaymentData, bool) {
+	if iter.run {
+		iter.control <- struct{}{}
+		item, ok := <-iter.data
+		return item, ok
+	}
+	return nil, false
+}
+
+func (iter *pendingTransactionIter) Close() {
+	close(iter.control)
+}
+
 // FetchVerified - fetch a series of verified transactions
 func FetchVerified(count int) ([]merkle.Digest, []byte, error) {
 	if count <= 0 {
@@ -256,11 +305,11 @@ func FetchVerified(count int) ([]merkle.Digest, []byte, error) {
 
 		//----------------------------------------------------------------------------------------
 
-		freeIter := newFreeIssueIter()
+		freeIter := newFreeIssueIter(globalData.verifiedFreeIssues)
 		defer freeIter.Close()
-		paidIter := newPaidIssueIter()
+		paidIter := newPaidIssueIter(globalData.verifiedPaidIssues)
 		defer paidIter.Close()
-		transactionIter := newTransactionIter()
+		transactionIter := newTransactionIter(globalData.verifiedTransactions)
 		defer transactionIter.Close()
 
 		// output some free issues attaching the asset record before them
@@ -248,6 +248,7 @@ func storeIssues(issues []*transactionrecord.BitmarkIssue, assetHandle storage.H
 			globalData.verifiedPaidIssues[payId] = entry
 			//delete(globalData.pendingPaidIssues, payId) // not created
 			delete(globalData.orphanPayments, payId)
+			recordPaymentBlock(payId, detail, result.Payments)
 			return result, false, nil
 		}
 	}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reservoir
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// MaximumListCount - largest number of items returned by a single list
+const MaximumListCount = 100
+
+// item types for issues, transactions use their record name
+const (
+	listFreeIssue = "FreeIssue"
+	listPaidIssue = "PaidIssue"
+)
+
+// ListInfo - one pay id held in the reservoir
+type ListInfo struct {
+	PayId     pay.PayId                              `json:"payId"`
+	State     string                                 `json:"state"`
+	Type      string                                 `json:"type"`
+	TxIds     []merkle.Digest                        `json:"txIds"`
+	ExpiresAt *time.Time                             `json:"expiresAt,omitempty"`
+	Payments  []transactionrecord.PaymentAlternative `json:"payments,omitempty"`
+}
+
+// listReservoir - return up to count items with pay ids after start
+//
+// items are in pay id order so the last pay id returned can be used
+// as the start of the next call, a zero start begins at the first item
+func listReservoir(start pay.PayId, count int) ([]ListInfo, error) {
+	if count <= 0 || count > MaximumListCount {
+		return nil, fault.InvalidCount
+	}
+
+	items := make([]ListInfo, 0, count)
+
+	// only keep items after the start
+	add := func(item ListInfo) {
+		if bytes.Compare(item.PayId[:], start[:]) > 0 {
+			items = append(items, item)
+		}
+	}
+
+	globalData.RLock()
+
+	addFree := func(state TransactionState, pool map[pay.PayId]*issueFreeData) {
+		iter := newFreeIssueIter(pool)
+		defer iter.Close()
+		for {
+			issue, ok := iter.Get()
+			if !ok {
+				return
+			}
+			item := ListInfo{
+				PayId: issue.payId,
+				State: state.String(),
+				Type:  listFreeIssue,
+				TxIds: listTxIds(issue.txs),
+			}
+			if StatePending == state {
+				item.ExpiresAt = listExpiry(issue.expiresAt)
+			}
+			add(item)
+		}
+	}
+
+	addPaid := func(state TransactionState, pool map[pay.PayId]*issuePaymentData) {
+		iter := newPaidIssueIter(pool)
+		defer iter.Close()
+		for {
+			issue, ok := iter.Get()
+			if !ok {
+				return
+			}
+			item := ListInfo{
+				PayId:    issue.payId,
+				State:    state.String(),
+				Type:     listPaidIssue,
+				TxIds:    listTxIds(issue.txs),
+				Payments: issue.payments,
+			}
+			if StatePending == state {
+				item.ExpiresAt = listExpiry(issue.expiresAt)
+			}
+			add(item)
+		}
+	}
+
+	addFree(StatePending, globalData.pendingFreeIssues)
+	addPaid(StatePending, globalData.pendingPaidIssues)
+	addFree(StateVerified, globalData.verifiedFreeIssues)
+	addPaid(StateVerified, globalData.verifiedPaidIssues)
+
+	pendingIter := newPendingTransactionIter(globalData.pendingTransactions)
+pending_transactions:
+	for {
+		entry, ok := pendingIter.Get()
+		if !ok {
+			break pending_transactions
+		}
+		name, _ := transactionrecord.RecordName(entry.tx.transaction)
+		add(ListInfo{
+			PayId:     entry.payId,
+			State:     StatePending.String(),
+			Type:      name,
+			TxIds:     []merkle.Digest{entry.tx.txId},
+			ExpiresAt: listExpiry(entry.expiresAt),
+			Payments:  entry.payments,
+		})
+	}
+	pendingIter.Close()
+
+	// verified transactions are keyed by pay id but do not store it
+	verifiedIter := newTransactionIter(globalData.verifiedTransactions)
+verified_transactions:
+	for {
+		tx, ok := verifiedIter.Get()
+		if !ok {
+			break verified_transactions
+		}
+		name, _ := transactionrecord.RecordName(tx.transaction)
+		add(ListInfo{
+			PayId: globalData.verifiedIndex[tx.txId],
+			State: StateVerified.String(),
+			Type:  name,
+			TxIds: []merkle.Digest{tx.txId},
+		})
+	}
+	verifiedIter.Close()
+
+	globalData.RUnlock()
+
+	sort.Slice(items, func(i, j int) bool {
+		return bytes.Compare(items[i].PayId[:], items[j].PayId[:]) < 0
+	})
+
+	if len(items) > count {
+		items = items[:count]
+	}
+
+	return items, nil
+}
+
+// extract the tx ids of a group of records
+func listTxIds(txs []*transactionData) []merkle.Digest {
+	txIds := make([]merkle.Digest, len(txs))
+	for i, tx := range txs {
+		txIds[i] = tx.txId
+	}
+	return txIds
+}
+
+// copy of expiry time so the reply does not alias the reservoir
+func listExpiry(expiresAt time.Time) *time.Time {
+	t := expiresAt.UTC()
+	return &t
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reservoir_test
+
+import (
+	"testing"
+
+	"github.com/bitmark-inc/bitmarkd/asset"
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// a reservoir on the mock handles, returns a function to finalise it
+func setupMockReservoir(t *testing.T) func() {
+	setup(t, chain.Testing)
+	initPackages()
+
+	ctls, mockHandles, reservoirHandles := setupMocks(t)
+
+	data, _ := currencyMap.Pack(true)
+	mockHandles.blockOwnerPayment.EXPECT().Get(gomock.Any()).Return(data).AnyTimes()
+
+	packedIssue, err := assetIssuance.Pack(&owner)
+	assert.Nil(t, err, "asset pack error")
+
+	mockHandles.transaction.EXPECT().GetNB(gomock.Any()).Return(uint64(2), []byte(packedIssue)).AnyTimes()
+	mockHandles.transaction.EXPECT().Has(gomock.Any()).Return(false).AnyTimes()
+	mockHandles.ownerTx.EXPECT().Get(gomock.Any()).Return([]byte("1")).AnyTimes()
+	mockHandles.ownerData.EXPECT().Get(gomock.Any()).Return([]byte(packedOwnerData)).AnyTimes()
+
+	// ensure the reservoir uses this test's handles
+	_ = reservoir.Finalise()
+	err = reservoir.Initialise(dataDirectory, reservoirHandles, false)
+	assert.Nil(t, err, "reservoir initialise error")
+
+	return func() {
+		_ = reservoir.Finalise()
+		finaliseMockController(ctls)
+		asset.Finalise()
+		teardown()
+	}
+}
+
+func TestList(t *testing.T) {
+	finalise := setupMockReservoir(t)
+	defer finalise()
+
+	rsvr := reservoir.Get()
+
+	_, err := rsvr.List(pay.PayId{}, 0)
+	assert.Equal(t, fault.InvalidCount, err, "wrong error for zero count")
+	_, err = rsvr.List(pay.PayId{}, reservoir.MaximumListCount+1)
+	assert.Equal(t, fault.InvalidCount, err, "wrong error for large count")
+
+	items, err := rsvr.List(pay.PayId{}, 10)
+	assert.Nil(t, err, "list error")
+	assert.Equal(t, 0, len(items), "wrong empty count")
+
+	info, _, err := rsvr.StoreTransfer(&txUnratifiedData)
+	assert.Nil(t, err, "store transfer error")
+
+	items, err = rsvr.List(pay.PayId{}, 10)
+	assert.Nil(t, err, "list error")
+	assert.Equal(t, 1, len(items), "wrong item count")
+
+	item := items[0]
+	assert.Equal(t, info.Id, item.PayId, "wrong pay id")
+	assert.Equal(t, reservoir.StatePending.String(), item.State, "wrong state")
+	assert.Equal(t, "BitmarkTransferUnratified", item.Type, "wrong type")
+	assert.Equal(t, []merkle.Digest{txUnratifiedID}, item.TxIds, "wrong tx ids")
+	assert.NotNil(t, item.ExpiresAt, "missing expiry")
+	assert.Equal(t, info.Payments, item.Payments, "wrong payments")
+
+	// nothing after the last item
+	items, err = rsvr.List(item.PayId, 10)
+	assert.Nil(t, err, "list error")
+	assert.Equal(t, 0, len(items), "wrong count after last item")
+}
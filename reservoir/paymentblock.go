@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reservoir
+
+import (
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/constants"
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// the currency block that contained a payment
+type paymentBlock struct {
+	currency  currency.Currency
+	blockHash string
+}
+
+// a pay id that was verified by a payment in a known block
+type paidRecord struct {
+	block    paymentBlock
+	payments []transactionrecord.PaymentAlternative // to return a transaction to pending
+}
+
+// remember the block that verified a pay id so that it can be
+// returned to pending if that block is reorganised out
+//
+// payments without a block hash cannot be reverted and are ignored
+// ensure lock is held before calling
+func recordPaymentBlock(payId pay.PayId, detail *PaymentDetail, payments []transactionrecord.PaymentAlternative) {
+	if detail == nil || detail.BlockHash == "" {
+		return
+	}
+
+	block := paymentBlock{
+		currency:  detail.Currency,
+		blockHash: detail.BlockHash,
+	}
+
+	payIds, ok := globalData.paymentBlocks[block]
+	if !ok {
+		payIds = make(map[pay.PayId]struct{})
+		globalData.paymentBlocks[block] = payIds
+	}
+	payIds[payId] = struct{}{}
+
+	globalData.paidRecords[payId] = &paidRecord{
+		block:    block,
+		payments: payments,
+	}
+}
+
+// forget the payment block of a pay id
+// ensure lock is held before calling
+func forgetPaymentBlock(payId pay.PayId) {
+	record, ok := globalData.paidRecords[payId]
+	if !ok {
+		return
+	}
+	delete(globalData.paidRecords, payId)
+
+	if payIds, ok := globalData.paymentBlocks[record.block]; ok {
+		delete(payIds, payId)
+		if len(payIds) == 0 {
+			delete(globalData.paymentBlocks, record.block)
+		}
+	}
+}
+
+// UnverifyPaymentBlock - return every verified item paid in a currency
+// block back to pending, as the block is no longer on the currency's
+// main chain; items already mined into a bitmark block are unaffected
+//
+// the pending limits still apply, an item that no longer fits is
+// dropped as if it had expired and must be resubmitted
+//
+// returns the number of pay ids returned to pending
+func UnverifyPaymentBlock(c currency.Currency, blockHash string) int {
+	globalData.Lock()
+	defer globalData.Unlock()
+
+	block := paymentBlock{
+		currency:  c,
+		blockHash: blockHash,
+	}
+
+	// payments not yet matched to any record
+	for payId, detail := range globalData.orphanPayments {
+		if detail.Currency == c && detail.BlockHash == blockHash {
+			delete(globalData.orphanPayments, payId)
+		}
+	}
+
+	payIds, ok := globalData.paymentBlocks[block]
+	if !ok {
+		return 0
+	}
+	delete(globalData.paymentBlocks, block)
+
+	expiresAt := time.Now().Add(constants.ReservoirTimeout)
+	n := 0
+
+	for payId := range payIds {
+		record := globalData.paidRecords[payId]
+		delete(globalData.paidRecords, payId)
+
+		if tx, ok := globalData.verifiedTransactions[payId]; ok {
+			if len(globalData.pendingTransactions) >= maximumPendingTransactions {
+				internalDelete(payId)
+				globalData.log.Warnf("drop payid: %s  reorganised %s block: %s  pending transactions full", payId, c, blockHash)
+				continue
+			}
+			delete(globalData.verifiedTransactions, payId)
+			delete(globalData.verifiedIndex, tx.txId)
+
+			globalData.pendingTransactions[payId] = &transactionPaymentData{
+				tx:        tx,
+				payId:     payId,
+				payments:  record.payments,
+				expiresAt: expiresAt,
+			}
+			globalData.pendingIndex[tx.txId] = payId
+			n += 1
+
+		} else if entry, ok := globalData.verifiedPaidIssues[payId]; ok {
+			if globalData.pendingPaidCount+len(entry.txs) >= maximumPendingPaidIssues {
+				internalDelete(payId)
+				globalData.log.Warnf("drop payid: %s  reorganised %s block: %s  pending paid issues full", payId, c, blockHash)
+				continue
+			}
+			delete(globalData.verifiedPaidIssues, payId)
+			for _, tx := range entry.txs {
+				delete(globalData.verifiedIndex, tx.txId)
+				globalData.pendingIndex[tx.txId] = payId
+			}
+
+			entry.expiresAt = expiresAt
+			globalData.pendingPaidIssues[payId] = entry
+			globalData.pendingPaidCount += len(entry.txs)
+			n += 1
+		}
+
+		globalData.log.Warnf("unverify payid: %s  reorganised %s block: %s", payId, c, blockHash)
+	}
+
+	return n
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reservoir_test
+
+import (
+	"testing"
+
+	"github.com/bitmark-inc/bitmarkd/asset"
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnverifyPaymentBlock(t *testing.T) {
+	setup(t, chain.Testing)
+	defer teardown()
+
+	initPackages()
+	defer asset.Finalise()
+
+	ctls, mockHandles, reservoirHandles := setupMocks(t)
+	defer finaliseMockController(ctls)
+
+	data, _ := currencyMap.Pack(true)
+	mockHandles.blockOwnerPayment.EXPECT().Get(gomock.Any()).Return(data).AnyTimes()
+
+	packedIssue, err := assetIssuance.Pack(&owner)
+	assert.Nil(t, err, "asset pack error")
+
+	mockHandles.transaction.EXPECT().GetNB(gomock.Any()).Return(uint64(2), []byte(packedIssue)).AnyTimes()
+	mockHandles.transaction.EXPECT().Has(gomock.Any()).Return(false).AnyTimes()
+	mockHandles.ownerTx.EXPECT().Get(gomock.Any()).Return([]byte("1")).AnyTimes()
+	mockHandles.ownerData.EXPECT().Get(gomock.Any()).Return([]byte(packedOwnerData)).AnyTimes()
+
+	// ensure the reservoir uses this test's handles
+	_ = reservoir.Finalise()
+	err = reservoir.Initialise(dataDirectory, reservoirHandles, false)
+	assert.Nil(t, err, "reservoir initialise error")
+	defer reservoir.Finalise()
+
+	rsvr := reservoir.Get()
+
+	info, _, err := rsvr.StoreTransfer(&txUnratifiedData)
+	assert.Nil(t, err, "store transfer error")
+	assert.Equal(t, reservoir.StatePending, rsvr.TransactionStatus(txUnratifiedID), "wrong original state")
+
+	payment := info.Payments[0][0]
+	detail := &reservoir.PaymentDetail{
+		Currency:  payment.Currency,
+		TxID:      "paying-tx",
+		Amounts:   map[string]uint64{payment.Address: payment.Amount},
+		BlockHash: "block-one",
+	}
+	reservoir.SetTransferVerified(info.Id, detail)
+	assert.Equal(t, reservoir.StateVerified, rsvr.TransactionStatus(txUnratifiedID), "payment not verified")
+
+	// other blocks do not affect the transfer
+	assert.Equal(t, 0, reservoir.UnverifyPaymentBlock(payment.Currency, "block-two"), "wrong count for other block")
+	assert.Equal(t, reservoir.StateVerified, rsvr.TransactionStatus(txUnratifiedID), "unverified by other block")
+
+	assert.Equal(t, 1, reservoir.UnverifyPaymentBlock(payment.Currency, "block-one"), "wrong count for paying block")
+	assert.Equal(t, reservoir.StatePending, rsvr.TransactionStatus(txUnratifiedID), "not returned to pending")
+
+	// the original payments are required again
+	items, err := rsvr.List(pay.PayId{}, 10)
+	assert.Nil(t, err, "list error")
+	assert.Equal(t, 1, len(items), "wrong item count")
+	assert.Equal(t, info.Payments, items[0].Payments, "wrong payments")
+	assert.Equal(t, []string{payment.Address}, reservoir.PaymentAddresses(payment.Currency), "wrong payment addresses")
+
+	// paying again in a new block verifies once more
+	detail.BlockHash = "block-three"
+	reservoir.SetTransferVerified(info.Id, detail)
+	assert.Equal(t, reservoir.StateVerified, rsvr.TransactionStatus(txUnratifiedID), "payment not verified again")
+
+	// already reverted block has no effect
+	assert.Equal(t, 0, reservoir.UnverifyPaymentBlock(payment.Currency, "block-one"), "wrong count for reverted block")
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reservoir
+
+import (
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/storage"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// prefix for the replacement signature to keep it distinct from any
+// packed transaction
+var replacementTag = []byte("replace")
+
+// ReplacementMessage - the data that the current owner must sign to
+// authorise replacing the pending transfer txId with the transfer newTxId
+func ReplacementMessage(txId merkle.Digest, newTxId merkle.Digest) []byte {
+	message := make([]byte, 0, len(replacementTag)+len(txId)+len(newTxId))
+	message = append(message, replacementTag...)
+	message = append(message, txId[:]...)
+	message = append(message, newTxId[:]...)
+	return message
+}
+
+// replaceTransfer - replace an unpaid pending transfer with a new
+// transfer of the same link
+//
+// the signature must be made by the current owner of the link over
+// the ReplacementMessage of the old and new transaction ids
+func replaceTransfer(
+	txId merkle.Digest,
+	transfer transactionrecord.BitmarkTransfer,
+	signature account.Signature,
+	transactionHandle storage.Handle,
+	ownerTxHandle storage.Handle,
+	ownerDataHandle storage.Handle,
+	blockOwnerPaymentHandle storage.Handle,
+) (*TransferInfo, error) {
+	if transactionHandle == nil || ownerTxHandle == nil || ownerDataHandle == nil || blockOwnerPaymentHandle == nil {
+		return nil, fault.NilPointer
+	}
+
+	globalData.Lock()
+	defer globalData.Unlock()
+
+	// only a pending transfer can be replaced, once paid it is locked
+	payId, ok := globalData.pendingIndex[txId]
+	if !ok {
+		if _, ok := globalData.verifiedIndex[txId]; ok {
+			return nil, fault.TransactionAlreadyVerified
+		}
+		return nil, fault.TransactionIsNotPending
+	}
+	entry, ok := globalData.pendingTransactions[payId]
+	if !ok {
+		return nil, fault.TransactionIsNotATransfer
+	}
+	previous, ok := entry.tx.transaction.(transactionrecord.BitmarkTransfer)
+	if !ok {
+		return nil, fault.TransactionIsNotATransfer
+	}
+
+	link := transfer.GetLink()
+	if previous.GetLink() != link {
+		return nil, fault.ReplacementLinkMismatch
+	}
+
+	// release the link so that the replacement is not seen as a
+	// double transfer, restoring it if the replacement is rejected
+	delete(globalData.inProgressLinks, link)
+
+	verifyResult, _, err := verifyTransfer(transfer, transactionHandle, ownerTxHandle, ownerDataHandle)
+	if err == nil && verifyResult.txId == txId {
+		err = fault.TransactionAlreadyExists
+	}
+	if err == nil {
+		message := ReplacementMessage(txId, verifyResult.txId)
+		err = verifyResult.currentOwner.CheckSignature(message, signature)
+	}
+	if err != nil {
+		globalData.inProgressLinks[link] = txId
+		return nil, err
+	}
+
+	internalDelete(payId)
+
+	packedTransfer := verifyResult.packed
+	payments := getPayments(verifyResult.transferBlockNumber, verifyResult.issueBlockNumber, verifyResult.previousTransfer, blockOwnerPaymentHandle)
+
+	result := &TransferInfo{
+		Id:        pay.NewPayId([][]byte{packedTransfer}),
+		TxId:      verifyResult.txId,
+		IssueTxId: verifyResult.issueTxId,
+		Packed:    packedTransfer,
+		Payments:  payments,
+	}
+
+	err = insertTransfer(transfer, result)
+	if err != nil {
+		// put the original back so that a rejected replacement
+		// leaves the pending transfer as it was
+		globalData.pendingTransactions[payId] = entry
+		globalData.pendingIndex[txId] = payId
+		globalData.inProgressLinks[link] = txId
+		return nil, err
+	}
+
+	globalData.log.Infof("replaced txid: %s  with txid: %s  payid: %s", txId, result.TxId, result.Id)
+
+	return result, nil
+}
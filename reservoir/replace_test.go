@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reservoir_test
+
+import (
+	"testing"
+
+	"github.com/bitmark-inc/bitmarkd/asset"
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
+)
+
+func TestReplaceTransfer(t *testing.T) {
+	setup(t, chain.Testing)
+	defer teardown()
+
+	initPackages()
+	defer asset.Finalise()
+
+	ctls, mockHandles, reservoirHandles := setupMocks(t)
+	defer finaliseMockController(ctls)
+
+	data, _ := currencyMap.Pack(true)
+	mockHandles.blockOwnerPayment.EXPECT().Get(gomock.Any()).Return(data).AnyTimes()
+
+	packedIssue, err := assetIssuance.Pack(&owner)
+	assert.Nil(t, err, "asset pack error")
+
+	mockHandles.transaction.EXPECT().GetNB(gomock.Any()).Return(uint64(2), []byte(packedIssue)).AnyTimes()
+	mockHandles.transaction.EXPECT().Has(gomock.Any()).Return(false).AnyTimes()
+	mockHandles.ownerTx.EXPECT().Get(gomock.Any()).Return([]byte("1")).AnyTimes()
+	mockHandles.ownerData.EXPECT().Get(gomock.Any()).Return([]byte(packedOwnerData)).AnyTimes()
+
+	// ensure the reservoir uses this test's handles
+	_ = reservoir.Finalise()
+	err = reservoir.Initialise(dataDirectory, reservoirHandles, false)
+	assert.Nil(t, err, "reservoir initialise error")
+	defer reservoir.Finalise()
+
+	rsvr := reservoir.Get()
+
+	_, _, err = rsvr.StoreTransfer(&txUnratifiedData)
+	assert.Nil(t, err, "store transfer error")
+	assert.Equal(t, reservoir.StatePending, rsvr.TransactionStatus(txUnratifiedID), "wrong original state")
+
+	replacement := &transactionrecord.BitmarkTransferUnratified{
+		Link:  assetTxID,
+		Owner: &owner2,
+	}
+	packed, err := replacement.Pack(&owner)
+	assert.Equal(t, fault.InvalidSignature, err, "wrong unsigned pack error")
+	replacement.Signature = ed25519.Sign(privateKey, packed)
+	packed, err = replacement.Pack(&owner)
+	assert.Nil(t, err, "replacement pack error")
+	replacementID := packed.MakeLink()
+
+	message := reservoir.ReplacementMessage(txUnratifiedID, replacementID)
+
+	// not signed by the current owner
+	_, err = rsvr.ReplaceTransfer(txUnratifiedID, replacement, ed25519.Sign(privateKey2, message))
+	assert.Equal(t, fault.InvalidSignature, err, "wrong error for bad signature")
+	assert.Equal(t, reservoir.StatePending, rsvr.TransactionStatus(txUnratifiedID), "original was removed")
+
+	// replacing with the same transfer
+	_, err = rsvr.ReplaceTransfer(txUnratifiedID, &txUnratifiedData, ed25519.Sign(privateKey, reservoir.ReplacementMessage(txUnratifiedID, txUnratifiedID)))
+	assert.Equal(t, fault.TransactionAlreadyExists, err, "wrong error for same transfer")
+
+	info, err := rsvr.ReplaceTransfer(txUnratifiedID, replacement, ed25519.Sign(privateKey, message))
+	assert.Nil(t, err, "replace error")
+	assert.Equal(t, replacementID, info.TxId, "wrong replacement tx id")
+	assert.Equal(t, reservoir.StateUnknown, rsvr.TransactionStatus(txUnratifiedID), "original still present")
+	assert.Equal(t, reservoir.StatePending, rsvr.TransactionStatus(replacementID), "wrong replacement state")
+
+	// original is gone so cannot be replaced again
+	_, err = rsvr.ReplaceTransfer(txUnratifiedID, replacement, ed25519.Sign(privateKey, message))
+	assert.Equal(t, fault.TransactionIsNotPending, err, "wrong error for replaced transfer")
+
+	// link is now held by the replacement
+	_, _, err = rsvr.StoreTransfer(&txUnratifiedData)
+	assert.Equal(t, fault.DoubleTransferAttempt, err, "wrong error for resubmitting original")
+}
@@ -73,9 +73,10 @@ type issueFreeData struct {
 
 // PaymentDetail - a payment record for a single currency
 type PaymentDetail struct {
-	Currency currency.Currency // code number
-	TxID     string            // tx id on currency blockchain
-	Amounts  map[string]uint64 // address(Base58) → value(Satoshis)
+	Currency  currency.Currency // code number
+	TxID      string            // tx id on currency blockchain
+	Amounts   map[string]uint64 // address(Base58) → value(Satoshis)
+	BlockHash string            // currency block containing the tx, empty if unknown
 }
 
 // track the shares
@@ -89,6 +90,7 @@ type Handles struct {
 	Assets            storage.Handle
 	BlockOwnerPayment storage.Handle
 	Blocks            storage.Handle
+	BlockFilters      storage.Handle
 	Transactions      storage.Handle
 	OwnerTxIndex      storage.Handle
 	OwnerData         storage.Handle
@@ -130,6 +132,10 @@ type globalDataType struct {
 	// ***** FIX THIS: need to expire
 	orphanPayments map[pay.PayId]*PaymentDetail
 
+	// currency blocks that verified pay ids, to revert on reorganisation
+	paymentBlocks map[paymentBlock]map[pay.PayId]struct{}
+	paidRecords   map[pay.PayId]*paidRecord
+
 	// tracking the shares
 	spend map[spendKey]uint64
 
@@ -156,6 +162,18 @@ func (g *globalDataType) StoreTransfer(transfer transactionrecord.BitmarkTransfe
 	)
 }
 
+func (g *globalDataType) ReplaceTransfer(txID merkle.Digest, transfer transactionrecord.BitmarkTransfer, signature account.Signature) (*TransferInfo, error) {
+	return replaceTransfer(
+		txID,
+		transfer,
+		signature,
+		g.handles.Transactions,
+		g.handles.OwnerTxIndex,
+		g.handles.OwnerData,
+		g.handles.BlockOwnerPayment,
+	)
+}
+
 func (g *globalDataType) StoreIssues(issues []*transactionrecord.BitmarkIssue) (*IssueInfo, bool, error) {
 	return storeIssues(
 		issues,
@@ -197,15 +215,21 @@ func (g *globalDataType) StoreSwap(swap *transactionrecord.ShareSwap) (*SwapInfo
 	)
 }
 
+func (g *globalDataType) List(start pay.PayId, count int) ([]ListInfo, error) {
+	return listReservoir(start, count)
+}
+
 // Reservoir - APIs
 type Reservoir interface {
 	StoreTransfer(transactionrecord.BitmarkTransfer) (*TransferInfo, bool, error)
+	ReplaceTransfer(merkle.Digest, transactionrecord.BitmarkTransfer, account.Signature) (*TransferInfo, error)
 	StoreIssues(issues []*transactionrecord.BitmarkIssue) (*IssueInfo, bool, error)
 	TryProof(pay.PayId, []byte) TrackingStatus
 	TransactionStatus(merkle.Digest) TransactionState
 	ShareBalance(*account.Account, merkle.Digest, int) ([]BalanceInfo, error)
 	StoreGrant(*transactionrecord.ShareGrant) (*GrantInfo, bool, error)
 	StoreSwap(swap *transactionrecord.ShareSwap) (*SwapInfo, bool, error)
+	List(pay.PayId, int) ([]ListInfo, error)
 }
 
 // Get - return reservoir APIs
@@ -246,6 +270,8 @@ func Initialise(cacheDirectory string, handles Handles, autoVerify bool) error {
 	globalData.pendingPaidCount = 0
 
 	globalData.orphanPayments = make(map[pay.PayId]*PaymentDetail)
+	globalData.paymentBlocks = make(map[paymentBlock]map[pay.PayId]struct{})
+	globalData.paidRecords = make(map[pay.PayId]*paidRecord)
 
 	globalData.spend = make(map[spendKey]uint64)
 
@@ -315,6 +341,55 @@ func ReadCounters() (int, int) {
 	return pending, verified
 }
 
+// PaymentAddresses - the distinct addresses of a currency that any
+// pending record is waiting to be paid to
+func PaymentAddresses(c currency.Currency) []string {
+	globalData.RLock()
+	defer globalData.RUnlock()
+
+	seen := make(map[string]struct{})
+	addresses := make([]string, 0, 10)
+
+	add := func(alternatives []transactionrecord.PaymentAlternative) {
+		for _, alternative := range alternatives {
+			for _, payment := range alternative {
+				if payment.Currency != c {
+					continue
+				}
+				if _, ok := seen[payment.Address]; ok {
+					continue
+				}
+				seen[payment.Address] = struct{}{}
+				addresses = append(addresses, payment.Address)
+			}
+		}
+	}
+
+	for _, item := range globalData.pendingTransactions {
+		add(item.payments)
+	}
+	for _, item := range globalData.pendingPaidIssues {
+		add(item.payments)
+	}
+
+	return addresses
+}
+
+// PendingPayments - the payment alternatives a pending pay id is
+// waiting for
+func PendingPayments(payId pay.PayId) ([]transactionrecord.PaymentAlternative, error) {
+	globalData.RLock()
+	defer globalData.RUnlock()
+
+	if item, ok := globalData.pendingTransactions[payId]; ok {
+		return item.payments, nil
+	}
+	if item, ok := globalData.pendingPaidIssues[payId]; ok {
+		return item.payments, nil
+	}
+	return nil, fault.PayIdIsNotPending
+}
+
 // TransactionState - status enumeration
 type TransactionState int
 
@@ -390,6 +465,8 @@ func setVerified(payId pay.PayId, detail *PaymentDetail) bool {
 		delete(globalData.pendingIndex, txId)
 		globalData.verifiedIndex[txId] = payId
 
+		recordPaymentBlock(payId, detail, entry.payments)
+
 		return true
 	}
 
@@ -412,6 +489,8 @@ func setVerified(payId pay.PayId, detail *PaymentDetail) bool {
 			globalData.verifiedIndex[txId] = payId
 		}
 
+		recordPaymentBlock(payId, detail, entry.payments)
+
 		return true
 	}
 
@@ -643,6 +722,8 @@ func DeleteByLink(link merkle.Digest) {
 // Lock must be held before calling this
 func internalDelete(payId pay.PayId) {
 
+	forgetPaymentBlock(payId)
+
 	// pending
 
 	if entry, ok := globalData.pendingTransactions[payId]; ok {
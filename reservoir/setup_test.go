@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reservoir_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/reservoir"
+)
+
+func TestPaymentAddresses(t *testing.T) {
+	finalise := setupMockReservoir(t)
+	defer finalise()
+
+	assert.Equal(t, 0, len(reservoir.PaymentAddresses(currency.Bitcoin)), "addresses without pending items")
+
+	_, _, err := reservoir.Get().StoreTransfer(&txUnratifiedData)
+	assert.Nil(t, err, "store transfer error")
+
+	assert.Equal(t, []string{currencyMap[currency.Bitcoin]}, reservoir.PaymentAddresses(currency.Bitcoin), "wrong bitcoin payment addresses")
+	assert.Equal(t, []string{currencyMap[currency.Litecoin]}, reservoir.PaymentAddresses(currency.Litecoin), "wrong litecoin payment addresses")
+}
+
+func TestPendingPayments(t *testing.T) {
+	finalise := setupMockReservoir(t)
+	defer finalise()
+
+	info, _, err := reservoir.Get().StoreTransfer(&txUnratifiedData)
+	assert.Nil(t, err, "store transfer error")
+
+	payments, err := reservoir.PendingPayments(info.Id)
+	assert.Nil(t, err, "pending payments error")
+	assert.Equal(t, info.Payments, payments, "wrong pending payments")
+
+	_, err = reservoir.PendingPayments(pay.PayId{1})
+	assert.Equal(t, fault.PayIdIsNotPending, err, "wrong error for unknown pay id")
+}
@@ -114,6 +114,7 @@ func storeGrant(
 			delete(globalData.pendingTransactions, payId)
 			delete(globalData.pendingIndex, txId)
 			delete(globalData.orphanPayments, payId)
+			recordPaymentBlock(payId, detail, payments)
 
 			globalData.spend[spendKey] += grant.Quantity
 			result.Remaining -= grant.Quantity
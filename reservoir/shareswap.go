@@ -111,6 +111,7 @@ func storeSwap(swap *transactionrecord.ShareSwap, shareQuantityHandle storage.Ha
 			delete(globalData.pendingTransactions, payId)
 			delete(globalData.pendingIndex, txId)
 			delete(globalData.orphanPayments, payId)
+			recordPaymentBlock(payId, detail, payments)
 
 			globalData.spend[spendKeyOne] += swap.QuantityOne
 			globalData.spend[spendKeyTwo] += swap.QuantityTwo
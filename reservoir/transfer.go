@@ -33,6 +33,7 @@ type TransferInfo struct {
 type verifiedTransferInfo struct {
 	txId                merkle.Digest
 	packed              []byte
+	currentOwner        *account.Account
 	previousTransfer    transactionrecord.BitmarkTransfer
 	issueTxId           merkle.Digest
 	transferBlockNumber uint64
@@ -95,24 +96,41 @@ func storeTransfer(
 		return nil, true, fault.TransactionAlreadyExists
 	}
 
+	err = insertTransfer(transfer, result)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return result, false, nil
+}
+
+// add a verified transfer to the pending pool, or directly to the
+// verified pool if payment has already been received
+// ensure lock is held before calling
+func insertTransfer(transfer transactionrecord.BitmarkTransfer, info *TransferInfo) error {
+
+	payId := info.Id
+	txId := info.TxId
+
 	transferredItem := &transactionData{
 		txId:        txId,
 		transaction: transfer,
-		packed:      packedTransfer,
+		packed:      info.Packed,
 	}
 
 	// already received the payment for the transfer
 	// approve the transfer immediately if payment is ok
 	detail, ok := globalData.orphanPayments[payId]
 	if ok || globalData.autoVerify {
-		if acceptablePayment(detail, payments) {
+		if acceptablePayment(detail, info.Payments) {
 			globalData.verifiedTransactions[payId] = transferredItem
 			globalData.verifiedIndex[txId] = payId
 			globalData.inProgressLinks[transfer.GetLink()] = txId
 			delete(globalData.pendingTransactions, payId)
 			delete(globalData.pendingIndex, txId)
 			delete(globalData.orphanPayments, payId)
-			return result, false, nil
+			recordPaymentBlock(payId, detail, info.Payments)
+			return nil
 		}
 	}
 
@@ -120,19 +138,19 @@ func storeTransfer(
 	payment := &transactionPaymentData{
 		payId:     payId,
 		tx:        transferredItem,
-		payments:  payments,
+		payments:  info.Payments,
 		expiresAt: time.Now().Add(constants.ReservoirTimeout),
 	}
 
 	if len(globalData.pendingTransactions) >= maximumPendingTransactions {
-		return nil, false, fault.BufferCapacityLimit
+		return fault.BufferCapacityLimit
 	}
 
 	globalData.pendingTransactions[payId] = payment
 	globalData.pendingIndex[txId] = payId
 	globalData.inProgressLinks[transfer.GetLink()] = txId
 
-	return result, false, nil
+	return nil
 }
 
 // verify that a transfer is ok
@@ -280,6 +298,7 @@ func verifyTransfer(transfer transactionrecord.BitmarkTransfer, transactionHandl
 	result := &verifiedTransferInfo{
 		txId:                txId,
 		packed:              packedTransfer,
+		currentOwner:        currentOwner,
 		previousTransfer:    previousTransfer,
 		issueTxId:           ownerData.IssueTxId(),
 		transferBlockNumber: ownerData.TransferBlockNumber(),
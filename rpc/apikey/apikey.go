@@ -0,0 +1,377 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package apikey
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/sha3"
+	"golang.org/x/time/rate"
+
+	"github.com/bitmark-inc/bitmarkd/counter"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/certificate"
+)
+
+// AuthMethod - the RPC a connection uses to present its key
+const AuthMethod = "Auth.Key"
+
+// Configuration - API keys from the configuration file
+type Configuration struct {
+	Required bool               `gluamapper:"required" json:"required"` // reject requests without a key
+	KeyFile  string             `gluamapper:"key_file" json:"key_file"` // JSON list of further keys
+	Keys     []KeyConfiguration `gluamapper:"keys" json:"keys"`
+}
+
+// KeyConfiguration - a single API key
+type KeyConfiguration struct {
+	Name    string   `gluamapper:"name" json:"name"`
+	Token   string   `gluamapper:"token" json:"token"`
+	Rate    float64  `gluamapper:"rate" json:"rate"`       // requests per second, zero for no limit
+	Burst   int      `gluamapper:"burst" json:"burst"`     // defaults to the rate
+	Methods []string `gluamapper:"methods" json:"methods"` // empty for all methods
+	Clients []string `gluamapper:"clients" json:"clients"` // client certificate common names or fingerprints
+}
+
+// method groups that can be used in KeyConfiguration.Methods
+// besides "Service.Method" and "Service.*"
+var groups = map[string][]string{
+	"read": {
+		"Assets.Get",
+		"Bitmark.Provenance",
+		"Bitmark.FullProvenance",
+		"BlockOwner.TxIDForBlock",
+		"Node.*",
+		"Owner.Bitmarks",
+		"Share.Balance",
+		"Transaction.*",
+	},
+	"submit": {
+		"Bitmark.Transfer",
+		"Bitmark.Replace",
+		"Bitmarks.Create",
+		"Bitmarks.Proof",
+		"BlockOwner.Transfer",
+		"Lightning.Invoice",
+		"Share.Create",
+		"Share.Grant",
+		"Share.Swap",
+	},
+}
+
+// Keys - all configured API keys
+//
+// a nil *Keys allows every request
+type Keys struct {
+	sync.RWMutex
+	required bool
+	byToken  map[[32]byte]*Key
+	keys     []*Key
+}
+
+// Key - a single API key and its usage
+type Key struct {
+	name    string
+	limiter *rate.Limiter
+	methods []string
+	clients []string
+	usage   *usage
+}
+
+// counters of a key, kept by a reload if the key keeps its name
+type usage struct {
+	calls  counter.Counter
+	denied counter.Counter
+}
+
+// KeyUsage - counters for one key
+type KeyUsage struct {
+	Name    string   `json:"name"`
+	Rate    float64  `json:"rate"`
+	Burst   int      `json:"burst"`
+	Methods []string `json:"methods"`
+	Clients []string `json:"clients,omitempty"`
+	Calls   uint64   `json:"calls"`
+	Denied  uint64   `json:"denied"`
+}
+
+// New - create the keys from the configuration and its key file
+//
+// returns nil if no keys are configured and none are required
+func New(configuration *Configuration) (*Keys, error) {
+	list, err := readKeys(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if 0 == len(list) && !configuration.Required {
+		return nil, nil
+	}
+
+	return build(configuration.Required, list, nil)
+}
+
+// Reload - replace all keys from a new configuration
+//
+// keys whose name is unchanged keep their usage counters, any
+// connection already using a key continues with its old settings
+func (k *Keys) Reload(configuration *Configuration) error {
+	if nil == k {
+		return fault.NotInitialised
+	}
+
+	list, err := readKeys(configuration)
+	if err != nil {
+		return err
+	}
+
+	k.RLock()
+	previous := make(map[string]*usage, len(k.keys))
+	for _, key := range k.keys {
+		previous[key.name] = key.usage
+	}
+	k.RUnlock()
+
+	n, err := build(configuration.Required, list, previous)
+	if err != nil {
+		return err
+	}
+
+	k.Lock()
+	k.required = n.required
+	k.byToken = n.byToken
+	k.keys = n.keys
+	k.Unlock()
+
+	return nil
+}
+
+// the keys from the configuration followed by those in the key file
+func readKeys(configuration *Configuration) ([]KeyConfiguration, error) {
+	list := configuration.Keys
+
+	if configuration.KeyFile != "" {
+		data, err := os.ReadFile(configuration.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		var fileKeys []KeyConfiguration
+		err = json.Unmarshal(data, &fileKeys)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, fileKeys...)
+	}
+
+	return list, nil
+}
+
+// validate the key list, reusing any previous counters
+func build(required bool, list []KeyConfiguration, previous map[string]*usage) (*Keys, error) {
+	k := &Keys{
+		required: required,
+		byToken:  make(map[[32]byte]*Key),
+		keys:     make([]*Key, 0, len(list)),
+	}
+
+	names := make(map[string]struct{})
+	for _, c := range list {
+		if c.Name == "" || (c.Token == "" && 0 == len(c.Clients)) {
+			return nil, fault.MissingParameters
+		}
+		if _, ok := names[c.Name]; ok {
+			return nil, fault.DuplicateAPIKey
+		}
+		names[c.Name] = struct{}{}
+
+		digest := sha3.Sum256([]byte(c.Token))
+		if _, ok := k.byToken[digest]; ok {
+			return nil, fault.DuplicateAPIKey
+		}
+
+		methods, err := expandMethods(c.Methods)
+		if err != nil {
+			return nil, err
+		}
+
+		limit := rate.Inf
+		burst := 0
+		if c.Rate > 0 {
+			limit = rate.Limit(c.Rate)
+			burst = c.Burst
+			if burst <= 0 {
+				burst = int(c.Rate)
+			}
+			if burst < 1 {
+				burst = 1
+			}
+		}
+
+		u, ok := previous[c.Name]
+		if !ok {
+			u = &usage{}
+		}
+
+		key := &Key{
+			name:    c.Name,
+			limiter: rate.NewLimiter(limit, burst),
+			methods: methods,
+			clients: c.Clients,
+			usage:   u,
+		}
+		if c.Token != "" {
+			k.byToken[digest] = key // a key with no token is only for client certificates
+		}
+		k.keys = append(k.keys, key)
+	}
+
+	return k, nil
+}
+
+// replace groups by their methods and check the rest are
+// "Service.Method" or "Service.*"
+func expandMethods(methods []string) ([]string, error) {
+	expanded := make([]string, 0, len(methods))
+	for _, m := range methods {
+		if group, ok := groups[m]; ok {
+			expanded = append(expanded, group...)
+			continue
+		}
+		s := strings.Split(m, ".")
+		if 2 != len(s) || "" == s[0] || "" == s[1] {
+			return nil, fault.InvalidAPIKeyMethod
+		}
+		expanded = append(expanded, m)
+	}
+	return expanded, nil
+}
+
+// Lookup - find the key for a token
+//
+// an empty token is allowed, with no key, unless keys are required
+func (k *Keys) Lookup(token string) (*Key, error) {
+	if nil == k {
+		return nil, nil
+	}
+	if "" == token {
+		if k.isRequired() {
+			return nil, fault.MissingAPIKey
+		}
+		return nil, nil
+	}
+
+	k.RLock()
+	key, ok := k.byToken[sha3.Sum256([]byte(token))]
+	k.RUnlock()
+	if !ok {
+		return nil, fault.InvalidAPIKey
+	}
+	return key, nil
+}
+
+// LookupClient - find the key for a client certificate
+//
+// returns nil if there is no certificate or it has no key
+func (k *Keys) LookupClient(identity *certificate.Identity) *Key {
+	if nil == k || nil == identity {
+		return nil
+	}
+
+	k.RLock()
+	defer k.RUnlock()
+
+	for _, key := range k.keys {
+		for _, client := range key.clients {
+			if identity.Matches(client) {
+				return key
+			}
+		}
+	}
+	return nil
+}
+
+// Authorise - check a key may call a method now and count the call
+//
+// the key is nil for a request without a key
+func (k *Keys) Authorise(key *Key, serviceMethod string) error {
+	if nil == k {
+		return nil
+	}
+	if nil == key {
+		if k.isRequired() && AuthMethod != serviceMethod {
+			return fault.MissingAPIKey
+		}
+		return nil
+	}
+
+	if !key.allows(serviceMethod) {
+		key.usage.denied.Increment()
+		return fault.MethodNotAllowedForAPIKey
+	}
+	if !key.limiter.Allow() {
+		key.usage.denied.Increment()
+		return fault.RateLimiting
+	}
+
+	key.usage.calls.Increment()
+	return nil
+}
+
+func (k *Keys) isRequired() bool {
+	k.RLock()
+	defer k.RUnlock()
+	return k.required
+}
+
+// Usage - counters of all keys in configuration order
+func (k *Keys) Usage() []KeyUsage {
+	if nil == k {
+		return []KeyUsage{}
+	}
+
+	k.RLock()
+	defer k.RUnlock()
+
+	usage := make([]KeyUsage, len(k.keys))
+	for i, key := range k.keys {
+		limit := float64(key.limiter.Limit())
+		if key.limiter.Limit() == rate.Inf {
+			limit = 0
+		}
+		usage[i] = KeyUsage{
+			Name:    key.name,
+			Rate:    limit,
+			Burst:   key.limiter.Burst(),
+			Methods: key.methods,
+			Clients: key.clients,
+			Calls:   key.usage.calls.Uint64(),
+			Denied:  key.usage.denied.Uint64(),
+		}
+	}
+	return usage
+}
+
+// Name - of the key
+func (key *Key) Name() string {
+	return key.name
+}
+
+// the method is in the allowlist, every key can present itself
+func (key *Key) allows(serviceMethod string) bool {
+	if 0 == len(key.methods) || AuthMethod == serviceMethod {
+		return true
+	}
+	service := strings.Split(serviceMethod, ".")[0]
+	for _, m := range key.methods {
+		if m == serviceMethod || m == service+".*" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package apikey_test
+
+import (
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
+	"github.com/bitmark-inc/bitmarkd/rpc/certificate"
+)
+
+func testConfiguration() *apikey.Configuration {
+	return &apikey.Configuration{
+		Keys: []apikey.KeyConfiguration{
+			{
+				Name:    "reader",
+				Token:   "reader-token",
+				Methods: []string{"read"},
+			},
+			{
+				Name:    "submitter",
+				Token:   "submitter-token",
+				Rate:    1,
+				Burst:   2,
+				Methods: []string{"submit", "Node.Info"},
+			},
+		},
+	}
+}
+
+func TestNewWhenNoKeys(t *testing.T) {
+	keys, err := apikey.New(&apikey.Configuration{})
+	assert.Nil(t, err, "wrong error")
+	assert.Nil(t, keys, "wrong keys")
+
+	key, err := keys.Lookup("any")
+	assert.Nil(t, err, "wrong lookup error")
+	assert.Nil(t, key, "wrong key")
+	assert.Nil(t, keys.Authorise(nil, "Bitmark.Transfer"), "wrong authorise")
+}
+
+func TestNewWhenInvalid(t *testing.T) {
+	c := testConfiguration()
+	c.Keys[1].Token = c.Keys[0].Token
+	_, err := apikey.New(c)
+	assert.Equal(t, fault.DuplicateAPIKey, err, "wrong duplicate token error")
+
+	c = testConfiguration()
+	c.Keys[1].Name = c.Keys[0].Name
+	_, err = apikey.New(c)
+	assert.Equal(t, fault.DuplicateAPIKey, err, "wrong duplicate name error")
+
+	c = testConfiguration()
+	c.Keys[0].Token = ""
+	_, err = apikey.New(c)
+	assert.Equal(t, fault.MissingParameters, err, "wrong missing token error")
+
+	c = testConfiguration()
+	c.Keys[0].Methods = []string{"everything"}
+	_, err = apikey.New(c)
+	assert.Equal(t, fault.InvalidAPIKeyMethod, err, "wrong method error")
+}
+
+func TestNewWithKeyFile(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "keys.json")
+	err := os.WriteFile(fileName, []byte(`[{"name":"file","token":"file-token","methods":["Owner.*"]}]`), 0o600)
+	assert.Nil(t, err, "write error")
+
+	c := testConfiguration()
+	c.KeyFile = fileName
+	keys, err := apikey.New(c)
+	assert.Nil(t, err, "wrong error")
+
+	key, err := keys.Lookup("file-token")
+	assert.Nil(t, err, "wrong lookup error")
+	assert.Equal(t, "file", key.Name(), "wrong key")
+	assert.Nil(t, keys.Authorise(key, "Owner.Bitmarks"), "wrong authorise")
+	assert.Equal(t, fault.MethodNotAllowedForAPIKey, keys.Authorise(key, "Assets.Get"), "wrong authorise")
+}
+
+func TestLookup(t *testing.T) {
+	keys, err := apikey.New(testConfiguration())
+	assert.Nil(t, err, "wrong error")
+
+	key, err := keys.Lookup("reader-token")
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, "reader", key.Name(), "wrong key")
+
+	_, err = keys.Lookup("unknown")
+	assert.Equal(t, fault.InvalidAPIKey, err, "wrong error")
+
+	key, err = keys.Lookup("")
+	assert.Nil(t, err, "wrong error")
+	assert.Nil(t, key, "wrong key")
+}
+
+func TestLookupWhenRequired(t *testing.T) {
+	c := testConfiguration()
+	c.Required = true
+	keys, err := apikey.New(c)
+	assert.Nil(t, err, "wrong error")
+
+	_, err = keys.Lookup("")
+	assert.Equal(t, fault.MissingAPIKey, err, "wrong error")
+	assert.Equal(t, fault.MissingAPIKey, keys.Authorise(nil, "Node.Info"), "wrong authorise")
+	assert.Nil(t, keys.Authorise(nil, apikey.AuthMethod), "wrong authorise")
+}
+
+func TestLookupClient(t *testing.T) {
+	c := testConfiguration()
+	c.Keys = append(c.Keys, apikey.KeyConfiguration{
+		Name:    "issuer",
+		Clients: []string{"issuer.example.com", "0a0b"},
+		Methods: []string{"submit"},
+	})
+	keys, err := apikey.New(c)
+	assert.Nil(t, err, "wrong error")
+
+	key := keys.LookupClient(&certificate.Identity{CommonName: "issuer.example.com"})
+	assert.Equal(t, "issuer", key.Name(), "wrong key")
+	assert.Nil(t, keys.Authorise(key, "Bitmarks.Create"), "wrong authorise")
+
+	assert.Nil(t, keys.LookupClient(&certificate.Identity{CommonName: "other"}), "wrong other key")
+	assert.Nil(t, keys.LookupClient(nil), "wrong anonymous key")
+
+	// a key without a token cannot be presented as a token
+	_, err = keys.Lookup("")
+	assert.Nil(t, err, "wrong empty token error")
+}
+
+func TestAuthorise(t *testing.T) {
+	keys, err := apikey.New(testConfiguration())
+	assert.Nil(t, err, "wrong error")
+
+	reader, _ := keys.Lookup("reader-token")
+	assert.Nil(t, keys.Authorise(reader, "Node.Info"), "wrong service wildcard")
+	assert.Nil(t, keys.Authorise(reader, "Assets.Get"), "wrong method")
+	assert.Equal(t, fault.MethodNotAllowedForAPIKey, keys.Authorise(reader, "Bitmark.Transfer"), "wrong submit")
+
+	submitter, _ := keys.Lookup("submitter-token")
+	assert.Nil(t, keys.Authorise(submitter, "Bitmark.Transfer"), "wrong first call")
+	assert.Nil(t, keys.Authorise(submitter, "Node.Info"), "wrong second call")
+	assert.Equal(t, fault.RateLimiting, keys.Authorise(submitter, "Bitmark.Transfer"), "wrong limit")
+	assert.Equal(t, fault.MethodNotAllowedForAPIKey, keys.Authorise(submitter, "Owner.Bitmarks"), "wrong read")
+
+	usage := keys.Usage()
+	assert.Equal(t, 2, len(usage), "wrong usage count")
+	assert.Equal(t, "reader", usage[0].Name, "wrong name")
+	assert.Equal(t, uint64(2), usage[0].Calls, "wrong reader calls")
+	assert.Equal(t, uint64(1), usage[0].Denied, "wrong reader denied")
+	assert.Equal(t, float64(0), usage[0].Rate, "wrong reader rate")
+	assert.Equal(t, uint64(2), usage[1].Calls, "wrong submitter calls")
+	assert.Equal(t, uint64(2), usage[1].Denied, "wrong submitter denied")
+	assert.Equal(t, float64(1), usage[1].Rate, "wrong submitter rate")
+	assert.Equal(t, 2, usage[1].Burst, "wrong submitter burst")
+}
+
+func TestReload(t *testing.T) {
+	keys, err := apikey.New(testConfiguration())
+	assert.Nil(t, err, "wrong error")
+
+	reader, _ := keys.Lookup("reader-token")
+	assert.Nil(t, keys.Authorise(reader, "Node.Info"), "wrong authorise")
+
+	c := testConfiguration()
+	c.Required = true
+	c.Keys[0].Methods = []string{"Node.Info"}
+	c.Keys[1].Token = "new-token"
+	err = keys.Reload(c)
+	assert.Nil(t, err, "wrong reload error")
+
+	_, err = keys.Lookup("submitter-token")
+	assert.Equal(t, fault.InvalidAPIKey, err, "old token still valid")
+	_, err = keys.Lookup("")
+	assert.Equal(t, fault.MissingAPIKey, err, "wrong required")
+
+	reader, _ = keys.Lookup("reader-token")
+	assert.Nil(t, keys.Authorise(reader, "Node.Info"), "wrong authorise")
+	assert.Equal(t, fault.MethodNotAllowedForAPIKey, keys.Authorise(reader, "Assets.Get"), "wrong new methods")
+
+	// counters continue for the same name
+	usage := keys.Usage()
+	assert.Equal(t, uint64(2), usage[0].Calls, "wrong reader calls")
+	assert.Equal(t, uint64(1), usage[0].Denied, "wrong reader denied")
+
+	// an invalid configuration leaves the keys unchanged
+	c.Keys[1].Name = c.Keys[0].Name
+	err = keys.Reload(c)
+	assert.Equal(t, fault.DuplicateAPIKey, err, "wrong duplicate error")
+	key, _ := keys.Lookup("new-token")
+	assert.Equal(t, "submitter", key.Name(), "wrong key")
+}
+
+type Node struct{}
+
+type InfoArguments struct{}
+
+func (n *Node) Info(_ *InfoArguments, reply *string) error {
+	*reply = "info"
+	return nil
+}
+
+type Bitmark struct{}
+
+func (b *Bitmark) Transfer(_ *InfoArguments, reply *string) error {
+	*reply = "transfer"
+	return nil
+}
+
+func TestServerCodec(t *testing.T) {
+	keys, err := apikey.New(testConfiguration())
+	assert.Nil(t, err, "wrong error")
+
+	s := rpc.NewServer()
+	_ = s.Register(&Node{})
+	_ = s.Register(&Bitmark{})
+	_ = s.Register(apikey.NewAuth(keys))
+
+	serverConn, clientConn := net.Pipe()
+	go s.ServeCodec(apikey.NewServerCodec(jsonrpc.NewServerCodec(serverConn), keys, nil))
+
+	client := jsonrpc.NewClient(clientConn)
+	defer client.Close()
+
+	// no key yet and none is required
+	var reply string
+	err = client.Call("Bitmark.Transfer", &InfoArguments{}, &reply)
+	assert.Nil(t, err, "wrong anonymous error")
+
+	var auth apikey.AuthReply
+	err = client.Call(apikey.AuthMethod, &apikey.AuthArguments{Token: "unknown"}, &auth)
+	assert.Equal(t, fault.InvalidAPIKey.Error(), err.Error(), "wrong invalid key error")
+
+	err = client.Call(apikey.AuthMethod, &apikey.AuthArguments{Token: "reader-token"}, &auth)
+	assert.Nil(t, err, "wrong auth error")
+	assert.Equal(t, "reader", auth.Name, "wrong key name")
+
+	err = client.Call("Node.Info", &InfoArguments{}, &reply)
+	assert.Nil(t, err, "wrong read error")
+	assert.Equal(t, "info", reply, "wrong reply")
+
+	err = client.Call("Bitmark.Transfer", &InfoArguments{}, &reply)
+	assert.Equal(t, fault.MethodNotAllowedForAPIKey.Error(), err.Error(), "wrong submit error")
+
+	// connection continues after a refusal
+	err = client.Call("Node.Info", &InfoArguments{}, &reply)
+	assert.Nil(t, err, "wrong read error")
+}
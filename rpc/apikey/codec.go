@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package apikey
+
+import (
+	"net/rpc"
+)
+
+// codec to check every request of a JSON-RPC connection
+type serverCodec struct {
+	rpc.ServerCodec
+	keys          *Keys
+	key           *Key
+	serviceMethod string
+}
+
+// NewServerCodec - wrap a codec to authorise each request
+//
+// key is from the transport (e.g. an HTTP header) or nil, a call to
+// Auth.Key changes it for the rest of the connection.  A refused
+// request is answered with the error and the connection continues.
+func NewServerCodec(codec rpc.ServerCodec, keys *Keys, key *Key) rpc.ServerCodec {
+	if nil == keys {
+		return codec
+	}
+	return &serverCodec{
+		ServerCodec: codec,
+		keys:        keys,
+		key:         key,
+	}
+}
+
+func (c *serverCodec) ReadRequestHeader(r *rpc.Request) error {
+	c.serviceMethod = ""
+	err := c.ServerCodec.ReadRequestHeader(r)
+	if err == nil {
+		c.serviceMethod = r.ServiceMethod
+	}
+	return err
+}
+
+// returning an error here makes the server send it as the reply
+func (c *serverCodec) ReadRequestBody(x interface{}) error {
+	err := c.ServerCodec.ReadRequestBody(x)
+	if err != nil || nil == x {
+		return err
+	}
+
+	if AuthMethod == c.serviceMethod {
+		arguments, ok := x.(*AuthArguments)
+		if !ok {
+			return nil
+		}
+		key, err := c.keys.Lookup(arguments.Token)
+		if err != nil {
+			return err
+		}
+		c.key = key
+	}
+
+	return c.keys.Authorise(c.key, c.serviceMethod)
+}
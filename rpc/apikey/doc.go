@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package apikey - optional API keys for the client RPC
+//
+// each key is a bearer token with its own rate limit, an allowlist
+// of methods and usage counters.  The HTTPS handler and gRPC take the
+// token from the Authorization header, a TLS JSON-RPC connection
+// presents it with an Auth.Key call.  Requests without a key are
+// served as before unless keys are required.
+//
+// the per-method limits of rpc/ratelimit still apply to every key.
+package apikey
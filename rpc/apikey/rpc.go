@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package apikey
+
+// Auth - an RPC entry for presenting an API key on a connection
+//
+// the key is taken by the connection's codec, this only reports it
+type Auth struct {
+	keys *Keys
+}
+
+// AuthArguments - arguments for key RPC request
+type AuthArguments struct {
+	Token string `json:"token"`
+}
+
+// AuthReply - results from key RPC
+type AuthReply struct {
+	Name string `json:"name"`
+}
+
+// NewAuth - create the Auth RPC
+func NewAuth(keys *Keys) *Auth {
+	return &Auth{
+		keys: keys,
+	}
+}
+
+// Key - check a token and return the name of its key
+func (a *Auth) Key(arguments *AuthArguments, reply *AuthReply) error {
+	key, err := a.keys.Lookup(arguments.Token)
+	if err != nil {
+		return err
+	}
+	if nil != key {
+		reply.Name = key.Name()
+	}
+	return nil
+}
+
+// Usage - an RPC entry for API key counters
+//
+// only served on the restricted HTTPS endpoint
+type Usage struct {
+	keys *Keys
+}
+
+// UsageArguments - arguments for list RPC request
+type UsageArguments struct{}
+
+// UsageReply - results from list RPC
+type UsageReply struct {
+	Keys []KeyUsage `json:"keys"`
+}
+
+// NewUsage - create the Usage RPC
+func NewUsage(keys *Keys) *Usage {
+	return &Usage{
+		keys: keys,
+	}
+}
+
+// List - usage of every key
+func (u *Usage) List(_ *UsageArguments, reply *UsageReply) error {
+	reply.Keys = u.keys.Usage()
+	return nil
+}
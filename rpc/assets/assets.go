@@ -87,7 +87,8 @@ func Register(assets []*transactionrecord.AssetData, pool storage.Handle) ([]Sta
 
 // GetArguments - arguments for RPC request
 type GetArguments struct {
-	Fingerprints []string `json:"fingerprints"`
+	Fingerprints []string                            `json:"fingerprints"`
+	Ids          []transactionrecord.AssetIdentifier `json:"ids,omitempty"`
 }
 
 // GetReply - results from get RPC request
@@ -107,7 +108,7 @@ type Record struct {
 func (assets *Assets) Get(arguments *GetArguments, reply *GetReply) error {
 
 	log := assets.Log
-	count := len(arguments.Fingerprints)
+	count := len(arguments.Fingerprints) + len(arguments.Ids)
 
 	if err := ratelimit.LimitN(assets.Limiter, count, maximumAssets); err != nil {
 		return err
@@ -119,11 +120,15 @@ func (assets *Assets) Get(arguments *GetArguments, reply *GetReply) error {
 
 	log.Infof("Assets.Get: %+v", arguments)
 
+	assetIds := make([]transactionrecord.AssetIdentifier, 0, count)
+	for _, fingerprint := range arguments.Fingerprints {
+		assetIds = append(assetIds, transactionrecord.NewAssetIdentifier([]byte(fingerprint)))
+	}
+	assetIds = append(assetIds, arguments.Ids...)
+
 	a := make([]Record, count)
 loop:
-	for i, fingerprint := range arguments.Fingerprints {
-
-		assetId := transactionrecord.NewAssetIdentifier([]byte(fingerprint))
+	for i, assetId := range assetIds {
 
 		confirmed := true
 		_, packedAsset := assets.Pool.GetNB(assetId[:])
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bans
+
+import (
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/peer/reputation"
+	"github.com/bitmark-inc/bitmarkd/rpc/ratelimit"
+	"github.com/bitmark-inc/logger"
+	"golang.org/x/time/rate"
+)
+
+// Bans
+// ----
+
+const (
+	rateLimitBans = 10
+	rateBurstBans = 20
+)
+
+// Bans - an RPC entry for administering banned peers
+//
+// only served on the restricted HTTPS endpoint
+type Bans struct {
+	Log     *logger.L
+	Limiter *rate.Limiter
+	Rep     reputation.Reputation
+}
+
+// ListArguments - arguments for list RPC request
+type ListArguments struct{}
+
+// ListReply - results from list RPC
+type ListReply struct {
+	Bans []reputation.BanInfo `json:"bans"`
+}
+
+// ClearArguments - arguments for clear RPC request
+type ClearArguments struct {
+	Target string `json:"target"` // hex public key, address or "*" for all
+}
+
+// ClearReply - results from clear RPC
+type ClearReply struct {
+	Cleared int `json:"cleared"`
+}
+
+func New(log *logger.L, rep reputation.Reputation) *Bans {
+	return &Bans{
+		Log:     log,
+		Limiter: rate.NewLimiter(rateLimitBans, rateBurstBans),
+		Rep:     rep,
+	}
+}
+
+// List - current bans, oldest first
+func (b *Bans) List(arguments *ListArguments, reply *ListReply) error {
+	if err := ratelimit.Limit(b.Limiter); err != nil {
+		return err
+	}
+
+	if b.Rep == nil {
+		return fault.NotInitialised
+	}
+
+	reply.Bans = b.Rep.Bans()
+	return nil
+}
+
+// Clear - remove the bans on a public key or address
+func (b *Bans) Clear(arguments *ClearArguments, reply *ClearReply) error {
+	if err := ratelimit.Limit(b.Limiter); err != nil {
+		return err
+	}
+
+	if b.Rep == nil {
+		return fault.NotInitialised
+	}
+
+	if arguments == nil || arguments.Target == "" {
+		return fault.MissingParameters
+	}
+
+	b.Log.Infof("Bans.Clear: %+v", arguments)
+
+	target := arguments.Target
+	if target == "*" {
+		target = ""
+	}
+
+	reply.Cleared = b.Rep.Clear(target)
+	return nil
+}
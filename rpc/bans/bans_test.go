@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bans_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/peer/reputation"
+	"github.com/bitmark-inc/bitmarkd/rpc/bans"
+	"github.com/bitmark-inc/bitmarkd/rpc/fixtures"
+	"github.com/bitmark-inc/bitmarkd/rpc/mocks"
+	"github.com/bitmark-inc/logger"
+)
+
+func TestBansList(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	r := mocks.NewMockReputation(ctl)
+
+	now := time.Now().UTC()
+	list := []reputation.BanInfo{
+		{
+			Address:   "192.0.2.1",
+			Reason:    reputation.WrongChain.String(),
+			BannedAt:  now,
+			ExpiresAt: now.Add(time.Hour),
+		},
+	}
+	r.EXPECT().Bans().Return(list).Times(1)
+
+	b := bans.New(logger.New(fixtures.LogCategory), r)
+
+	var reply bans.ListReply
+	err := b.List(&bans.ListArguments{}, &reply)
+	assert.Nil(t, err, "wrong List")
+	assert.Equal(t, list, reply.Bans, "wrong bans")
+}
+
+func TestBansClear(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	r := mocks.NewMockReputation(ctl)
+	r.EXPECT().Clear("192.0.2.1").Return(1).Times(1)
+	r.EXPECT().Clear("").Return(3).Times(1)
+
+	b := bans.New(logger.New(fixtures.LogCategory), r)
+
+	var reply bans.ClearReply
+	err := b.Clear(&bans.ClearArguments{Target: "192.0.2.1"}, &reply)
+	assert.Nil(t, err, "wrong Clear")
+	assert.Equal(t, 1, reply.Cleared, "wrong cleared count")
+
+	err = b.Clear(&bans.ClearArguments{Target: "*"}, &reply)
+	assert.Nil(t, err, "wrong Clear all")
+	assert.Equal(t, 3, reply.Cleared, "wrong cleared all count")
+
+	err = b.Clear(&bans.ClearArguments{}, &reply)
+	assert.Equal(t, fault.MissingParameters, err, "wrong error for missing target")
+}
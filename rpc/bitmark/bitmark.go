@@ -10,6 +10,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/bitmark-inc/bitmarkd/account"
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/messagebus"
@@ -140,6 +141,76 @@ func (bitmark *Bitmark) Transfer(arguments *transactionrecord.BitmarkTransferCou
 	return nil
 }
 
+// Replace an unpaid pending transfer
+// ----------------------------------
+
+// ReplaceArguments - arguments for replace RPC
+type ReplaceArguments struct {
+	TxId      merkle.Digest                                   `json:"txId"`
+	Transfer  *transactionrecord.BitmarkTransferCountersigned `json:"transfer"`
+	Signature account.Signature                               `json:"signature"`
+}
+
+// Replace - replace a pending transfer that has not been paid with a
+// new transfer of the same bitmark, signed by the current owner
+func (bitmark *Bitmark) Replace(arguments *ReplaceArguments, reply *TransferReply) error {
+	if err := ratelimit.Limit(bitmark.Limiter); err != nil {
+		return err
+	}
+	if bitmark.ReadOnly {
+		return fault.NotAvailableInReadOnlyMode
+	}
+
+	log := bitmark.Log
+
+	if arguments == nil || arguments.Transfer == nil || arguments.Transfer.Owner == nil {
+		return fault.InvalidItem
+	}
+
+	log.Infof("Bitmark.Replace: %+v", arguments)
+
+	if !bitmark.IsNormalMode(mode.Normal) {
+		return fault.NotAvailableDuringSynchronise
+	}
+
+	if arguments.Transfer.Owner.IsTesting() != bitmark.IsTestingChain() {
+		return fault.WrongNetworkForPublicKey
+	}
+
+	transfer := transactionrecord.BitmarkTransfer(arguments.Transfer)
+
+	// for unratified transfers
+	if len(arguments.Transfer.Countersignature) == 0 {
+		transfer = &transactionrecord.BitmarkTransferUnratified{
+			Link:      arguments.Transfer.Link,
+			Escrow:    arguments.Transfer.Escrow,
+			Owner:     arguments.Transfer.Owner,
+			Signature: arguments.Transfer.Signature,
+		}
+	}
+
+	stored, err := bitmark.Rsvr.ReplaceTransfer(arguments.TxId, transfer, arguments.Signature)
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("replaced: %v  by: %v", arguments.TxId, stored.TxId)
+	reply.TxId = stored.TxId
+	reply.BitmarkId = stored.IssueTxId
+	reply.PayId = stored.Id
+	reply.Payments = make(map[string]transactionrecord.PaymentAlternative)
+
+	for _, payment := range stored.Payments {
+		c := payment[0].Currency.String()
+		reply.Payments[c] = payment
+	}
+
+	// announce replacement to other peers
+	messagebus.Bus.Broadcast.Send("replace", arguments.TxId[:], stored.Packed, arguments.Signature)
+
+	return nil
+}
+
 // Trace the history of a property
 // -------------------------------
 
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package certificate
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/logger"
+)
+
+// ClientConfiguration - client certificates accepted by a listener
+type ClientConfiguration struct {
+	Required     bool     `gluamapper:"required" json:"required"`         // refuse connections without a client certificate
+	Authorities  string   `gluamapper:"authorities" json:"authorities"`   // PEM bundle of client CA certificates
+	Fingerprints []string `gluamapper:"fingerprints" json:"fingerprints"` // SHA3-256 hex of pinned client certificates
+}
+
+// Identity - an authenticated client certificate
+type Identity struct {
+	CommonName  string
+	Fingerprint [32]byte
+}
+
+// SetClient - add client certificate verification to a listener
+//
+// a certificate must be signed by one of the authorities and, if any
+// fingerprints are given, must also be one of the pinned certificates
+func SetClient(log *logger.L, name string, tlsConfiguration *tls.Config, client *ClientConfiguration) error {
+	if "" == strings.TrimSpace(client.Authorities) && 0 == len(client.Fingerprints) {
+		if client.Required {
+			log.Errorf("%s client certificates required without authorities or fingerprints", name)
+			return fault.MissingParameters
+		}
+		return nil
+	}
+
+	pinned := make(map[[32]byte]struct{})
+	for _, f := range client.Fingerprints {
+		b, err := hex.DecodeString(strings.TrimSpace(f))
+		if err != nil || 32 != len(b) {
+			log.Errorf("%s invalid client fingerprint: %q", name, f)
+			return fault.InvalidFingerprint
+		}
+		var fin [32]byte
+		copy(fin[:], b)
+		pinned[fin] = struct{}{}
+	}
+
+	if "" != strings.TrimSpace(client.Authorities) {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(client.Authorities)) {
+			log.Errorf("%s failed to load client certificate authorities", name)
+			return fault.InvalidCertificateAuthority
+		}
+		tlsConfiguration.ClientCAs = pool
+		tlsConfiguration.ClientAuth = tls.VerifyClientCertIfGiven
+		if client.Required {
+			tlsConfiguration.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	} else {
+		tlsConfiguration.ClientAuth = tls.RequestClientCert
+		if client.Required {
+			tlsConfiguration.ClientAuth = tls.RequireAnyClientCert
+		}
+	}
+
+	if 0 != len(pinned) {
+		tlsConfiguration.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			if 0 == len(rawCerts) {
+				return nil
+			}
+			if _, ok := pinned[fingerprint(rawCerts[0])]; !ok {
+				return fault.ClientCertificateNotPinned
+			}
+			return nil
+		}
+	}
+
+	log.Infof("%s client certificates: required: %t  pinned: %d", name, client.Required, len(pinned))
+
+	return nil
+}
+
+// ClientIdentity - the client certificate of a connection
+//
+// returns nil if the client did not present a certificate, any
+// certificate present has passed the checks set by SetClient
+func ClientIdentity(state *tls.ConnectionState) *Identity {
+	if nil == state || 0 == len(state.PeerCertificates) {
+		return nil
+	}
+	leaf := state.PeerCertificates[0]
+	return &Identity{
+		CommonName:  leaf.Subject.CommonName,
+		Fingerprint: fingerprint(leaf.Raw),
+	}
+}
+
+// Matches - name is the common name or the hex fingerprint
+func (id *Identity) Matches(name string) bool {
+	if nil == id || "" == name {
+		return false
+	}
+	if name == id.CommonName {
+		return true
+	}
+	return strings.EqualFold(name, hex.EncodeToString(id.Fingerprint[:]))
+}
+
+func (id *Identity) String() string {
+	if nil == id {
+		return "anonymous"
+	}
+	return fmt.Sprintf("%q (%x)", id.CommonName, id.Fingerprint)
+}
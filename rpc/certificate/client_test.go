@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package certificate_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/certificate"
+	"github.com/bitmark-inc/bitmarkd/rpc/fixtures"
+	"github.com/bitmark-inc/logger"
+)
+
+// a CA and a client certificate signed by it
+type testClient struct {
+	authority   string
+	certificate tls.Certificate
+}
+
+func newTestClient(t *testing.T, commonName string) testClient {
+	caPublic, caPrivate, _ := ed25519.GenerateKey(rand.Reader)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caPublic, caPrivate)
+	assert.Nil(t, err, "ca error")
+	ca, _ := x509.ParseCertificate(caDER)
+
+	public, private, _ := ed25519.GenerateKey(rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, public, caPrivate)
+	assert.Nil(t, err, "certificate error")
+
+	return testClient{
+		authority: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER})),
+		certificate: tls.Certificate{
+			Certificate: [][]byte{der},
+			PrivateKey:  private,
+		},
+	}
+}
+
+func serverConfig(t *testing.T) *tls.Config {
+	wd, _ := os.Getwd()
+	fixtureDir := path.Join(filepath.Dir(wd), "fixtures")
+	tlsConfig, _, err := certificate.Get(
+		logger.New(fixtures.LogCategory),
+		"test",
+		fixtures.Certificate(fixtureDir),
+		fixtures.Key(fixtureDir),
+	)
+	assert.Nil(t, err, "wrong Get")
+	return tlsConfig
+}
+
+// handshake and return the client identity seen by the server
+func handshake(serverConfig *tls.Config, client []tls.Certificate) (*certificate.Identity, error) {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	go func() {
+		c := tls.Client(clientConn, &tls.Config{
+			InsecureSkipVerify: true,
+			Certificates:       client,
+		})
+		_ = c.Handshake()
+		// TLS 1.3 reports a rejected certificate after the handshake
+		_, _ = c.Read(make([]byte, 1))
+	}()
+
+	s := tls.Server(serverConn, serverConfig)
+	err := s.Handshake()
+	if err != nil {
+		return nil, err
+	}
+	state := s.ConnectionState()
+	return certificate.ClientIdentity(&state), nil
+}
+
+func TestSetClientWithAuthorities(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	client := newTestClient(t, "issuer")
+	other := newTestClient(t, "other")
+
+	tlsConfig := serverConfig(t)
+	err := certificate.SetClient(logger.New(fixtures.LogCategory), "test", tlsConfig, &certificate.ClientConfiguration{
+		Required:    true,
+		Authorities: client.authority,
+	})
+	assert.Nil(t, err, "wrong SetClient")
+
+	identity, err := handshake(tlsConfig, []tls.Certificate{client.certificate})
+	assert.Nil(t, err, "wrong handshake")
+	assert.Equal(t, "issuer", identity.CommonName, "wrong common name")
+	assert.Equal(t, sha3.Sum256(client.certificate.Certificate[0]), identity.Fingerprint, "wrong fingerprint")
+	assert.True(t, identity.Matches("issuer"), "common name not matched")
+	assert.True(t, identity.Matches(hex.EncodeToString(identity.Fingerprint[:])), "fingerprint not matched")
+	assert.False(t, identity.Matches("other"), "wrong match")
+
+	_, err = handshake(tlsConfig, []tls.Certificate{other.certificate})
+	assert.NotNil(t, err, "certificate from another authority accepted")
+
+	_, err = handshake(tlsConfig, nil)
+	assert.NotNil(t, err, "missing certificate accepted")
+}
+
+func TestSetClientWithFingerprints(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	client := newTestClient(t, "issuer")
+	other := newTestClient(t, "other")
+	pinned := sha3.Sum256(client.certificate.Certificate[0])
+
+	tlsConfig := serverConfig(t)
+	err := certificate.SetClient(logger.New(fixtures.LogCategory), "test", tlsConfig, &certificate.ClientConfiguration{
+		Fingerprints: []string{hex.EncodeToString(pinned[:])},
+	})
+	assert.Nil(t, err, "wrong SetClient")
+
+	identity, err := handshake(tlsConfig, []tls.Certificate{client.certificate})
+	assert.Nil(t, err, "wrong handshake")
+	assert.Equal(t, pinned, identity.Fingerprint, "wrong fingerprint")
+
+	_, err = handshake(tlsConfig, []tls.Certificate{other.certificate})
+	assert.NotNil(t, err, "certificate not pinned accepted")
+
+	// not required
+	identity, err = handshake(tlsConfig, nil)
+	assert.Nil(t, err, "wrong handshake")
+	assert.Nil(t, identity, "wrong identity")
+}
+
+func TestSetClientWhenInvalid(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	log := logger.New(fixtures.LogCategory)
+
+	err := certificate.SetClient(log, "test", &tls.Config{}, &certificate.ClientConfiguration{})
+	assert.Nil(t, err, "wrong disabled")
+
+	err = certificate.SetClient(log, "test", &tls.Config{}, &certificate.ClientConfiguration{Required: true})
+	assert.Equal(t, fault.MissingParameters, err, "wrong required")
+
+	err = certificate.SetClient(log, "test", &tls.Config{}, &certificate.ClientConfiguration{Authorities: "not a certificate"})
+	assert.Equal(t, fault.InvalidCertificateAuthority, err, "wrong authorities")
+
+	err = certificate.SetClient(log, "test", &tls.Config{}, &certificate.ClientConfiguration{Fingerprints: []string{"0123"}})
+	assert.Equal(t, fault.InvalidFingerprint, err, "wrong fingerprint")
+}
@@ -16,6 +16,7 @@ import (
 	"net/rpc/jsonrpc"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/bitmark-inc/bitmarkd/announce"
@@ -26,6 +27,8 @@ import (
 	"github.com/bitmark-inc/bitmarkd/mode"
 	"github.com/bitmark-inc/bitmarkd/peer"
 	"github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
+	"github.com/bitmark-inc/bitmarkd/rpc/certificate"
 	"github.com/bitmark-inc/bitmarkd/rpc/node"
 	"github.com/bitmark-inc/bitmarkd/storage"
 	"github.com/bitmark-inc/bitmarkd/util"
@@ -61,16 +64,26 @@ type Handler interface {
 	RPC(http.ResponseWriter, *http.Request)
 	Details(http.ResponseWriter, *http.Request)
 	Connections(http.ResponseWriter, *http.Request)
+	Reservoir(http.ResponseWriter, *http.Request)
+	Bans(http.ResponseWriter, *http.Request)
+	Keys(http.ResponseWriter, *http.Request)
 	Root(http.ResponseWriter, *http.Request)
+	REST(http.ResponseWriter, *http.Request)
 	SetAllow(allow map[string][]*net.IPNet)
+	SetRestricted(api string, server *rpc.Server)
+	SetKeys(keys *apikey.Keys)
 }
 
 type handler struct {
+	sync.RWMutex // protects allow, which a reload can change
+
 	log                *logger.L
 	server             *rpc.Server
+	restricted         map[string]*rpc.Server
 	start              time.Time
 	version            string
 	allow              map[string][]*net.IPNet
+	keys               *apikey.Keys
 	maximumConnections uint64
 }
 
@@ -91,7 +104,22 @@ func New(
 }
 
 func (h *handler) SetAllow(allow map[string][]*net.IPNet) {
+	h.Lock()
 	h.allow = allow
+	h.Unlock()
+}
+
+// SetRestricted - server for an access controlled api
+func (h *handler) SetRestricted(api string, server *rpc.Server) {
+	if h.restricted == nil {
+		h.restricted = make(map[string]*rpc.Server)
+	}
+	h.restricted[api] = server
+}
+
+// SetKeys - API keys for the normal RPC and REST
+func (h *handler) SetKeys(keys *apikey.Keys) {
+	h.keys = keys
 }
 
 // global atomic connection counter
@@ -110,8 +138,58 @@ func (h *handler) RPC(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	server := h.server
+	key, err := h.lookupKey(r)
+	if err != nil {
+		sendUnauthorized(w, err)
+		return
+	}
+
+	h.serve(w, r, h.server, key)
+}
+
+// performs a call to a restricted RPC to inspect the reservoir
+// (restricted to local_allow)
+func (h *handler) Reservoir(w http.ResponseWriter, r *http.Request) {
+	h.serveRestricted("reservoir", w, r)
+}
+
+// performs a call to a restricted RPC to list or clear peer bans
+// (restricted to local_allow)
+func (h *handler) Bans(w http.ResponseWriter, r *http.Request) {
+	h.serveRestricted("bans", w, r)
+}
+
+// performs a call to a restricted RPC to list API key usage
+// (restricted to local_allow)
+func (h *handler) Keys(w http.ResponseWriter, r *http.Request) {
+	h.serveRestricted("keys", w, r)
+}
+
+// serve a restricted RPC to allowed addresses only
+func (h *handler) serveRestricted(api string, w http.ResponseWriter, r *http.Request) {
+	if http.MethodPost != r.Method {
+		sendMethodNotAllowed(w)
+		return
+	}
+
+	if !h.isAllowed(api, r) {
+		h.log.Warnf("Deny access: %q", r.RemoteAddr)
+		sendForbidden(w)
+		return
+	}
+
+	server, ok := h.restricted[api]
+	if !ok {
+		sendNotFound(w)
+		return
+	}
 
+	h.serve(w, r, server, nil)
+}
+
+// serve a single JSON RPC request
+// only the normal server is subject to API keys
+func (h *handler) serve(w http.ResponseWriter, r *http.Request, server *rpc.Server, key *apikey.Key) {
 	if connectionCountHTTPS.Increment() > h.maximumConnections {
 		connectionCountHTTPS.Decrement()
 		sendTooManyRequests(w)
@@ -120,6 +198,9 @@ func (h *handler) RPC(w http.ResponseWriter, r *http.Request) {
 	defer connectionCountHTTPS.Decrement()
 
 	serverCodec := jsonrpc.NewServerCodec(&InternalConnection{in: r.Body, out: w})
+	if server == h.server {
+		serverCodec = apikey.NewServerCodec(serverCodec, h.keys, key)
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.Header().Set("X-Content-Type-Options", "nosniff")
@@ -131,6 +212,32 @@ func (h *handler) RPC(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// key from the bearer token or, without a token, from the client
+// certificate
+func (h *handler) lookupKey(r *http.Request) (*apikey.Key, error) {
+	token := bearerToken(r)
+	if "" == token {
+		identity := certificate.ClientIdentity(r.TLS)
+		if nil != identity {
+			h.log.Debugf("https client: %s identity: %s", r.RemoteAddr, identity)
+		}
+		if key := h.keys.LookupClient(identity); nil != key {
+			return key, nil
+		}
+	}
+	return h.keys.Lookup(token)
+}
+
+// token from an "Authorization: Bearer <token>" header
+func bearerToken(r *http.Request) string {
+	const prefix = "Bearer "
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) <= len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(authorization[len(prefix):])
+}
+
 // check if remote address is allowed
 func (h *handler) isAllowed(api string, r *http.Request) bool {
 	last := strings.LastIndex(r.RemoteAddr, ":")
@@ -138,7 +245,9 @@ func (h *handler) isAllowed(api string, r *http.Request) bool {
 		return false
 	}
 
+	h.RLock()
 	cidr, ok := h.allow[api]
+	h.RUnlock()
 	if !ok {
 		return false
 	}
@@ -378,6 +487,10 @@ func sendForbidden(w http.ResponseWriter) {
 func sendTooManyRequests(w http.ResponseWriter) {
 	sendError(w, "Too Many Requests", http.StatusTooManyRequests)
 }
+func sendUnauthorized(w http.ResponseWriter, err error) {
+	w.Header().Set("WWW-Authenticate", "Bearer")
+	sendError(w, err.Error(), http.StatusUnauthorized)
+}
 func sendInternalServerError(w http.ResponseWriter) {
 	sendError(w, "internal server error", http.StatusInternalServerError)
 }
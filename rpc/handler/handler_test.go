@@ -206,7 +206,7 @@ func TestPeers(t *testing.T) {
 	defer fixtures.TeardownTestLogger()
 
 	f := func(string) ([]string, error) { return []string{}, nil }
-	announce.Initialise("domain", "cache dir", f)
+	announce.Initialise("domain", "cache dir", false, f)
 	defer announce.Finalise()
 
 	s := rpc.NewServer()
@@ -528,3 +528,149 @@ func TestDetailsWhenTooManyConnections(t *testing.T) {
 	_ = json.NewDecoder(resp.Body).Decode(&j)
 	assert.Equal(t, tooManyRequests, j.Error, "wrong method")
 }
+
+func TestReservoir(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := handler.New(
+		logger.New(fixtures.LogCategory),
+		rpc.NewServer(),
+		time.Now(),
+		"1.0",
+		uint64(5),
+	)
+
+	r := rpc.NewServer()
+	a := Add{}
+	_ = r.Register(a)
+	h.SetRestricted("reservoir", r)
+
+	allow := make(map[string][]*net.IPNet)
+	_, ipNet, _ := net.ParseCIDR("192.0.2.1/32")
+	allow["reservoir"] = []*net.IPNet{ipNet}
+	h.SetAllow(allow)
+
+	add := AddArg{
+		A: 3,
+		B: 4,
+	}
+
+	arg := jReq{
+		ID:     7,
+		Method: "Add.Add",
+		Params: []AddArg{add},
+	}
+	data, _ := json.Marshal(arg)
+
+	req := httptest.NewRequest("POST", "http://not.exist", bytes.NewReader(data))
+	w := httptest.NewRecorder()
+	h.Reservoir(w, req)
+
+	resp := w.Result()
+	var j jResp
+	_ = json.NewDecoder(resp.Body).Decode(&j)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "wrong status code")
+	assert.Equal(t, add.A+add.B, j.Result, "wrong result")
+	assert.Nil(t, j.Error, "wrong error")
+}
+
+func TestReservoirWhenWrongHTTPMethod(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := handler.New(
+		logger.New(fixtures.LogCategory),
+		rpc.NewServer(),
+		time.Now(),
+		"1.0",
+		uint64(5),
+	)
+	h.SetRestricted("reservoir", rpc.NewServer())
+
+	req := httptest.NewRequest("GET", "http://not.exist", http.NoBody)
+	w := httptest.NewRecorder()
+	h.Reservoir(w, req)
+
+	resp := w.Result()
+	var j eResp
+	_ = json.NewDecoder(resp.Body).Decode(&j)
+	assert.Equal(t, notAllowed, j.Error, "wrong method")
+}
+
+func TestReservoirWhenNotAllow(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := handler.New(
+		logger.New(fixtures.LogCategory),
+		rpc.NewServer(),
+		time.Now(),
+		"1.0",
+		uint64(5),
+	)
+	h.SetRestricted("reservoir", rpc.NewServer())
+
+	req := httptest.NewRequest("POST", "http://test.com", http.NoBody)
+	w := httptest.NewRecorder()
+	h.Reservoir(w, req)
+
+	resp := w.Result()
+	var j eResp
+	_ = json.NewDecoder(resp.Body).Decode(&j)
+	assert.Equal(t, "forbidden", j.Error, "wrong not allow")
+}
+
+func TestBans(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := handler.New(
+		logger.New(fixtures.LogCategory),
+		rpc.NewServer(),
+		time.Now(),
+		"1.0",
+		uint64(5),
+	)
+
+	r := rpc.NewServer()
+	a := Add{}
+	_ = r.Register(a)
+	h.SetRestricted("bans", r)
+
+	allow := make(map[string][]*net.IPNet)
+	_, ipNet, _ := net.ParseCIDR("192.0.2.1/32")
+	allow["reservoir"] = []*net.IPNet{ipNet}
+	h.SetAllow(allow)
+
+	arg := jReq{
+		ID:     7,
+		Method: "Add.Add",
+		Params: []AddArg{{A: 1, B: 2}},
+	}
+	data, _ := json.Marshal(arg)
+
+	// reservoir access does not grant bans access
+	req := httptest.NewRequest("POST", "http://not.exist", bytes.NewReader(data))
+	w := httptest.NewRecorder()
+	h.Bans(w, req)
+
+	resp := w.Result()
+	var e eResp
+	_ = json.NewDecoder(resp.Body).Decode(&e)
+	assert.Equal(t, "forbidden", e.Error, "wrong not allow")
+
+	allow["bans"] = []*net.IPNet{ipNet}
+	h.SetAllow(allow)
+
+	req = httptest.NewRequest("POST", "http://not.exist", bytes.NewReader(data))
+	w = httptest.NewRecorder()
+	h.Bans(w, req)
+
+	resp = w.Result()
+	var j jResp
+	_ = json.NewDecoder(resp.Body).Decode(&j)
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "wrong status code")
+	assert.Equal(t, 3, j.Result, "wrong result")
+	assert.Nil(t, j.Error, "wrong error")
+}
@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
+	"github.com/bitmark-inc/bitmarkd/rpc/assets"
+	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
+	"github.com/bitmark-inc/bitmarkd/rpc/owner"
+	"github.com/bitmark-inc/bitmarkd/rpc/server"
+	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// all REST resources are below this path
+const restPrefix = "/v1/"
+
+// limit the size of a POSTed transfer
+const maximumRESTBodySize = 65536
+
+// HTTP status for errors returned by the RPC implementations
+// any other RPC error is considered to be an internal server error
+var restErrorStatus = map[string]int{
+	fault.InvalidCount.Error():                          http.StatusBadRequest,
+	fault.InvalidCurrencyAddress.Error():                http.StatusBadRequest,
+	fault.InvalidItem.Error():                           http.StatusBadRequest,
+	fault.InvalidOwnerOrRegistrant.Error():              http.StatusBadRequest,
+	fault.InvalidPaymentVersion.Error():                 http.StatusBadRequest,
+	fault.InvalidSignature.Error():                      http.StatusBadRequest,
+	fault.SignatureTooLong.Error():                      http.StatusBadRequest,
+	fault.TransactionIsNotAnIssue.Error():               http.StatusBadRequest,
+	fault.TransactionLinksToSelf.Error():                http.StatusBadRequest,
+	fault.WrongNetworkForPublicKey.Error():              http.StatusBadRequest,
+	fault.InvalidAPIKey.Error():                         http.StatusUnauthorized,
+	fault.MissingAPIKey.Error():                         http.StatusUnauthorized,
+	fault.MethodNotAllowedForAPIKey.Error():             http.StatusForbidden,
+	fault.AccountIsNotWatched.Error():                   http.StatusNotFound,
+	fault.AssetNotFound.Error():                         http.StatusNotFound,
+	fault.LinkToInvalidOrUnconfirmedTransaction.Error(): http.StatusNotFound,
+	fault.RecordNotFound.Error():                        http.StatusNotFound,
+	fault.TransactionIsNotConfirmed.Error():             http.StatusNotFound,
+	fault.NotAvailableInReadOnlyMode.Error():            http.StatusForbidden,
+	fault.TransactionAlreadyExists.Error():              http.StatusConflict,
+	fault.DoubleTransferAttempt.Error():                 http.StatusConflict,
+	fault.RateLimiting.Error():                          http.StatusTooManyRequests,
+	fault.BufferCapacityLimit.Error():                   http.StatusServiceUnavailable,
+	fault.NotAvailableDuringSynchronise.Error():         http.StatusServiceUnavailable,
+}
+
+// REST - resource oriented access to the public RPCs
+//
+// resources:
+//
+//	GET  /v1/bitmarks/{txId}             [provenance, query: count=<int> default: 10]
+//	GET  /v1/assets/{assetId}            [a single asset record]
+//	GET  /v1/accounts/{account}/bitmarks [query: start=<int> count=<int> default: 0, 10]
+//	GET  /v1/tx/{txId}/status            [pending, verified or confirmed]
+//	POST /v1/transfers                   [body: countersigned transfer JSON]
+func (h *handler) REST(w http.ResponseWriter, r *http.Request) {
+	path := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, restPrefix), "/"), "/")
+
+	var serve func(http.ResponseWriter, *http.Request, []string, *apikey.Key)
+	method := http.MethodGet
+
+	switch {
+	case 2 == len(path) && "bitmarks" == path[0]:
+		serve = h.restProvenance
+	case 2 == len(path) && "assets" == path[0]:
+		serve = h.restAsset
+	case 3 == len(path) && "accounts" == path[0] && "bitmarks" == path[2]:
+		serve = h.restAccountBitmarks
+	case 3 == len(path) && "tx" == path[0] && "status" == path[2]:
+		serve = h.restTransactionStatus
+	case 1 == len(path) && "transfers" == path[0]:
+		serve = h.restTransfer
+		method = http.MethodPost
+	default:
+		sendNotFound(w)
+		return
+	}
+
+	if method != r.Method {
+		sendMethodNotAllowed(w)
+		return
+	}
+
+	key, err := h.lookupKey(r)
+	if err != nil {
+		sendUnauthorized(w, err)
+		return
+	}
+
+	if connectionCountHTTPS.Increment() > h.maximumConnections {
+		connectionCountHTTPS.Decrement()
+		sendTooManyRequests(w)
+		return
+	}
+	defer connectionCountHTTPS.Decrement()
+
+	serve(w, r, path, key)
+}
+
+// GET /v1/bitmarks/{txId}
+func (h *handler) restProvenance(w http.ResponseWriter, r *http.Request, path []string, key *apikey.Key) {
+	var txId merkle.Digest
+	if err := txId.UnmarshalText([]byte(path[1])); err != nil {
+		sendBadRequest(w, err)
+		return
+	}
+
+	count, err := queryInt(r, "count", defaultCount)
+	if err != nil {
+		sendBadRequest(w, err)
+		return
+	}
+
+	arguments := bitmark.ProvenanceArguments{
+		TxId:  txId,
+		Count: count,
+	}
+	var reply bitmark.ProvenanceReply
+	if err := h.call(key, "Bitmark.Provenance", &arguments, &reply); err != nil {
+		sendRESTError(w, err)
+		return
+	}
+
+	if 0 == len(reply.Data) {
+		sendRESTError(w, fault.RecordNotFound)
+		return
+	}
+
+	sendReply(w, reply)
+}
+
+// GET /v1/assets/{assetId}
+func (h *handler) restAsset(w http.ResponseWriter, _ *http.Request, path []string, key *apikey.Key) {
+	var assetId transactionrecord.AssetIdentifier
+	if err := assetId.UnmarshalText([]byte(path[1])); err != nil {
+		sendBadRequest(w, err)
+		return
+	}
+
+	arguments := assets.GetArguments{
+		Ids: []transactionrecord.AssetIdentifier{assetId},
+	}
+	var reply assets.GetReply
+	if err := h.call(key, "Assets.Get", &arguments, &reply); err != nil {
+		sendRESTError(w, err)
+		return
+	}
+
+	// missing assets are returned as empty records
+	if 1 != len(reply.Assets) || "" == reply.Assets[0].Record {
+		sendRESTError(w, fault.AssetNotFound)
+		return
+	}
+
+	sendReply(w, reply.Assets[0])
+}
+
+// GET /v1/accounts/{account}/bitmarks
+func (h *handler) restAccountBitmarks(w http.ResponseWriter, r *http.Request, path []string, key *apikey.Key) {
+	acct, err := account.AccountFromBase58(path[1])
+	if err != nil {
+		sendBadRequest(w, err)
+		return
+	}
+
+	start, err := queryInt(r, "start", 0)
+	if err != nil {
+		sendBadRequest(w, err)
+		return
+	}
+	count, err := queryInt(r, "count", defaultCount)
+	if err != nil {
+		sendBadRequest(w, err)
+		return
+	}
+	if start < 0 {
+		sendBadRequest(w, fault.InvalidCount)
+		return
+	}
+
+	arguments := owner.BitmarksArguments{
+		Owner: acct,
+		Start: uint64(start),
+		Count: count,
+	}
+	var reply json.RawMessage
+	if err := h.call(key, "Owner.Bitmarks", &arguments, &reply); err != nil {
+		sendRESTError(w, err)
+		return
+	}
+
+	sendReply(w, reply)
+}
+
+// GET /v1/tx/{txId}/status
+func (h *handler) restTransactionStatus(w http.ResponseWriter, _ *http.Request, path []string, key *apikey.Key) {
+	var txId merkle.Digest
+	if err := txId.UnmarshalText([]byte(path[1])); err != nil {
+		sendBadRequest(w, err)
+		return
+	}
+
+	arguments := transaction.Arguments{
+		TxId: txId,
+	}
+	var reply transaction.StatusReply
+	if err := h.call(key, "Transaction.Status", &arguments, &reply); err != nil {
+		sendRESTError(w, err)
+		return
+	}
+
+	sendReply(w, reply)
+}
+
+// POST /v1/transfers
+func (h *handler) restTransfer(w http.ResponseWriter, r *http.Request, _ []string, key *apikey.Key) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maximumRESTBodySize+1))
+	if err != nil || len(body) > maximumRESTBodySize {
+		sendBadRequest(w, fault.InvalidItem)
+		return
+	}
+
+	var transfer transactionrecord.BitmarkTransferCountersigned
+	if err := json.Unmarshal(body, &transfer); err != nil {
+		sendBadRequest(w, fault.InvalidItem)
+		return
+	}
+
+	var reply json.RawMessage
+	if err := h.call(key, "Bitmark.Transfer", &transfer, &reply); err != nil {
+		sendRESTError(w, err)
+		return
+	}
+
+	sendReply(w, reply)
+}
+
+// call an RPC on the normal server, if the key allows, and decode its result
+func (h *handler) call(key *apikey.Key, serviceMethod string, arguments interface{}, reply interface{}) error {
+	err := h.keys.Authorise(key, serviceMethod)
+	if err != nil {
+		return err
+	}
+
+	request, err := json.Marshal(arguments)
+	if err != nil {
+		return err
+	}
+
+	result, err := server.Call(h.server, serviceMethod, request)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(result, reply)
+}
+
+// read an optional integer query parameter
+func queryInt(r *http.Request, name string, defaultValue int) (int, error) {
+	s := r.URL.Query().Get(name)
+	if "" == s {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fault.InvalidCount
+	}
+	return n, nil
+}
+
+// invalid path, query or body
+func sendBadRequest(w http.ResponseWriter, err error) {
+	sendError(w, err.Error(), http.StatusBadRequest)
+}
+
+// map an error from an RPC call to the HTTP status code for its JSON body
+func sendRESTError(w http.ResponseWriter, err error) {
+	if code, ok := restErrorStatus[err.Error()]; ok {
+		if http.StatusUnauthorized == code {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+		}
+		sendError(w, err.Error(), code)
+		return
+	}
+	sendInternalServerError(w)
+}
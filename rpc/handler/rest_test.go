@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/rpc"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/assets"
+	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
+	"github.com/bitmark-inc/bitmarkd/rpc/fixtures"
+	"github.com/bitmark-inc/bitmarkd/rpc/handler"
+	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+	"github.com/bitmark-inc/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	restTxId = "2dc8770718b01f0205ad991bfb4c052f02677cff60e65d596e890cb6ed82c861"
+)
+
+// fake RPC services with the same names as the real ones
+type restBitmark struct {
+	provenanceErr error
+	transferErr   error
+}
+
+func (b *restBitmark) Provenance(arguments *bitmark.ProvenanceArguments, reply *bitmark.ProvenanceReply) error {
+	if nil != b.provenanceErr {
+		return b.provenanceErr
+	}
+	for i := 0; i < arguments.Count; i += 1 {
+		reply.Data = append(reply.Data, bitmark.ProvenanceRecord{
+			Record: "BitmarkTransferUnratified",
+			TxId:   arguments.TxId,
+		})
+	}
+	return nil
+}
+
+func (b *restBitmark) Transfer(arguments *json.RawMessage, reply *bitmark.TransferReply) error {
+	return b.transferErr
+}
+
+type restAssets struct{}
+
+func (a *restAssets) Get(arguments *assets.GetArguments, reply *assets.GetReply) error {
+	reply.Assets = make([]assets.Record, len(arguments.Ids))
+	return nil
+}
+
+type restTransaction struct{}
+
+func (t *restTransaction) Status(arguments *transaction.Arguments, reply *transaction.StatusReply) error {
+	reply.Status = "Confirmed"
+	return nil
+}
+
+func newRESTHandler(b *restBitmark) handler.Handler {
+	s := rpc.NewServer()
+	_ = s.RegisterName("Bitmark", b)
+	_ = s.RegisterName("Assets", &restAssets{})
+	_ = s.RegisterName("Transaction", &restTransaction{})
+
+	return handler.New(
+		logger.New(fixtures.LogCategory),
+		s,
+		time.Now(),
+		"1.0",
+		uint64(5),
+	)
+}
+
+func restRequest(h handler.Handler, method string, url string, body string) (*http.Response, []byte) {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	h.REST(w, req)
+
+	resp := w.Result()
+	buffer := bytes.Buffer{}
+	_, _ = buffer.ReadFrom(resp.Body)
+	return resp, buffer.Bytes()
+}
+
+func TestRESTProvenance(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := newRESTHandler(&restBitmark{})
+
+	resp, body := restRequest(h, http.MethodGet, "http://not.exist/v1/bitmarks/"+restTxId+"?count=3", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "wrong status code")
+
+	var reply bitmark.ProvenanceReply
+	err := json.Unmarshal(body, &reply)
+	assert.Nil(t, err, "wrong unmarshal")
+	assert.Equal(t, 3, len(reply.Data), "wrong provenance count")
+	assert.Equal(t, restTxId, reply.Data[0].TxId, "wrong tx id")
+}
+
+func TestRESTProvenanceWhenRPCError(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := newRESTHandler(&restBitmark{provenanceErr: fault.RateLimiting})
+
+	resp, body := restRequest(h, http.MethodGet, "http://not.exist/v1/bitmarks/"+restTxId, "")
+
+	var j eResp
+	_ = json.Unmarshal(body, &j)
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode, "wrong status code")
+	assert.Equal(t, http.StatusTooManyRequests, j.Code, "wrong error code")
+	assert.Equal(t, fault.RateLimiting.Error(), j.Error, "wrong error")
+}
+
+func TestRESTProvenanceWhenUnmappedRPCError(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := newRESTHandler(&restBitmark{provenanceErr: fault.NilPointer})
+
+	resp, _ := restRequest(h, http.MethodGet, "http://not.exist/v1/bitmarks/"+restTxId, "")
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode, "wrong status code")
+
+	h = newRESTHandler(&restBitmark{provenanceErr: fault.InvalidCount})
+
+	resp, _ = restRequest(h, http.MethodGet, "http://not.exist/v1/bitmarks/"+restTxId, "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "wrong status code")
+}
+
+func TestRESTProvenanceWhenInvalidTxId(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := newRESTHandler(&restBitmark{})
+
+	resp, _ := restRequest(h, http.MethodGet, "http://not.exist/v1/bitmarks/1234", "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "wrong status code")
+
+	resp, _ = restRequest(h, http.MethodGet, "http://not.exist/v1/bitmarks/"+restTxId+"?count=x", "")
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "wrong status code")
+}
+
+func TestRESTAssetWhenNotFound(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := newRESTHandler(&restBitmark{})
+
+	assetId := transactionrecord.NewAssetIdentifier([]byte("fingerprint"))
+	resp, body := restRequest(h, http.MethodGet, "http://not.exist/v1/assets/"+assetId.String(), "")
+
+	var j eResp
+	_ = json.Unmarshal(body, &j)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "wrong status code")
+	assert.Equal(t, fault.AssetNotFound.Error(), j.Error, "wrong error")
+}
+
+func TestRESTTransactionStatus(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := newRESTHandler(&restBitmark{})
+
+	resp, body := restRequest(h, http.MethodGet, "http://not.exist/v1/tx/"+restTxId+"/status", "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode, "wrong status code")
+
+	var reply transaction.StatusReply
+	_ = json.Unmarshal(body, &reply)
+	assert.Equal(t, "Confirmed", reply.Status, "wrong status")
+}
+
+func TestRESTTransfer(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := newRESTHandler(&restBitmark{transferErr: fault.NotAvailableInReadOnlyMode})
+
+	resp, _ := restRequest(h, http.MethodPost, "http://not.exist/v1/transfers", `{"link":"`+restTxId+`"}`)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode, "wrong status code")
+
+	resp, _ = restRequest(h, http.MethodPost, "http://not.exist/v1/transfers", `{"link":`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "wrong status code")
+
+	resp, _ = restRequest(h, http.MethodPost, "http://not.exist/v1/transfers", `{"link":"1234"}`)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "wrong status code")
+}
+
+func TestRESTTransferWhenRPCError(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	items := []struct {
+		err  error
+		code int
+	}{
+		{nil, http.StatusOK},
+		{fault.InvalidCurrencyAddress, http.StatusBadRequest},
+		{fault.InvalidPaymentVersion, http.StatusBadRequest},
+		{fault.TransactionIsNotAnIssue, http.StatusBadRequest},
+		{fault.DoubleTransferAttempt, http.StatusConflict},
+		{fault.BufferCapacityLimit, http.StatusServiceUnavailable},
+		{fault.NilPointer, http.StatusInternalServerError},
+	}
+
+	for _, item := range items {
+		h := newRESTHandler(&restBitmark{transferErr: item.err})
+
+		resp, _ := restRequest(h, http.MethodPost, "http://not.exist/v1/transfers", `{"link":"`+restTxId+`"}`)
+		assert.Equal(t, item.code, resp.StatusCode, "wrong status code for: %v", item.err)
+	}
+}
+
+func TestRESTWhenWrongPathOrMethod(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	h := newRESTHandler(&restBitmark{})
+
+	resp, _ := restRequest(h, http.MethodGet, "http://not.exist/v1/transfers", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode, "wrong status code")
+
+	resp, _ = restRequest(h, http.MethodPost, "http://not.exist/v1/tx/"+restTxId+"/status", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode, "wrong status code")
+
+	resp, _ = restRequest(h, http.MethodGet, "http://not.exist/v1/blocks/1", "")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode, "wrong status code")
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package lightning
+
+import (
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/payment"
+	"github.com/bitmark-inc/bitmarkd/rpc/ratelimit"
+	"github.com/bitmark-inc/logger"
+	"golang.org/x/time/rate"
+)
+
+// Lightning
+// ---------
+
+const (
+	rateLimitLightning = 10
+	rateBurstLightning = 20
+)
+
+// Lightning - an RPC entry for paying by Lightning invoice
+type Lightning struct {
+	Log      *logger.L
+	Limiter  *rate.Limiter
+	Invoicer func(pay.PayId) (*payment.Invoice, error)
+	ReadOnly bool
+}
+
+// InvoiceArguments - arguments for invoice RPC request
+type InvoiceArguments struct {
+	PayId pay.PayId `json:"payId"`
+}
+
+// InvoiceReply - results from invoice RPC
+type InvoiceReply struct {
+	payment.Invoice
+}
+
+func New(log *logger.L, invoicer func(pay.PayId) (*payment.Invoice, error), readOnly bool) *Lightning {
+	return &Lightning{
+		Log:      log,
+		Limiter:  rate.NewLimiter(rateLimitLightning, rateBurstLightning),
+		Invoicer: invoicer,
+		ReadOnly: readOnly,
+	}
+}
+
+// Invoice - BOLT11 invoice settling the Bitcoin payment of a pending pay id
+func (l *Lightning) Invoice(arguments *InvoiceArguments, reply *InvoiceReply) error {
+	if l.ReadOnly {
+		return fault.NotAvailableInReadOnlyMode
+	}
+
+	if err := ratelimit.Limit(l.Limiter); err != nil {
+		return err
+	}
+
+	if arguments == nil {
+		return fault.InvalidItem
+	}
+
+	l.Log.Infof("Lightning.Invoice: %+v", arguments)
+
+	invoice, err := l.Invoicer(arguments.PayId)
+	if err != nil {
+		return err
+	}
+
+	reply.Invoice = *invoice
+	return nil
+}
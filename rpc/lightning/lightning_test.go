@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package lightning_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	"github.com/bitmark-inc/bitmarkd/payment"
+	"github.com/bitmark-inc/bitmarkd/rpc/fixtures"
+	"github.com/bitmark-inc/bitmarkd/rpc/lightning"
+	"github.com/bitmark-inc/logger"
+)
+
+func TestLightningInvoice(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	invoice := &payment.Invoice{
+		PayId:          pay.PayId{1, 2},
+		PaymentRequest: "lntb500",
+		PaymentHash:    "0102",
+		Amount:         500,
+		ExpiresAt:      time.Now().UTC(),
+	}
+
+	l := lightning.New(logger.New(fixtures.LogCategory), func(payId pay.PayId) (*payment.Invoice, error) {
+		assert.Equal(t, invoice.PayId, payId, "wrong pay id")
+		return invoice, nil
+	}, false)
+
+	var reply lightning.InvoiceReply
+	err := l.Invoice(&lightning.InvoiceArguments{PayId: invoice.PayId}, &reply)
+	assert.Nil(t, err, "wrong Invoice")
+	assert.Equal(t, *invoice, reply.Invoice, "wrong invoice")
+}
+
+func TestLightningInvoiceError(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	l := lightning.New(logger.New(fixtures.LogCategory), func(payId pay.PayId) (*payment.Invoice, error) {
+		return nil, fault.LightningNotEnabled
+	}, false)
+
+	var reply lightning.InvoiceReply
+	err := l.Invoice(&lightning.InvoiceArguments{PayId: pay.PayId{1}}, &reply)
+	assert.Equal(t, fault.LightningNotEnabled, err, "wrong error")
+}
+
+func TestLightningInvoiceWhenReadOnly(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	l := lightning.New(logger.New(fixtures.LogCategory), payment.LightningInvoice, true)
+
+	var reply lightning.InvoiceReply
+	err := l.Invoice(&lightning.InvoiceArguments{PayId: pay.PayId{1}}, &reply)
+	assert.Equal(t, fault.NotAvailableInReadOnlyMode, err, "wrong error")
+}
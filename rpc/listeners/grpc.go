@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package listeners
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/rpc"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/messagebus"
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
+	"github.com/bitmark-inc/bitmarkd/rpc/certificate"
+	"github.com/bitmark-inc/bitmarkd/rpc/pb"
+	"github.com/bitmark-inc/bitmarkd/rpc/server"
+	"github.com/bitmark-inc/logger"
+)
+
+const (
+	grpcLogName = "grpc_rpc"
+)
+
+// GRPCConfiguration - configuration file data for gRPC setup
+type GRPCConfiguration struct {
+	MaximumConnections uint64                          `gluamapper:"maximum_connections" json:"maximum_connections"`
+	Listen             []string                        `gluamapper:"listen" json:"listen"`
+	Certificate        string                          `gluamapper:"certificate" json:"certificate"`
+	PrivateKey         string                          `gluamapper:"private_key" json:"private_key"`
+	Client             certificate.ClientConfiguration `gluamapper:"client" json:"client"`
+}
+
+// gRPC status for errors returned by the RPC implementations
+// any other RPC error is considered to be an invalid argument
+var grpcErrorCode = map[string]codes.Code{
+	fault.InvalidAPIKey.Error():                         codes.Unauthenticated,
+	fault.MissingAPIKey.Error():                         codes.Unauthenticated,
+	fault.MethodNotAllowedForAPIKey.Error():             codes.PermissionDenied,
+	fault.AccountIsNotWatched.Error():                   codes.NotFound,
+	fault.AssetNotFound.Error():                         codes.NotFound,
+	fault.LinkToInvalidOrUnconfirmedTransaction.Error(): codes.NotFound,
+	fault.RecordNotFound.Error():                        codes.NotFound,
+	fault.TransactionIsNotConfirmed.Error():             codes.NotFound,
+	fault.NotAvailableInReadOnlyMode.Error():            codes.PermissionDenied,
+	fault.TransactionAlreadyExists.Error():              codes.AlreadyExists,
+	fault.DoubleTransferAttempt.Error():                 codes.AlreadyExists,
+	fault.RateLimiting.Error():                          codes.ResourceExhausted,
+	fault.NotAvailableDuringSynchronise.Error():         codes.Unavailable,
+}
+
+type grpcListener struct {
+	log             *logger.L
+	listenIPAndPort []string
+	ipType          []string
+	server          *grpc.Server
+}
+
+func (g grpcListener) Serve() error {
+	for i, listen := range g.listenIPAndPort {
+		g.log.Infof("starting server: %s on: %q", grpcLogName, listen)
+		listener, err := net.Listen(g.ipType[i], listen)
+		if err != nil {
+			g.log.Errorf("%s listen error: %s", grpcLogName, err)
+			return err
+		}
+
+		go func() {
+			err := g.server.Serve(listener)
+			g.log.Errorf("%s terminated: %s", grpcLogName, err)
+		}()
+	}
+
+	return nil
+}
+
+// NewGRPC - gRPC listener delegating to the JSON-RPC server
+//
+// returns nil if no listen addresses are configured
+func NewGRPC(
+	configuration *GRPCConfiguration,
+	log *logger.L,
+	tlsConfig *tls.Config,
+	rpcServer *rpc.Server,
+	keys *apikey.Keys,
+) (Listener, error) {
+	if len(configuration.Listen) == 0 {
+		log.Infof("disable: %s", grpcLogName)
+		return nil, nil
+	}
+
+	if configuration.MaximumConnections < minConnectionCount {
+		log.Errorf("invalid %s maximum connection limit: %d", grpcLogName, configuration.MaximumConnections)
+		return nil, fault.MissingParameters
+	}
+
+	ipType, err := parseListenAddress(configuration.Listen, log)
+	if err != nil {
+		return nil, err
+	}
+
+	g := grpcListener{
+		log:             log,
+		listenIPAndPort: configuration.Listen,
+		ipType:          ipType,
+		server: grpc.NewServer(
+			grpc.Creds(credentials.NewTLS(tlsConfig)),
+			grpc.MaxConcurrentStreams(uint32(configuration.MaximumConnections)),
+			grpc.UnaryInterceptor(UnaryAuthorise(keys)),
+			grpc.StreamInterceptor(StreamAuthorise(keys)),
+		),
+	}
+
+	ev := NewEvents(log, messagebus.Bus.Broadcast.Chan(messagebus.Default))
+	RegisterGRPC(g.server, rpcServer, ev)
+
+	return &g, nil
+}
+
+// RegisterGRPC - register all services on a gRPC server
+func RegisterGRPC(g *grpc.Server, rpcServer *rpc.Server, ev *Events) {
+	d := delegate{server: rpcServer}
+
+	pb.RegisterAssetsServer(g, &assetsService{delegate: d})
+	pb.RegisterBitmarkServer(g, &bitmarkService{delegate: d})
+	pb.RegisterBitmarksServer(g, &bitmarksService{delegate: d})
+	pb.RegisterOwnerServer(g, &ownerService{delegate: d})
+	pb.RegisterNodeServer(g, &nodeService{delegate: d, events: ev})
+	pb.RegisterTransactionServer(g, &transactionService{delegate: d, events: ev})
+	pb.RegisterBlockOwnerServer(g, &blockOwnerService{delegate: d})
+	pb.RegisterShareServer(g, &shareService{delegate: d})
+}
+
+// UnaryAuthorise - check the API key of each unary call
+func UnaryAuthorise(keys *apikey.Keys) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		err := authorise(ctx, keys, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, request)
+	}
+}
+
+// StreamAuthorise - check the API key when a stream starts
+func StreamAuthorise(keys *apikey.Keys) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := authorise(stream.Context(), keys, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
+
+// key from "authorization: Bearer <token>" metadata or, without a
+// token, from the client certificate, and the method
+// "/bitmarkd.v1.Assets/Get" is authorised as "Assets.Get"
+func authorise(ctx context.Context, keys *apikey.Keys, fullMethod string) error {
+	if nil == keys {
+		return nil
+	}
+
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, authorization := range md.Get("authorization") {
+			if t, found := strings.CutPrefix(authorization, "Bearer "); found {
+				token = strings.TrimSpace(t)
+			}
+		}
+	}
+
+	key := (*apikey.Key)(nil)
+	if "" == token {
+		key = keys.LookupClient(grpcClientIdentity(ctx))
+	}
+
+	var err error
+	if nil == key {
+		key, err = keys.Lookup(token)
+		if err != nil {
+			return grpcError(err)
+		}
+	}
+
+	service, method := path.Split(fullMethod)
+	service = strings.TrimPrefix(strings.Trim(service, "/"), "bitmarkd.v1.")
+	err = keys.Authorise(key, service+"."+method)
+	if err != nil {
+		return grpcError(err)
+	}
+	return nil
+}
+
+// the verified client certificate of the call's connection, nil if
+// there is none
+func grpcClientIdentity(ctx context.Context) *certificate.Identity {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	return certificate.ClientIdentity(&tlsInfo.State)
+}
+
+// to call the JSON-RPC method corresponding to a gRPC method
+type delegate struct {
+	server *rpc.Server
+}
+
+// call the method with its own argument and reply types
+func (d delegate) call(serviceMethod string, arguments interface{}, reply interface{}) error {
+	err := server.Invoke(d.server, serviceMethod, arguments, reply)
+	if err != nil {
+		return grpcError(err)
+	}
+	return nil
+}
+
+// a request field that cannot be converted to the method's arguments
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// map an error from an RPC call to a gRPC status
+func grpcError(err error) error {
+	if code, ok := grpcErrorCode[err.Error()]; ok {
+		return status.Error(code, err.Error())
+	}
+	if _, ok := err.(server.Error); ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,465 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package listeners
+
+import (
+	"encoding"
+	"reflect"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/blockdump"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/currency"
+	"github.com/bitmark-inc/bitmarkd/peer/forks"
+	"github.com/bitmark-inc/bitmarkd/rpc/pb"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// conversions between the gRPC messages and the arguments and replies
+// of the JSON-RPC methods; text fields use the same encoding as JSON
+
+// text of an identifier, empty for nil
+func toText(v interface{}) string {
+	m, ok := v.(encoding.TextMarshaler)
+	if !ok {
+		return ""
+	}
+	if r := reflect.ValueOf(v); reflect.Ptr == r.Kind() && r.IsNil() {
+		return ""
+	}
+	s, err := m.MarshalText()
+	if err != nil {
+		return ""
+	}
+	return string(s)
+}
+
+// set an identifier from its text, an empty string leaves it zero
+func fromText(s string, v encoding.TextUnmarshaler) error {
+	if "" == s {
+		return nil
+	}
+	return v.UnmarshalText([]byte(s))
+}
+
+// account from base58, nil for an empty string
+func toAccount(s string) (*account.Account, error) {
+	if "" == s {
+		return nil, nil
+	}
+	return account.AccountFromBase58(s)
+}
+
+// ---- requests ----
+
+func toPayment(p *pb.Payment) (*transactionrecord.Payment, error) {
+	if nil == p {
+		return nil, nil
+	}
+	payment := &transactionrecord.Payment{
+		Address: p.Address,
+		Amount:  p.Amount,
+	}
+	if err := fromText(p.Currency, &payment.Currency); err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+func toCurrencyMap(m map[string]string) (currency.Map, error) {
+	if nil == m {
+		return nil, nil
+	}
+	payments := make(currency.Map, len(m))
+	for name, address := range m {
+		var c currency.Currency
+		if err := c.UnmarshalText([]byte(name)); err != nil {
+			return nil, err
+		}
+		payments[c] = address
+	}
+	return payments, nil
+}
+
+func toAssetData(a *pb.AssetData) (*transactionrecord.AssetData, error) {
+	if nil == a {
+		return nil, nil
+	}
+	registrant, err := toAccount(a.Registrant)
+	if err != nil {
+		return nil, err
+	}
+	return &transactionrecord.AssetData{
+		Name:        a.Name,
+		Fingerprint: a.Fingerprint,
+		Metadata:    a.Metadata,
+		Registrant:  registrant,
+		Signature:   a.Signature,
+	}, nil
+}
+
+func toBitmarkIssue(i *pb.BitmarkIssue) (*transactionrecord.BitmarkIssue, error) {
+	if nil == i {
+		return nil, nil
+	}
+	owner, err := toAccount(i.Owner)
+	if err != nil {
+		return nil, err
+	}
+	issue := &transactionrecord.BitmarkIssue{
+		Owner:     owner,
+		Nonce:     i.Nonce,
+		Signature: i.Signature,
+	}
+	if err := fromText(i.AssetId, &issue.AssetId); err != nil {
+		return nil, err
+	}
+	return issue, nil
+}
+
+func toBitmarkTransfer(t *pb.BitmarkTransfer) (*transactionrecord.BitmarkTransferCountersigned, error) {
+	if nil == t {
+		return &transactionrecord.BitmarkTransferCountersigned{}, nil
+	}
+	escrow, err := toPayment(t.Escrow)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := toAccount(t.Owner)
+	if err != nil {
+		return nil, err
+	}
+	transfer := &transactionrecord.BitmarkTransferCountersigned{
+		Escrow:           escrow,
+		Owner:            owner,
+		Signature:        t.Signature,
+		Countersignature: t.Countersignature,
+	}
+	if err := fromText(t.Link, &transfer.Link); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func toBlockOwnerTransfer(t *pb.BlockOwnerTransfer) (*transactionrecord.BlockOwnerTransfer, error) {
+	if nil == t {
+		return &transactionrecord.BlockOwnerTransfer{}, nil
+	}
+	escrow, err := toPayment(t.Escrow)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := toCurrencyMap(t.Payments)
+	if err != nil {
+		return nil, err
+	}
+	owner, err := toAccount(t.Owner)
+	if err != nil {
+		return nil, err
+	}
+	transfer := &transactionrecord.BlockOwnerTransfer{
+		Escrow:           escrow,
+		Version:          t.Version,
+		Payments:         payments,
+		Owner:            owner,
+		Signature:        t.Signature,
+		Countersignature: t.Countersignature,
+	}
+	if err := fromText(t.Link, &transfer.Link); err != nil {
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func toBitmarkShare(s *pb.BitmarkShare) (*transactionrecord.BitmarkShare, error) {
+	if nil == s {
+		return &transactionrecord.BitmarkShare{}, nil
+	}
+	share := &transactionrecord.BitmarkShare{
+		Quantity:  s.Quantity,
+		Signature: s.Signature,
+	}
+	if err := fromText(s.Link, &share.Link); err != nil {
+		return nil, err
+	}
+	return share, nil
+}
+
+func toShareGrant(g *pb.ShareGrant) (*transactionrecord.ShareGrant, error) {
+	if nil == g {
+		return &transactionrecord.ShareGrant{}, nil
+	}
+	owner, err := toAccount(g.Owner)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := toAccount(g.Recipient)
+	if err != nil {
+		return nil, err
+	}
+	grant := &transactionrecord.ShareGrant{
+		Quantity:         g.Quantity,
+		Owner:            owner,
+		Recipient:        recipient,
+		BeforeBlock:      g.BeforeBlock,
+		Signature:        g.Signature,
+		Countersignature: g.Countersignature,
+	}
+	if err := fromText(g.ShareId, &grant.ShareId); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+func toShareSwap(s *pb.ShareSwap) (*transactionrecord.ShareSwap, error) {
+	if nil == s {
+		return &transactionrecord.ShareSwap{}, nil
+	}
+	ownerOne, err := toAccount(s.OwnerOne)
+	if err != nil {
+		return nil, err
+	}
+	ownerTwo, err := toAccount(s.OwnerTwo)
+	if err != nil {
+		return nil, err
+	}
+	swap := &transactionrecord.ShareSwap{
+		QuantityOne:      s.QuantityOne,
+		OwnerOne:         ownerOne,
+		QuantityTwo:      s.QuantityTwo,
+		OwnerTwo:         ownerTwo,
+		BeforeBlock:      s.BeforeBlock,
+		Signature:        s.Signature,
+		Countersignature: s.Countersignature,
+	}
+	if err := fromText(s.ShareIdOne, &swap.ShareIdOne); err != nil {
+		return nil, err
+	}
+	if err := fromText(s.ShareIdTwo, &swap.ShareIdTwo); err != nil {
+		return nil, err
+	}
+	return swap, nil
+}
+
+// ---- replies ----
+
+func pbPayment(p *transactionrecord.Payment) *pb.Payment {
+	if nil == p {
+		return nil
+	}
+	return &pb.Payment{
+		Currency: toText(p.Currency),
+		Address:  p.Address,
+		Amount:   p.Amount,
+	}
+}
+
+func pbPayments(payments map[string]transactionrecord.PaymentAlternative) map[string]*pb.PaymentAlternative {
+	if nil == payments {
+		return nil
+	}
+	m := make(map[string]*pb.PaymentAlternative, len(payments))
+	for name, alternative := range payments {
+		a := &pb.PaymentAlternative{}
+		for _, p := range alternative {
+			a.Payments = append(a.Payments, pbPayment(p))
+		}
+		m[name] = a
+	}
+	return m
+}
+
+func pbCurrencyMap(payments currency.Map) map[string]string {
+	if nil == payments {
+		return nil
+	}
+	m := make(map[string]string, len(payments))
+	for c, address := range payments {
+		m[toText(c)] = address
+	}
+	return m
+}
+
+func pbAssetData(a *transactionrecord.AssetData) *pb.AssetData {
+	if nil == a {
+		return nil
+	}
+	return &pb.AssetData{
+		Name:        a.Name,
+		Fingerprint: a.Fingerprint,
+		Metadata:    a.Metadata,
+		Registrant:  toText(a.Registrant),
+		Signature:   a.Signature,
+	}
+}
+
+// a transaction record, nil for anything else
+func pbTransactionRecord(v interface{}) *pb.TransactionRecord {
+	switch tx := v.(type) {
+
+	case *transactionrecord.OldBaseData:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_BaseData{
+			BaseData: &pb.OldBaseData{
+				Currency:       toText(tx.Currency),
+				PaymentAddress: tx.PaymentAddress,
+				Owner:          toText(tx.Owner),
+				Nonce:          tx.Nonce,
+				Signature:      tx.Signature,
+			},
+		}}
+
+	case *transactionrecord.AssetData:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_AssetData{
+			AssetData: pbAssetData(tx),
+		}}
+
+	case *transactionrecord.BitmarkIssue:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_BitmarkIssue{
+			BitmarkIssue: &pb.BitmarkIssue{
+				AssetId:   toText(tx.AssetId),
+				Owner:     toText(tx.Owner),
+				Nonce:     tx.Nonce,
+				Signature: tx.Signature,
+			},
+		}}
+
+	case *transactionrecord.BitmarkTransferUnratified:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_BitmarkTransferUnratified{
+			BitmarkTransferUnratified: &pb.BitmarkTransfer{
+				Link:      toText(tx.Link),
+				Escrow:    pbPayment(tx.Escrow),
+				Owner:     toText(tx.Owner),
+				Signature: tx.Signature,
+			},
+		}}
+
+	case *transactionrecord.BitmarkTransferCountersigned:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_BitmarkTransferCountersigned{
+			BitmarkTransferCountersigned: &pb.BitmarkTransfer{
+				Link:             toText(tx.Link),
+				Escrow:           pbPayment(tx.Escrow),
+				Owner:            toText(tx.Owner),
+				Signature:        tx.Signature,
+				Countersignature: tx.Countersignature,
+			},
+		}}
+
+	case *transactionrecord.BlockFoundation:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_BlockFoundation{
+			BlockFoundation: &pb.BlockFoundation{
+				Version:   tx.Version,
+				Payments:  pbCurrencyMap(tx.Payments),
+				Owner:     toText(tx.Owner),
+				Nonce:     tx.Nonce,
+				Signature: tx.Signature,
+			},
+		}}
+
+	case *transactionrecord.BlockOwnerTransfer:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_BlockOwnerTransfer{
+			BlockOwnerTransfer: &pb.BlockOwnerTransfer{
+				Link:             toText(tx.Link),
+				Escrow:           pbPayment(tx.Escrow),
+				Version:          tx.Version,
+				Payments:         pbCurrencyMap(tx.Payments),
+				Owner:            toText(tx.Owner),
+				Signature:        tx.Signature,
+				Countersignature: tx.Countersignature,
+			},
+		}}
+
+	case *transactionrecord.BitmarkShare:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_BitmarkShare{
+			BitmarkShare: &pb.BitmarkShare{
+				Link:      toText(tx.Link),
+				Quantity:  tx.Quantity,
+				Signature: tx.Signature,
+			},
+		}}
+
+	case *transactionrecord.ShareGrant:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_ShareGrant{
+			ShareGrant: &pb.ShareGrant{
+				ShareId:          toText(tx.ShareId),
+				Quantity:         tx.Quantity,
+				Owner:            toText(tx.Owner),
+				Recipient:        toText(tx.Recipient),
+				BeforeBlock:      tx.BeforeBlock,
+				Signature:        tx.Signature,
+				Countersignature: tx.Countersignature,
+			},
+		}}
+
+	case *transactionrecord.ShareSwap:
+		return &pb.TransactionRecord{Record: &pb.TransactionRecord_ShareSwap{
+			ShareSwap: &pb.ShareSwap{
+				ShareIdOne:       toText(tx.ShareIdOne),
+				QuantityOne:      tx.QuantityOne,
+				OwnerOne:         toText(tx.OwnerOne),
+				ShareIdTwo:       toText(tx.ShareIdTwo),
+				QuantityTwo:      tx.QuantityTwo,
+				OwnerTwo:         toText(tx.OwnerTwo),
+				BeforeBlock:      tx.BeforeBlock,
+				Signature:        tx.Signature,
+				Countersignature: tx.Countersignature,
+			},
+		}}
+
+	default:
+		return nil
+	}
+}
+
+func pbReorg(r forks.Reorg) *pb.Reorg {
+	return &pb.Reorg{
+		Height:    r.Height,
+		Start:     r.Start,
+		Depth:     r.Depth,
+		Peer:      r.Peer,
+		Timestamp: r.Timestamp.Unix(),
+	}
+}
+
+func pbHeader(h *blockrecord.Header) *pb.BlockHeader {
+	if nil == h {
+		return nil
+	}
+	header := &pb.BlockHeader{
+		Version:          uint32(h.Version),
+		TransactionCount: uint32(h.TransactionCount),
+		Number:           h.Number,
+		PreviousBlock:    toText(h.PreviousBlock),
+		MerkleRoot:       toText(h.MerkleRoot),
+		Timestamp:        h.Timestamp,
+		Nonce:            toText(h.Nonce),
+	}
+	if nil != h.Difficulty {
+		header.Difficulty = toText(*h.Difficulty)
+	}
+	return header
+}
+
+// a dumped block, nil for anything else
+func pbBlock(v interface{}) *pb.Block {
+	b, ok := v.(*blockdump.Block)
+	if !ok || nil == b {
+		return nil
+	}
+	block := &pb.Block{
+		Digest: toText(b.Digest),
+		Header: pbHeader(b.Header),
+		Packed: b.Packed,
+	}
+	for _, tx := range b.Transactions {
+		block.Transactions = append(block.Transactions, &pb.BlockTransaction{
+			Index: int64(tx.Index),
+			TxId:  toText(tx.TxId),
+			Type:  tx.Type,
+			Data:  pbTransactionRecord(tx.Data),
+		})
+	}
+	return block
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package listeners
+
+import (
+	"sync"
+
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/messagebus"
+	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/rpc/pb"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+	"github.com/bitmark-inc/logger"
+)
+
+// events buffered for each stream, a slow stream misses any more
+const eventQueueSize = 100
+
+// Events - fan out of the broadcast queue to streaming gRPC calls
+type Events struct {
+	sync.Mutex
+	log         *logger.L
+	subscribers map[chan event]struct{}
+}
+
+// one decoded broadcast message
+type event struct {
+	block        *pb.BlockEvent
+	transactions []*pb.TransactionEvent
+}
+
+// NewEvents - start distributing messages from a broadcast queue
+//
+// the queue is read until it is closed
+func NewEvents(log *logger.L, queue <-chan messagebus.Message) *Events {
+	e := &Events{
+		log:         log,
+		subscribers: make(map[chan event]struct{}),
+	}
+	go e.run(queue)
+	return e
+}
+
+func (e *Events) run(queue <-chan messagebus.Message) {
+	for item := range queue {
+		ev, ok := e.decode(item)
+		if !ok {
+			continue
+		}
+
+		e.Lock()
+		for c := range e.subscribers {
+			select {
+			case c <- ev:
+			default:
+			}
+		}
+		e.Unlock()
+	}
+
+	e.Lock()
+	for c := range e.subscribers {
+		close(c)
+		delete(e.subscribers, c)
+	}
+	e.Unlock()
+}
+
+// only blocks and new transactions are of interest
+func (e *Events) decode(item messagebus.Message) (event, bool) {
+	if 0 == len(item.Parameters) {
+		return event{}, false
+	}
+	packed := item.Parameters[0]
+
+	switch item.Command {
+	case "block":
+		packedHeader, err := blockrecord.PackedHeaderOf(packed)
+		if err != nil {
+			e.log.Warnf("block event error: %s", err)
+			return event{}, false
+		}
+		header, err := packedHeader.Unpack()
+		if err != nil {
+			e.log.Warnf("block event error: %s", err)
+			return event{}, false
+		}
+		return event{
+			block: &pb.BlockEvent{
+				Number: header.Number,
+				Digest: packedHeader.Digest().String(),
+				Packed: packed,
+			},
+		}, true
+
+	case "assets", "issues", "transfer":
+		transactions := make([]*pb.TransactionEvent, 0, 1)
+		for 0 != len(packed) {
+			tx, n, err := transactionrecord.Packed(packed).Unpack(mode.IsTesting())
+			if err != nil {
+				e.log.Warnf("%s event error: %s", item.Command, err)
+				break
+			}
+			record, _ := transactionrecord.RecordName(tx)
+			id := transactionrecord.Packed(packed[:n]).MakeLink().String()
+			if asset, ok := tx.(*transactionrecord.AssetData); ok {
+				id = asset.AssetId().String()
+			}
+			transactions = append(transactions, &pb.TransactionEvent{
+				Record: record,
+				TxId:   id,
+				Packed: packed[:n],
+			})
+			packed = packed[n:]
+		}
+		return event{transactions: transactions}, 0 != len(transactions)
+
+	default:
+		return event{}, false
+	}
+}
+
+func (e *Events) subscribe() chan event {
+	c := make(chan event, eventQueueSize)
+	e.Lock()
+	e.subscribers[c] = struct{}{}
+	e.Unlock()
+	return c
+}
+
+func (e *Events) unsubscribe(c chan event) {
+	e.Lock()
+	delete(e.subscribers, c)
+	e.Unlock()
+}
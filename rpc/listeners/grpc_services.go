@@ -0,0 +1,650 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package listeners
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/assets"
+	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
+	"github.com/bitmark-inc/bitmarkd/rpc/bitmarks"
+	"github.com/bitmark-inc/bitmarkd/rpc/blockowner"
+	"github.com/bitmark-inc/bitmarkd/rpc/node"
+	"github.com/bitmark-inc/bitmarkd/rpc/owner"
+	"github.com/bitmark-inc/bitmarkd/rpc/pb"
+	"github.com/bitmark-inc/bitmarkd/rpc/share"
+	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// each gRPC service delegates to the JSON-RPC service of the same
+// name, converting its request to the method's arguments and the
+// method's reply to its reply
+
+type assetsService struct {
+	pb.UnimplementedAssetsServer
+	delegate
+}
+
+func (s *assetsService) Get(_ context.Context, r *pb.AssetsGetRequest) (*pb.AssetsGetReply, error) {
+	arguments := &assets.GetArguments{
+		Fingerprints: r.Fingerprints,
+	}
+	for _, id := range r.Ids {
+		var assetId transactionrecord.AssetIdentifier
+		if err := assetId.UnmarshalText([]byte(id)); err != nil {
+			return nil, invalidArgument(err)
+		}
+		arguments.Ids = append(arguments.Ids, assetId)
+	}
+
+	var reply assets.GetReply
+	if err := s.call("Assets.Get", arguments, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.AssetsGetReply{}
+	for _, a := range reply.Assets {
+		data, _ := a.Data.(*transactionrecord.AssetData)
+		result.Assets = append(result.Assets, &pb.AssetRecord{
+			Record:    a.Record,
+			Confirmed: a.Confirmed,
+			Id:        toText(a.AssetId),
+			Data:      pbAssetData(data),
+		})
+	}
+	return result, nil
+}
+
+type bitmarkService struct {
+	pb.UnimplementedBitmarkServer
+	delegate
+}
+
+func (s *bitmarkService) Transfer(_ context.Context, r *pb.BitmarkTransferRequest) (*pb.TransferReply, error) {
+	arguments, err := toBitmarkTransfer(r.Transfer)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply bitmark.TransferReply
+	if err := s.call("Bitmark.Transfer", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return pbBitmarkTransferReply(&reply), nil
+}
+
+func (s *bitmarkService) Replace(_ context.Context, r *pb.BitmarkReplaceRequest) (*pb.TransferReply, error) {
+	transfer, err := toBitmarkTransfer(r.Transfer)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	arguments := &bitmark.ReplaceArguments{
+		Transfer:  transfer,
+		Signature: r.Signature,
+	}
+	if err := fromText(r.TxId, &arguments.TxId); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply bitmark.TransferReply
+	if err := s.call("Bitmark.Replace", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return pbBitmarkTransferReply(&reply), nil
+}
+
+func pbBitmarkTransferReply(reply *bitmark.TransferReply) *pb.TransferReply {
+	return &pb.TransferReply{
+		TxId:      toText(reply.TxId),
+		BitmarkId: toText(reply.BitmarkId),
+		PayId:     toText(reply.PayId),
+		Payments:  pbPayments(reply.Payments),
+	}
+}
+
+func (s *bitmarkService) Provenance(_ context.Context, r *pb.BitmarkProvenanceRequest) (*pb.BitmarkProvenanceReply, error) {
+	arguments := &bitmark.ProvenanceArguments{
+		Count: int(r.Count),
+	}
+	if err := fromText(r.TxId, &arguments.TxId); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply bitmark.ProvenanceReply
+	if err := s.call("Bitmark.Provenance", arguments, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.BitmarkProvenanceReply{}
+	for _, p := range reply.Data {
+		result.Data = append(result.Data, &pb.ProvenanceRecord{
+			Record:  p.Record,
+			IsOwner: p.IsOwner,
+			TxId:    toText(p.TxId),
+			InBlock: p.InBlock,
+			AssetId: toText(p.AssetId),
+			Data:    pbTransactionRecord(p.Data),
+		})
+	}
+	return result, nil
+}
+
+func (s *bitmarkService) FullProvenance(_ context.Context, r *pb.BitmarkFullProvenanceRequest) (*pb.BitmarkFullProvenanceReply, error) {
+	arguments := &bitmark.FullProvenanceArguments{}
+	if err := fromText(r.BitmarkId, &arguments.BitmarkId); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply bitmark.FullProvenanceReply
+	if err := s.call("Bitmark.FullProvenance", arguments, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.BitmarkFullProvenanceReply{}
+	for _, p := range reply.Data {
+		metadata, _ := p.Metadata.(map[string]string)
+		result.Data = append(result.Data, &pb.ProvenanceRecord{
+			Record:   p.Record,
+			IsOwner:  p.IsOwner,
+			TxId:     toText(p.TxId),
+			InBlock:  p.InBlock,
+			AssetId:  toText(p.AssetId),
+			Data:     pbTransactionRecord(p.Data),
+			Metadata: metadata,
+		})
+	}
+	return result, nil
+}
+
+type bitmarksService struct {
+	pb.UnimplementedBitmarksServer
+	delegate
+}
+
+func (s *bitmarksService) Create(_ context.Context, r *pb.BitmarksCreateRequest) (*pb.BitmarksCreateReply, error) {
+	arguments := &bitmarks.CreateArguments{}
+	for _, a := range r.Assets {
+		asset, err := toAssetData(a)
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+		arguments.Assets = append(arguments.Assets, asset)
+	}
+	for _, i := range r.Issues {
+		issue, err := toBitmarkIssue(i)
+		if err != nil {
+			return nil, invalidArgument(err)
+		}
+		arguments.Issues = append(arguments.Issues, issue)
+	}
+
+	var reply bitmarks.CreateReply
+	if err := s.call("Bitmarks.Create", arguments, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.BitmarksCreateReply{
+		PayId:      toText(reply.PayId),
+		PayNonce:   toText(reply.PayNonce),
+		Difficulty: reply.Difficulty,
+		Payments:   pbPayments(reply.Payments),
+	}
+	for _, a := range reply.Assets {
+		result.Assets = append(result.Assets, &pb.AssetStatus{
+			Id:        toText(a.AssetId),
+			Duplicate: a.Duplicate,
+		})
+	}
+	for _, i := range reply.Issues {
+		result.Issues = append(result.Issues, toText(i.TxId))
+	}
+	return result, nil
+}
+
+func (s *bitmarksService) Proof(_ context.Context, r *pb.BitmarksProofRequest) (*pb.BitmarksProofReply, error) {
+	arguments := &bitmarks.ProofArguments{
+		Nonce: r.Nonce,
+	}
+	if err := fromText(r.PayId, &arguments.PayId); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply bitmarks.ProofReply
+	if err := s.call("Bitmarks.Proof", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.BitmarksProofReply{
+		Status: reply.Status.String(),
+	}, nil
+}
+
+type ownerService struct {
+	pb.UnimplementedOwnerServer
+	delegate
+}
+
+func (s *ownerService) Bitmarks(_ context.Context, r *pb.OwnerBitmarksRequest) (*pb.OwnerBitmarksReply, error) {
+	o, err := toAccount(r.Owner)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	arguments := &owner.BitmarksArguments{
+		Owner: o,
+		Start: r.Start,
+		Count: int(r.Count),
+	}
+
+	var reply owner.BitmarksReply
+	if err := s.call("Owner.Bitmarks", arguments, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.OwnerBitmarksReply{
+		Next: reply.Next,
+		Tx:   make(map[string]*pb.OwnerRecord, len(reply.Tx)),
+	}
+	for _, d := range reply.Data {
+		result.Data = append(result.Data, &pb.OwnedBitmark{
+			N:           d.N,
+			TxId:        toText(d.TxId),
+			Issue:       toText(d.IssueTxId),
+			Item:        d.Item.String(),
+			AssetId:     toText(d.AssetId),
+			BlockNumber: d.BlockNumber,
+		})
+	}
+	for id, tx := range reply.Tx {
+		result.Tx[id] = &pb.OwnerRecord{
+			Record:  tx.Record,
+			TxId:    toText(tx.TxId),
+			InBlock: tx.InBlock,
+			AssetId: toText(tx.AssetId),
+			Data:    pbTransactionRecord(tx.Data),
+		}
+	}
+	return result, nil
+}
+
+type nodeService struct {
+	pb.UnimplementedNodeServer
+	delegate
+	events *Events
+}
+
+func (s *nodeService) List(_ context.Context, r *pb.NodeListRequest) (*pb.NodeListReply, error) {
+	arguments := &node.Arguments{
+		Start: r.Start,
+		Count: int(r.Count),
+	}
+
+	var reply node.Reply
+	if err := s.call("Node.List", arguments, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.NodeListReply{
+		NextStart: reply.NextStart,
+	}
+	for _, n := range reply.Nodes {
+		entry := &pb.NodeEntry{
+			Fingerprint: toText(n.Fingerprint),
+		}
+		for _, c := range n.Connections {
+			entry.Connections = append(entry.Connections, toText(c))
+		}
+		result.Nodes = append(result.Nodes, entry)
+	}
+	return result, nil
+}
+
+func (s *nodeService) Info(_ context.Context, _ *pb.NodeInfoRequest) (*pb.NodeInfoReply, error) {
+	var reply node.InfoReply
+	if err := s.call("Node.Info", &node.InfoArguments{}, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.NodeInfoReply{
+		Chain: reply.Chain,
+		Mode:  reply.Mode,
+		Block: &pb.NodeInfoReply_Block{
+			Height: reply.Block.Height,
+			Hash:   reply.Block.Hash,
+		},
+		Miner: &pb.NodeInfoReply_Miner{
+			Success: reply.Miner.Success,
+			Failed:  reply.Miner.Failed,
+		},
+		Rpcs:  reply.RPCs,
+		Peers: reply.Peers,
+		TransactionCounters: &pb.NodeInfoReply_Counters{
+			Pending:  int64(reply.TransactionCounters.Pending),
+			Verified: int64(reply.TransactionCounters.Verified),
+		},
+		Difficulty: reply.Difficulty,
+		Hashrate:   reply.Hashrate,
+		Version:    reply.Version,
+		Uptime:     reply.Uptime,
+		PublicKey:  reply.PublicKey,
+	}
+	if nil != reply.Sync {
+		result.Sync = &pb.NodeInfoReply_Sync{
+			Target:  reply.Sync.Target,
+			Headers: reply.Sync.Headers,
+			Blocks:  reply.Sync.Blocks,
+		}
+	}
+	return result, nil
+}
+
+func (s *nodeService) Forks(_ context.Context, _ *pb.NodeForksRequest) (*pb.NodeForksReply, error) {
+	var reply node.ForksReply
+	if err := s.call("Node.Forks", &node.ForksArguments{}, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.NodeForksReply{
+		MaximumDepth: reply.MaximumDepth,
+	}
+	for _, tip := range reply.Tips {
+		result.Tips = append(result.Tips, &pb.ForkTip{
+			Height:    tip.Height,
+			Digest:    toText(tip.Digest),
+			Peers:     tip.Peers,
+			FirstSeen: tip.FirstSeen.Unix(),
+			LastSeen:  tip.LastSeen.Unix(),
+		})
+	}
+	for _, reorg := range reply.Reorgs {
+		result.Reorgs = append(result.Reorgs, pbReorg(reorg))
+	}
+	if nil != reply.Halted {
+		result.Halted = pbReorg(*reply.Halted)
+	}
+	return result, nil
+}
+
+func (s *nodeService) BlockDump(_ context.Context, r *pb.NodeBlockDumpRequest) (*pb.NodeBlockDumpReply, error) {
+	arguments := &node.BlockDumpArguments{
+		Height: r.Height,
+		Binary: r.Binary,
+	}
+
+	var reply node.BlockDumpReply
+	if err := s.call("Node.BlockDump", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.NodeBlockDumpReply{
+		Block: pbBlock(reply.Block),
+	}, nil
+}
+
+func (s *nodeService) BlockDecode(_ context.Context, r *pb.NodeBlockDecodeRequest) (*pb.NodeBlockDecodeReply, error) {
+	arguments := &node.BlockDecodeArguments{
+		Packed: r.Packed,
+	}
+
+	var reply node.BlockDecodeReply
+	if err := s.call("Node.BlockDecode", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.NodeBlockDecodeReply{
+		Block: pbBlock(reply.Block),
+	}, nil
+}
+
+func (s *nodeService) BlockDumpRange(_ context.Context, r *pb.NodeBlockDumpRangeRequest) (*pb.NodeBlockDumpRangeReply, error) {
+	arguments := &node.BlockDumpRangeArguments{
+		Height: r.Height,
+		Count:  int(r.Count),
+		Txs:    r.Txs,
+	}
+
+	var reply node.BlockDumpRangeReply
+	if err := s.call("Node.BlockDumpRange", arguments, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.NodeBlockDumpRangeReply{}
+	for _, b := range reply.Blocks {
+		result.Blocks = append(result.Blocks, pbBlock(b))
+	}
+	return result, nil
+}
+
+func (s *nodeService) BlockFilter(_ context.Context, r *pb.NodeBlockFilterRequest) (*pb.NodeBlockFilterReply, error) {
+	arguments := &node.BlockFilterArguments{
+		Height: r.Height,
+	}
+
+	var reply node.BlockFilterReply
+	if err := s.call("Node.BlockFilter", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.NodeBlockFilterReply{
+		Height: reply.Height,
+		Digest: toText(reply.Digest),
+		Count:  reply.Count,
+		Filter: reply.Filter,
+	}, nil
+}
+
+func (s *nodeService) Blocks(_ *pb.EventsRequest, stream pb.Node_BlocksServer) error {
+	c := s.events.subscribe()
+	defer s.events.unsubscribe(c)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-c:
+			if !ok {
+				return nil
+			}
+			if nil == e.block {
+				continue
+			}
+			if err := stream.Send(e.block); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+type transactionService struct {
+	pb.UnimplementedTransactionServer
+	delegate
+	events *Events
+}
+
+func (s *transactionService) Status(_ context.Context, r *pb.TransactionStatusRequest) (*pb.TransactionStatusReply, error) {
+	arguments := &transaction.Arguments{}
+	if err := fromText(r.TxId, &arguments.TxId); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply transaction.StatusReply
+	if err := s.call("Transaction.Status", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.TransactionStatusReply{
+		Status: reply.Status,
+	}, nil
+}
+
+func (s *transactionService) InclusionProof(_ context.Context, r *pb.TransactionInclusionProofRequest) (*pb.TransactionInclusionProofReply, error) {
+	arguments := &transaction.InclusionProofArguments{
+		BlockNumber: r.BlockNumber,
+	}
+	if err := fromText(r.TxId, &arguments.TxId); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply transaction.InclusionProofReply
+	if err := s.call("Transaction.InclusionProof", arguments, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.TransactionInclusionProofReply{
+		TxId:         toText(reply.TxId),
+		BlockNumber:  reply.BlockNumber,
+		Digest:       toText(reply.Digest),
+		Header:       pbHeader(reply.Header),
+		PackedHeader: reply.PackedHeader,
+		Index:        reply.Index,
+	}
+	for _, digest := range reply.Branch {
+		result.Branch = append(result.Branch, toText(digest))
+	}
+	return result, nil
+}
+
+func (s *transactionService) Events(_ *pb.EventsRequest, stream pb.Transaction_EventsServer) error {
+	c := s.events.subscribe()
+	defer s.events.unsubscribe(c)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case e, ok := <-c:
+			if !ok {
+				return nil
+			}
+			for _, tx := range e.transactions {
+				if err := stream.Send(tx); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+type blockOwnerService struct {
+	pb.UnimplementedBlockOwnerServer
+	delegate
+}
+
+func (s *blockOwnerService) TxIDForBlock(_ context.Context, r *pb.BlockOwnerTxIDForBlockRequest) (*pb.BlockOwnerTxIDForBlockReply, error) {
+	arguments := &blockowner.TxIDForBlockArguments{
+		BlockNumber: r.BlockNumber,
+	}
+
+	var reply blockowner.TxIDForBlockReply
+	if err := s.call("BlockOwner.TxIDForBlock", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.BlockOwnerTxIDForBlockReply{
+		TxId: toText(reply.TxId),
+	}, nil
+}
+
+func (s *blockOwnerService) Transfer(_ context.Context, r *pb.BlockOwnerTransferRequest) (*pb.TransferReply, error) {
+	arguments, err := toBlockOwnerTransfer(r.Transfer)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply blockowner.TransferReply
+	if err := s.call("BlockOwner.Transfer", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.TransferReply{
+		TxId:     toText(reply.TxId),
+		PayId:    toText(reply.PayId),
+		Payments: pbPayments(reply.Payments),
+	}, nil
+}
+
+type shareService struct {
+	pb.UnimplementedShareServer
+	delegate
+}
+
+func (s *shareService) Create(_ context.Context, r *pb.ShareCreateRequest) (*pb.ShareCreateReply, error) {
+	arguments, err := toBitmarkShare(r.Share)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply share.CreateReply
+	if err := s.call("Share.Create", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.ShareCreateReply{
+		TxId:     toText(reply.TxId),
+		ShareId:  toText(reply.ShareId),
+		PayId:    toText(reply.PayId),
+		Payments: pbPayments(reply.Payments),
+	}, nil
+}
+
+func (s *shareService) Balance(_ context.Context, r *pb.ShareBalanceRequest) (*pb.ShareBalanceReply, error) {
+	o, err := toAccount(r.Owner)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	arguments := &share.BalanceArguments{
+		Owner: o,
+		Count: int(r.Count),
+	}
+	if err := fromText(r.ShareId, &arguments.ShareId); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply share.BalanceReply
+	if err := s.call("Share.Balance", arguments, &reply); err != nil {
+		return nil, err
+	}
+
+	result := &pb.ShareBalanceReply{}
+	for _, b := range reply.Balances {
+		result.Balances = append(result.Balances, &pb.ShareBalance{
+			ShareId:   toText(b.ShareId),
+			Confirmed: b.Confirmed,
+			Spend:     b.Spend,
+			Available: b.Available,
+		})
+	}
+	return result, nil
+}
+
+func (s *shareService) Grant(_ context.Context, r *pb.ShareGrantRequest) (*pb.ShareGrantReply, error) {
+	arguments, err := toShareGrant(r.Grant)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply share.GrantReply
+	if err := s.call("Share.Grant", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.ShareGrantReply{
+		Remaining: reply.Remaining,
+		TxId:      toText(reply.TxId),
+		PayId:     toText(reply.PayId),
+		Payments:  pbPayments(reply.Payments),
+	}, nil
+}
+
+func (s *shareService) Swap(_ context.Context, r *pb.ShareSwapRequest) (*pb.ShareSwapReply, error) {
+	arguments, err := toShareSwap(r.Swap)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	var reply share.SwapReply
+	if err := s.call("Share.Swap", arguments, &reply); err != nil {
+		return nil, err
+	}
+	return &pb.ShareSwapReply{
+		RemainingOne: reply.RemainingOne,
+		RemainingTwo: reply.RemainingTwo,
+		TxId:         toText(reply.TxId),
+		PayId:        toText(reply.PayId),
+		Payments:     pbPayments(reply.Payments),
+	}, nil
+}
@@ -0,0 +1,257 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package listeners_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/rpc"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/chain"
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/messagebus"
+	"github.com/bitmark-inc/bitmarkd/mode"
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
+	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
+	"github.com/bitmark-inc/bitmarkd/rpc/fixtures"
+	"github.com/bitmark-inc/bitmarkd/rpc/listeners"
+	"github.com/bitmark-inc/bitmarkd/rpc/pb"
+	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+	"github.com/bitmark-inc/logger"
+)
+
+// fake JSON-RPC services with the names and types of real ones
+type Transaction struct{}
+
+func (t Transaction) Status(arg *transaction.Arguments, reply *transaction.StatusReply) error {
+	if (merkle.Digest{}) == arg.TxId {
+		return fault.RecordNotFound
+	}
+	reply.Status = "Confirmed"
+	return nil
+}
+
+type Bitmark struct{}
+
+func (b Bitmark) Transfer(arg *transactionrecord.BitmarkTransferCountersigned, reply *bitmark.TransferReply) error {
+	reply.TxId = arg.Link
+	reply.BitmarkId = arg.Link
+	reply.Payments = map[string]transactionrecord.PaymentAlternative{
+		"BTC": {arg.Escrow},
+	}
+	return nil
+}
+
+func setupGRPC(t *testing.T) (*grpc.ClientConn, chan messagebus.Message) {
+	s := rpc.NewServer()
+	err := s.Register(Transaction{})
+	assert.Nil(t, err, "register error")
+	err = s.Register(Bitmark{})
+	assert.Nil(t, err, "register error")
+
+	queue := make(chan messagebus.Message, 10)
+	g := grpc.NewServer()
+	listeners.RegisterGRPC(g, s, listeners.NewEvents(logger.New(fixtures.LogCategory), queue))
+
+	l := bufconn.Listen(65536)
+	go func() {
+		_ = g.Serve(l)
+	}()
+	t.Cleanup(g.Stop)
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return l.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.Nil(t, err, "client error")
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return conn, queue
+}
+
+const testTxId = "0102030405060708091011121314151617181920212223242526272829303132"
+
+func TestGRPCUnary(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	conn, _ := setupGRPC(t)
+	client := pb.NewTransactionClient(conn)
+
+	reply, err := client.Status(context.Background(), &pb.TransactionStatusRequest{TxId: testTxId})
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, "Confirmed", reply.Status, "wrong status")
+}
+
+func TestGRPCUnaryWhenError(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	conn, _ := setupGRPC(t)
+	client := pb.NewTransactionClient(conn)
+
+	_, err := client.Status(context.Background(), &pb.TransactionStatusRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err), "wrong code")
+	assert.Equal(t, fault.RecordNotFound.Error(), status.Convert(err).Message(), "wrong message")
+
+	_, err = client.Status(context.Background(), &pb.TransactionStatusRequest{TxId: "01"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "wrong code")
+}
+
+func TestGRPCUnaryRecord(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	conn, _ := setupGRPC(t)
+	client := pb.NewBitmarkClient(conn)
+
+	escrow := &pb.Payment{
+		Currency: "BTC",
+		Address:  "mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn",
+		Amount:   250000,
+	}
+	reply, err := client.Transfer(context.Background(), &pb.BitmarkTransferRequest{
+		Transfer: &pb.BitmarkTransfer{
+			Link:   testTxId,
+			Escrow: escrow,
+		},
+	})
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, testTxId, reply.TxId, "wrong tx id")
+	assert.Equal(t, testTxId, reply.BitmarkId, "wrong bitmark id")
+	assert.Equal(t, 1, len(reply.Payments["BTC"].Payments), "wrong payment count")
+	assert.Equal(t, escrow.Address, reply.Payments["BTC"].Payments[0].Address, "wrong address")
+	assert.Equal(t, escrow.Amount, reply.Payments["BTC"].Payments[0].Amount, "wrong amount")
+
+	_, err = client.Transfer(context.Background(), &pb.BitmarkTransferRequest{
+		Transfer: &pb.BitmarkTransfer{
+			Link:  testTxId,
+			Owner: "not an account",
+		},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "wrong code for account")
+}
+
+func TestGRPCTransactionEvents(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	_ = mode.Initialise(chain.Testing)
+	defer mode.Finalise()
+
+	seed := make([]byte, ed25519.SeedSize)
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	registrant := &account.Account{
+		AccountInterface: &account.ED25519Account{
+			Test:      true,
+			PublicKey: privateKey.Public().(ed25519.PublicKey),
+		},
+	}
+	asset := transactionrecord.AssetData{
+		Name:        "grpc",
+		Fingerprint: "fingerprint",
+		Metadata:    "owner\x00grpc",
+		Registrant:  registrant,
+	}
+	message, _ := asset.Pack(registrant)
+	asset.Signature = ed25519.Sign(privateKey, message)
+	packed, err := asset.Pack(registrant)
+	assert.Nil(t, err, "asset pack error")
+
+	conn, queue := setupGRPC(t)
+	client := pb.NewTransactionClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.Events(ctx, &pb.EventsRequest{})
+	assert.Nil(t, err, "events error")
+
+	// the subscription is made once the stream reaches the server
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case queue <- messagebus.Message{Command: "assets", Parameters: [][]byte{packed}}:
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+	}()
+
+	event, err := stream.Recv()
+	assert.Nil(t, err, "receive error")
+	assert.Equal(t, "AssetData", event.Record, "wrong record")
+	assert.Equal(t, asset.AssetId().String(), event.TxId, "wrong id")
+	assert.Equal(t, []byte(packed), event.Packed, "wrong packed")
+}
+
+func TestGRPCAuthoriseWithClientCertificate(t *testing.T) {
+	keys, err := apikey.New(&apikey.Configuration{
+		Required: true,
+		Keys: []apikey.KeyConfiguration{
+			{
+				Name:    "issuer",
+				Methods: []string{"submit"},
+				Clients: []string{"issuer.example.com"},
+			},
+		},
+	})
+	assert.Nil(t, err, "keys error")
+
+	interceptor := listeners.UnaryAuthorise(keys)
+	handler := func(ctx context.Context, request interface{}) (interface{}, error) {
+		return "called", nil
+	}
+	withClient := func(commonName string) context.Context {
+		state := tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{
+				{
+					Raw:     []byte(commonName),
+					Subject: pkix.Name{CommonName: commonName},
+				},
+			},
+		}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	}
+	transfer := &grpc.UnaryServerInfo{FullMethod: "/bitmarkd.v1.Bitmark/Transfer"}
+
+	reply, err := interceptor(withClient("issuer.example.com"), nil, transfer, handler)
+	assert.Nil(t, err, "wrong error for client")
+	assert.Equal(t, "called", reply, "handler not called")
+
+	_, err = interceptor(withClient("other.example.com"), nil, transfer, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "wrong code for unknown client")
+
+	_, err = interceptor(context.Background(), nil, transfer, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err), "wrong code without client")
+
+	_, err = interceptor(withClient("issuer.example.com"), nil, &grpc.UnaryServerInfo{FullMethod: "/bitmarkd.v1.Node/Info"}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "wrong code for method")
+}
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/certificate"
 	"github.com/bitmark-inc/bitmarkd/rpc/handler"
 	"github.com/bitmark-inc/logger"
 )
@@ -25,11 +26,12 @@ const (
 
 // HTTPSConfiguration - configuration file data for HTTPS setup
 type HTTPSConfiguration struct {
-	MaximumConnections uint64              `gluamapper:"maximum_connections" json:"maximum_connections"`
-	Listen             []string            `gluamapper:"listen" json:"listen"`
-	Certificate        string              `gluamapper:"certificate" json:"certificate"`
-	PrivateKey         string              `gluamapper:"private_key" json:"private_key"`
-	Allow              map[string][]string `gluamapper:"allow" json:"allow"`
+	MaximumConnections uint64                          `gluamapper:"maximum_connections" json:"maximum_connections"`
+	Listen             []string                        `gluamapper:"listen" json:"listen"`
+	Certificate        string                          `gluamapper:"certificate" json:"certificate"`
+	PrivateKey         string                          `gluamapper:"private_key" json:"private_key"`
+	Client             certificate.ClientConfiguration `gluamapper:"client" json:"client"`
+	Allow              map[string][]string             `gluamapper:"allow" json:"allow"`
 }
 
 type httpsListener struct {
@@ -80,6 +82,24 @@ func doServeHTTPS(addr string, hdlr http.Handler, cfg *tls.Config) {
 	_ = s.Serve(tlsListener)
 }
 
+// ParseAllow - create access control from lists of CIDR networks
+// in the form to match http.Request.RemoteAddr
+func ParseAllow(allow map[string][]string) (map[string][]*net.IPNet, error) {
+	local := make(map[string][]*net.IPNet)
+	for path, addresses := range allow {
+		set := make([]*net.IPNet, len(addresses))
+		local[path] = set
+		for i, ip := range addresses {
+			_, cidr, err := net.ParseCIDR(strings.Trim(ip, " "))
+			if err != nil {
+				return nil, err
+			}
+			set[i] = cidr
+		}
+	}
+	return local, nil
+}
+
 func NewHTTPS(
 	configuration *HTTPSConfiguration,
 	log *logger.L,
@@ -104,18 +124,9 @@ func NewHTTPS(
 		readOnly:        readOnly,
 	}
 
-	// create access control and format strings to match http.Request.RemoteAddr
-	local := make(map[string][]*net.IPNet)
-	for path, addresses := range configuration.Allow {
-		set := make([]*net.IPNet, len(addresses))
-		local[path] = set
-		for i, ip := range addresses {
-			_, cidr, err := net.ParseCIDR(strings.Trim(ip, " "))
-			if err != nil {
-				return nil, err
-			}
-			set[i] = cidr
-		}
+	local, err := ParseAllow(configuration.Allow)
+	if err != nil {
+		return nil, err
 	}
 
 	hdlr.SetAllow(local)
@@ -125,6 +136,10 @@ func NewHTTPS(
 	h.mux.HandleFunc("/bitmarkd/details", hdlr.Details)
 	h.mux.HandleFunc("/bitmarkd/connections", hdlr.Connections)
 	h.mux.HandleFunc("/bitmarkd/peers", hdlr.Peers)
+	h.mux.HandleFunc("/bitmarkd/reservoir", hdlr.Reservoir)
+	h.mux.HandleFunc("/bitmarkd/bans", hdlr.Bans)
+	h.mux.HandleFunc("/bitmarkd/keys", hdlr.Keys)
+	h.mux.HandleFunc("/v1/", hdlr.REST)
 	h.mux.HandleFunc("/", hdlr.Root)
 
 	return &h, nil
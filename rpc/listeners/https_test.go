@@ -12,6 +12,7 @@ import (
 	"math/rand"
 	"net"
 	"net/http"
+	"net/rpc"
 	"os"
 	"path"
 	"path/filepath"
@@ -20,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
 	"github.com/bitmark-inc/bitmarkd/rpc/certificate"
 	"github.com/bitmark-inc/bitmarkd/rpc/fixtures"
 	"github.com/bitmark-inc/bitmarkd/rpc/listeners"
@@ -48,8 +50,28 @@ func (h testHandler) Root(w http.ResponseWriter, _ *http.Request) {
 	_, _ = w.Write([]byte("Root"))
 }
 
+func (h testHandler) Reservoir(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte("Reservoir"))
+}
+
+func (h testHandler) Bans(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte("Bans"))
+}
+
+func (h testHandler) Keys(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte("Keys"))
+}
+
+func (h testHandler) REST(w http.ResponseWriter, _ *http.Request) {
+	_, _ = w.Write([]byte("REST"))
+}
+
 func (h testHandler) SetAllow(_ map[string][]*net.IPNet) {}
 
+func (h testHandler) SetRestricted(_ string, _ *rpc.Server) {}
+
+func (h testHandler) SetKeys(_ *apikey.Keys) {}
+
 var client *http.Client
 
 func init() {
@@ -61,6 +83,23 @@ func init() {
 	}
 }
 
+// the server listens in the background, so wait until it accepts
+func waitForListener(t *testing.T, port int) {
+	address := fmt.Sprintf("127.0.0.1:%d", port)
+	deadline := time.Now().Add(time.Second)
+	for {
+		conn, err := net.Dial("tcp", address)
+		if err == nil {
+			conn.Close()
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("server not listening: %s", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
 func setup(t *testing.T) (int, listeners.Listener) {
 	allow := "127.0.0.1/32"
 	port := rand.Intn(30000) + 30000
@@ -99,6 +138,7 @@ func setup(t *testing.T) (int, listeners.Listener) {
 		logger.New(fixtures.LogCategory),
 		tlsConf,
 		testHandler{},
+		false,
 	)
 	if err != nil {
 		t.Error("NewHTTPS with error: ", err)
@@ -123,7 +163,7 @@ func TestHttpsListenerServeRPC(t *testing.T) {
 	customTransport := http.DefaultTransport.(*http.Transport).Clone()
 	customTransport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
 
-	time.Sleep(time.Millisecond) // make sure server is ready
+	waitForListener(t, port)
 	url := fmt.Sprintf("https://127.0.0.1:%d/bitmarkd/", port)
 	resp, err := client.Get(url + "rpc")
 	if err != nil {
@@ -145,7 +185,7 @@ func TestHttpsListenerServeDetails(t *testing.T) {
 	err := h.Serve()
 	assert.Nil(t, err, "wrong Serve")
 
-	time.Sleep(time.Millisecond)
+	waitForListener(t, port)
 	url := fmt.Sprintf("https://127.0.0.1:%d/bitmarkd/", port)
 	resp, err := client.Get(url + "details")
 	if err != nil {
@@ -167,7 +207,7 @@ func TestHttpsListenerServePeers(t *testing.T) {
 	err := h.Serve()
 	assert.Nil(t, err, "wrong Serve")
 
-	time.Sleep(time.Millisecond)
+	waitForListener(t, port)
 	url := fmt.Sprintf("https://127.0.0.1:%d/bitmarkd/", port)
 	resp, err := client.Get(url + "peers")
 	if err != nil {
@@ -189,7 +229,7 @@ func TestHttpsListenerServeConnections(t *testing.T) {
 	err := h.Serve()
 	assert.Nil(t, err, "wrong Serve")
 
-	time.Sleep(time.Millisecond)
+	waitForListener(t, port)
 	url := fmt.Sprintf("https://127.0.0.1:%d/bitmarkd/", port)
 	resp, err := client.Get(url + "connections")
 	if err != nil {
@@ -211,7 +251,7 @@ func TestHttpsListenerServeRoot(t *testing.T) {
 	err := h.Serve()
 	assert.Nil(t, err, "wrong Serve")
 
-	time.Sleep(time.Millisecond)
+	waitForListener(t, port)
 	url := fmt.Sprintf("https://127.0.0.1:%d/bitmarkd/", port)
 	resp, err := client.Get(url)
 	if err != nil {
@@ -223,3 +263,18 @@ func TestHttpsListenerServeRoot(t *testing.T) {
 	content, _ := ioutil.ReadAll(resp.Body)
 	assert.Equal(t, "Root", string(content), "wrong Root call")
 }
+
+func TestParseAllow(t *testing.T) {
+	allow, err := listeners.ParseAllow(map[string][]string{
+		"details": {"127.0.0.0/8", " ::1/128"},
+	})
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, 2, len(allow["details"]), "wrong network count")
+	assert.True(t, allow["details"][0].Contains(net.ParseIP("127.0.0.2")), "wrong ipv4 network")
+	assert.True(t, allow["details"][1].Contains(net.ParseIP("::1")), "wrong ipv6 network")
+
+	_, err = listeners.ParseAllow(map[string][]string{
+		"details": {"127.0.0.1"},
+	})
+	assert.NotNil(t, err, "wrong missing prefix")
+}
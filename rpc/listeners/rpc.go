@@ -15,6 +15,8 @@ import (
 	"github.com/bitmark-inc/bitmarkd/announce"
 	"github.com/bitmark-inc/bitmarkd/counter"
 	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
+	"github.com/bitmark-inc/bitmarkd/rpc/certificate"
 	"github.com/bitmark-inc/bitmarkd/util"
 	"github.com/bitmark-inc/logger"
 )
@@ -34,6 +36,7 @@ type rpcListener struct {
 	tlsConfig       *tls.Config
 	ipType          []string
 	listenIPAndPort []string
+	keys            *apikey.Keys
 	readOnly        bool
 }
 
@@ -47,12 +50,12 @@ func (r rpcListener) Serve() error {
 			return err
 		}
 
-		go doServeRPC(r.listener, r.server, r.maxConnections, r.log, r.count)
+		go doServeRPC(r.listener, r.server, r.keys, r.maxConnections, r.log, r.count)
 	}
 	return nil
 }
 
-func doServeRPC(listen net.Listener, server *rpc.Server, maximumConnections uint64, log *logger.L, count *counter.Counter) {
+func doServeRPC(listen net.Listener, server *rpc.Server, keys *apikey.Keys, maximumConnections uint64, log *logger.L, count *counter.Counter) {
 serve_loop:
 	for {
 		conn, err := listen.Accept()
@@ -62,7 +65,14 @@ serve_loop:
 		}
 		if count.Increment() <= maximumConnections {
 			go func() {
-				server.ServeCodec(jsonrpc.NewServerCodec(conn))
+				identity, err := clientIdentity(conn)
+				if err != nil {
+					log.Warnf("rpc client: %s handshake error: %s", conn.RemoteAddr(), err)
+				} else {
+					key := keys.LookupClient(identity)
+					log.Debugf("rpc client: %s identity: %s", conn.RemoteAddr(), identity)
+					server.ServeCodec(apikey.NewServerCodec(jsonrpc.NewServerCodec(conn), keys, key))
+				}
 				_ = conn.Close()
 				count.Decrement()
 			}()
@@ -78,12 +88,13 @@ serve_loop:
 
 // RPCConfiguration - configuration file data for RPC setup
 type RPCConfiguration struct {
-	MaximumConnections uint64   `gluamapper:"maximum_connections" json:"maximum_connections"`
-	Bandwidth          float64  `gluamapper:"bandwidth" json:"bandwidth"`
-	Listen             []string `gluamapper:"listen" json:"listen"`
-	Certificate        string   `gluamapper:"certificate" json:"certificate"`
-	PrivateKey         string   `gluamapper:"private_key" json:"private_key"`
-	Announce           []string `gluamapper:"announce" json:"announce"`
+	MaximumConnections uint64                          `gluamapper:"maximum_connections" json:"maximum_connections"`
+	Bandwidth          float64                         `gluamapper:"bandwidth" json:"bandwidth"`
+	Listen             []string                        `gluamapper:"listen" json:"listen"`
+	Certificate        string                          `gluamapper:"certificate" json:"certificate"`
+	PrivateKey         string                          `gluamapper:"private_key" json:"private_key"`
+	Client             certificate.ClientConfiguration `gluamapper:"client" json:"client"`
+	Announce           []string                        `gluamapper:"announce" json:"announce"`
 }
 
 func NewRPC(
@@ -94,6 +105,7 @@ func NewRPC(
 	ann announce.Announce,
 	tlsConfig *tls.Config,
 	certificateFingerprint [32]byte,
+	keys *apikey.Keys,
 	readOnly bool,
 ) (Listener, error) {
 	if configuration.MaximumConnections < minConnectionCount {
@@ -112,6 +124,7 @@ func NewRPC(
 		server:          server,
 		count:           count,
 		tlsConfig:       tlsConfig,
+		keys:            keys,
 		readOnly:        readOnly,
 	}
 
@@ -153,6 +166,20 @@ config_loop:
 	return &r, nil
 }
 
+// complete the TLS handshake to get the client certificate, if any
+func clientIdentity(conn net.Conn) (*certificate.Identity, error) {
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return nil, nil
+	}
+	err := tlsConn.Handshake()
+	if err != nil {
+		return nil, err
+	}
+	state := tlsConn.ConnectionState()
+	return certificate.ClientIdentity(&state), nil
+}
+
 func parseListenAddress(addrs []string, log *logger.L) ([]string, error) {
 	parsed := make([]string, len(addrs))
 	for i, listen := range addrs {
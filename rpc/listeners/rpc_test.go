@@ -87,6 +87,8 @@ func TestRpcListenerServe(t *testing.T) {
 		a,
 		tlsCertificate,
 		fin,
+		nil,
+		false,
 	)
 	assert.Nil(t, err, "wrong NewRPC")
 
@@ -146,6 +148,8 @@ func TestRpcListenerServeWhenMaxConnectionCountTooSmall(t *testing.T) {
 		a,
 		&tls.Config{},
 		[32]byte{},
+		nil,
+		false,
 	)
 	assert.Equal(t, fault.MissingParameters, err, "wrong error")
 }
@@ -181,6 +185,8 @@ func TestRpcListenerServeWhenBandwidthTooSmall(t *testing.T) {
 		a,
 		&tls.Config{},
 		[32]byte{},
+		nil,
+		false,
 	)
 	assert.Equal(t, fault.MissingParameters, err, "wrong error")
 }
@@ -214,6 +220,8 @@ func TestRpcListenerServeWhenEmptyListen(t *testing.T) {
 		a,
 		&tls.Config{},
 		[32]byte{},
+		nil,
+		false,
 	)
 	assert.Equal(t, fault.MissingParameters, err, "wrong error")
 }
@@ -249,6 +257,8 @@ func TestRpcListenerServeWhenWrongAnnounce(t *testing.T) {
 		a,
 		&tls.Config{},
 		[32]byte{},
+		nil,
+		false,
 	)
 	assert.NotNil(t, err, "wrong error")
 }
@@ -285,6 +295,8 @@ func TestRpcListenerServeWhenErrorAnnounceSet(t *testing.T) {
 		a,
 		&tls.Config{},
 		[32]byte{},
+		nil,
+		false,
 	)
 	assert.NotNil(t, err, "wrong error")
 	assert.Equal(t, "fake error", err.Error(), "wrong error message")
@@ -320,6 +332,8 @@ func TestRpcListenerServeWhenErrorListen(t *testing.T) {
 		a,
 		&tls.Config{},
 		[32]byte{},
+		nil,
+		false,
 	)
 	assert.NotNil(t, err, "wrong error")
 	assert.Equal(t, fault.InvalidIpAddress, err, "wrong error message")
@@ -355,6 +369,8 @@ func TestRpcListenerServeWhenListenAll(t *testing.T) {
 		a,
 		&tls.Config{},
 		[32]byte{},
+		nil,
+		false,
 	)
 	assert.Nil(t, err, "wrong NewRPC")
 }
@@ -389,6 +405,8 @@ func TestRpcListenerServeWhenListenIPv6(t *testing.T) {
 		a,
 		&tls.Config{},
 		[32]byte{},
+		nil,
+		false,
 	)
 	assert.Nil(t, err, "wrong NewRPC")
 }
@@ -425,6 +443,8 @@ func TestRpcListenerServeWhenInvalidTLSConfig(t *testing.T) {
 		a,
 		&tls.Config{},
 		[32]byte{},
+		nil,
+		false,
 	)
 	assert.Nil(t, err, "wrong NewRPC")
 
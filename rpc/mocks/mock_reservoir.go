@@ -60,6 +60,21 @@ func (mr *MockReservoirMockRecorder) StoreTransfer(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreTransfer", reflect.TypeOf((*MockReservoir)(nil).StoreTransfer), arg0)
 }
 
+// ReplaceTransfer mocks base method
+func (m *MockReservoir) ReplaceTransfer(arg0 merkle.Digest, arg1 transactionrecord.BitmarkTransfer, arg2 account.Signature) (*reservoir.TransferInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTransfer", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*reservoir.TransferInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceTransfer indicates an expected call of ReplaceTransfer
+func (mr *MockReservoirMockRecorder) ReplaceTransfer(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTransfer", reflect.TypeOf((*MockReservoir)(nil).ReplaceTransfer), arg0, arg1, arg2)
+}
+
 // StoreIssues mocks base method
 func (m *MockReservoir) StoreIssues(issues []*transactionrecord.BitmarkIssue) (*reservoir.IssueInfo, bool, error) {
 	m.ctrl.T.Helper()
@@ -150,3 +165,18 @@ func (mr *MockReservoirMockRecorder) StoreSwap(swap interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreSwap", reflect.TypeOf((*MockReservoir)(nil).StoreSwap), swap)
 }
+
+// List mocks base method
+func (m *MockReservoir) List(arg0 pay.PayId, arg1 int) ([]reservoir.ListInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].([]reservoir.ListInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockReservoirMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReservoir)(nil).List), arg0, arg1)
+}
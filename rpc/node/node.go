@@ -12,13 +12,16 @@ import (
 	"github.com/bitmark-inc/bitmarkd/announce"
 	"github.com/bitmark-inc/bitmarkd/announce/rpc"
 	"github.com/bitmark-inc/bitmarkd/block"
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
 	"github.com/bitmark-inc/bitmarkd/blockdump"
+	"github.com/bitmark-inc/bitmarkd/blockfilter"
 	"github.com/bitmark-inc/bitmarkd/blockheader"
 	"github.com/bitmark-inc/bitmarkd/counter"
 	"github.com/bitmark-inc/bitmarkd/difficulty"
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/mode"
 	"github.com/bitmark-inc/bitmarkd/peer"
+	"github.com/bitmark-inc/bitmarkd/peer/forks"
 	"github.com/bitmark-inc/bitmarkd/proof"
 	"github.com/bitmark-inc/bitmarkd/reservoir"
 	"github.com/bitmark-inc/bitmarkd/rpc/ratelimit"
@@ -40,6 +43,7 @@ type Node struct {
 	Version  string
 	Announce announce.Announce
 	Pool     storage.Handle
+	Filters  storage.Handle
 	counter  *counter.Counter
 	ReadOnly bool
 }
@@ -76,6 +80,7 @@ func New(log *logger.L,
 		Version:  version,
 		Announce: ann,
 		Pool:     pools.Blocks,
+		Filters:  pools.BlockFilters,
 		counter:  ctr,
 		ReadOnly: readOnly,
 	}
@@ -117,6 +122,14 @@ type InfoReply struct {
 	Version             string    `json:"version"`
 	Uptime              string    `json:"uptime"`
 	PublicKey           string    `json:"publicKey"`
+	Sync                *SyncInfo `json:"sync,omitempty"`
+}
+
+// SyncInfo - progress of catching up with the network
+type SyncInfo struct {
+	Target  uint64 `json:"target"`  // height agreed by the peers
+	Headers uint64 `json:"headers"` // highest validated header
+	Blocks  uint64 `json:"blocks"`  // highest stored block
 }
 
 // BlockInfo - the highest block held by the node
@@ -170,6 +183,34 @@ func (node *Node) Info(_ *InfoArguments, reply *InfoReply) error {
 	reply.Version = node.Version
 	reply.Uptime = time.Since(node.Start).String()
 	reply.PublicKey = hex.EncodeToString(peer.PublicKey())
+
+	if mode.Is(mode.Resynchronise) {
+		reply.Sync = &SyncInfo{
+			Target:  peer.BlockHeight(),
+			Headers: peer.HeaderHeight(),
+			Blocks:  reply.Block.Height,
+		}
+	}
+	return nil
+}
+
+// ForksArguments - empty arguments for forks request
+type ForksArguments struct{}
+
+// ForksReply - competing chains seen from peers, recent
+// reorganisations and any refused reorganisation that halted syncing
+type ForksReply struct {
+	forks.Report
+}
+
+// Forks - return the fork monitor state
+func (node *Node) Forks(_ *ForksArguments, reply *ForksReply) error {
+
+	if err := ratelimit.Limit(node.Limiter); err != nil {
+		return err
+	}
+
+	reply.Report = peer.Forks()
 	return nil
 }
 
@@ -269,3 +310,44 @@ func (node *Node) BlockDumpRange(arguments *BlockDumpRangeArguments, reply *Bloc
 	reply.Blocks = blocks
 	return nil
 }
+
+// BlockFilterArguments - the block of the filter
+type BlockFilterArguments struct {
+	Height uint64 `json:"height,string"`
+}
+
+// BlockFilterReply - the compact filter of a block, its items are
+// keyed by the first bytes of the block digest
+type BlockFilterReply struct {
+	Height uint64             `json:"height,string"`
+	Digest blockdigest.Digest `json:"digest"`
+	Count  uint64             `json:"count"`
+	Filter string             `json:"filter"` // hex
+}
+
+// BlockFilter - return the compact filter of a block
+func (node *Node) BlockFilter(arguments *BlockFilterArguments, reply *BlockFilterReply) error {
+
+	if err := ratelimit.Limit(node.Limiter); err != nil {
+		return err
+	}
+
+	if node.Pool == nil || node.Filters == nil {
+		return fault.DatabaseIsNotSet
+	}
+
+	filter, digest, err := blockfilter.Get(node.Filters, node.Pool, arguments.Height, mode.IsTesting())
+	if err != nil {
+		return err
+	}
+	count, err := blockfilter.Count(filter)
+	if err != nil {
+		return err
+	}
+
+	reply.Height = arguments.Height
+	reply.Digest = digest
+	reply.Count = count
+	reply.Filter = hex.EncodeToString(filter)
+	return nil
+}
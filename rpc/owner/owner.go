@@ -8,6 +8,7 @@ package owner
 import (
 	"github.com/bitmark-inc/bitmarkd/account"
 	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/light"
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/mode"
 	"github.com/bitmark-inc/bitmarkd/ownership"
@@ -94,6 +95,11 @@ func (owner *Owner) Bitmarks(arguments *BitmarksArguments, reply *BitmarksReply)
 	log := owner.Log
 	log.Infof("Owner.Bitmarks: %+v", arguments)
 
+	// a light node only indexes its watched accounts
+	if light.IsEnabled() && !light.IsWatched(arguments.Owner) {
+		return fault.AccountIsNotWatched
+	}
+
 	ownershipData, err := owner.Ownership.ListBitmarksFor(arguments.Owner, arguments.Start, arguments.Count)
 	if err != nil {
 		return err
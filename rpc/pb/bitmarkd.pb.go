@@ -0,0 +1,5835 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// gRPC access to the bitmarkd client API
+//
+// every unary method calls the JSON-RPC method of the same service and
+// name, e.g. Assets/Get is Assets.Get, with its arguments and result
+// carried in the fields of typed messages named after the method
+//
+// digests, identifiers and accounts are the same text as in JSON-RPC;
+// signatures and packed records are bytes; an empty string is an
+// absent value
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.3
+// source: bitmarkd.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Address  string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount   uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_bitmarkd_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Payment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Payment) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// all of the payments of one currency
+type PaymentAlternative struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+}
+
+func (x *PaymentAlternative) Reset() {
+	*x = PaymentAlternative{}
+	mi := &file_bitmarkd_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentAlternative) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentAlternative) ProtoMessage() {}
+
+func (x *PaymentAlternative) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentAlternative.ProtoReflect.Descriptor instead.
+func (*PaymentAlternative) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentAlternative) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type AssetData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Metadata    string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Registrant  string `protobuf:"bytes,4,opt,name=registrant,proto3" json:"registrant,omitempty"`
+	Signature   []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *AssetData) Reset() {
+	*x = AssetData{}
+	mi := &file_bitmarkd_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetData) ProtoMessage() {}
+
+func (x *AssetData) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetData.ProtoReflect.Descriptor instead.
+func (*AssetData) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AssetData) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AssetData) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *AssetData) GetMetadata() string {
+	if x != nil {
+		return x.Metadata
+	}
+	return ""
+}
+
+func (x *AssetData) GetRegistrant() string {
+	if x != nil {
+		return x.Registrant
+	}
+	return ""
+}
+
+func (x *AssetData) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type BitmarkIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AssetId   string `protobuf:"bytes,1,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Owner     string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Nonce     uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *BitmarkIssue) Reset() {
+	*x = BitmarkIssue{}
+	mi := &file_bitmarkd_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarkIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarkIssue) ProtoMessage() {}
+
+func (x *BitmarkIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarkIssue.ProtoReflect.Descriptor instead.
+func (*BitmarkIssue) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BitmarkIssue) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *BitmarkIssue) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *BitmarkIssue) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *BitmarkIssue) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// countersignature is empty for an unratified transfer
+type BitmarkTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link             string   `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Escrow           *Payment `protobuf:"bytes,2,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	Owner            string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Signature        []byte   `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Countersignature []byte   `protobuf:"bytes,5,opt,name=countersignature,proto3" json:"countersignature,omitempty"`
+}
+
+func (x *BitmarkTransfer) Reset() {
+	*x = BitmarkTransfer{}
+	mi := &file_bitmarkd_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarkTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarkTransfer) ProtoMessage() {}
+
+func (x *BitmarkTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarkTransfer.ProtoReflect.Descriptor instead.
+func (*BitmarkTransfer) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BitmarkTransfer) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *BitmarkTransfer) GetEscrow() *Payment {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+func (x *BitmarkTransfer) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *BitmarkTransfer) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BitmarkTransfer) GetCountersignature() []byte {
+	if x != nil {
+		return x.Countersignature
+	}
+	return nil
+}
+
+type OldBaseData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency       string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	PaymentAddress string `protobuf:"bytes,2,opt,name=payment_address,json=paymentAddress,proto3" json:"payment_address,omitempty"`
+	Owner          string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Nonce          uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature      []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *OldBaseData) Reset() {
+	*x = OldBaseData{}
+	mi := &file_bitmarkd_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OldBaseData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OldBaseData) ProtoMessage() {}
+
+func (x *OldBaseData) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OldBaseData.ProtoReflect.Descriptor instead.
+func (*OldBaseData) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OldBaseData) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *OldBaseData) GetPaymentAddress() string {
+	if x != nil {
+		return x.PaymentAddress
+	}
+	return ""
+}
+
+func (x *OldBaseData) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OldBaseData) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *OldBaseData) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// payments is currency → address
+type BlockFoundation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version   uint64            `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Payments  map[string]string `protobuf:"bytes,2,rep,name=payments,proto3" json:"payments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Owner     string            `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Nonce     uint64            `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Signature []byte            `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *BlockFoundation) Reset() {
+	*x = BlockFoundation{}
+	mi := &file_bitmarkd_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockFoundation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockFoundation) ProtoMessage() {}
+
+func (x *BlockFoundation) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockFoundation.ProtoReflect.Descriptor instead.
+func (*BlockFoundation) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BlockFoundation) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BlockFoundation) GetPayments() map[string]string {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *BlockFoundation) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *BlockFoundation) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *BlockFoundation) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// payments is currency → address
+type BlockOwnerTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link             string            `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Escrow           *Payment          `protobuf:"bytes,2,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	Version          uint64            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	Payments         map[string]string `protobuf:"bytes,4,rep,name=payments,proto3" json:"payments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Owner            string            `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	Signature        []byte            `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	Countersignature []byte            `protobuf:"bytes,7,opt,name=countersignature,proto3" json:"countersignature,omitempty"`
+}
+
+func (x *BlockOwnerTransfer) Reset() {
+	*x = BlockOwnerTransfer{}
+	mi := &file_bitmarkd_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockOwnerTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockOwnerTransfer) ProtoMessage() {}
+
+func (x *BlockOwnerTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockOwnerTransfer.ProtoReflect.Descriptor instead.
+func (*BlockOwnerTransfer) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BlockOwnerTransfer) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *BlockOwnerTransfer) GetEscrow() *Payment {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+func (x *BlockOwnerTransfer) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BlockOwnerTransfer) GetPayments() map[string]string {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *BlockOwnerTransfer) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *BlockOwnerTransfer) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *BlockOwnerTransfer) GetCountersignature() []byte {
+	if x != nil {
+		return x.Countersignature
+	}
+	return nil
+}
+
+type BitmarkShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Link      string `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	Quantity  uint64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *BitmarkShare) Reset() {
+	*x = BitmarkShare{}
+	mi := &file_bitmarkd_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarkShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarkShare) ProtoMessage() {}
+
+func (x *BitmarkShare) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarkShare.ProtoReflect.Descriptor instead.
+func (*BitmarkShare) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BitmarkShare) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *BitmarkShare) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BitmarkShare) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ShareGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareId          string `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	Quantity         uint64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Owner            string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Recipient        string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	BeforeBlock      uint64 `protobuf:"varint,5,opt,name=before_block,json=beforeBlock,proto3" json:"before_block,omitempty"`
+	Signature        []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	Countersignature []byte `protobuf:"bytes,7,opt,name=countersignature,proto3" json:"countersignature,omitempty"`
+}
+
+func (x *ShareGrant) Reset() {
+	*x = ShareGrant{}
+	mi := &file_bitmarkd_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGrant) ProtoMessage() {}
+
+func (x *ShareGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGrant.ProtoReflect.Descriptor instead.
+func (*ShareGrant) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ShareGrant) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *ShareGrant) GetQuantity() uint64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShareGrant) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ShareGrant) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *ShareGrant) GetBeforeBlock() uint64 {
+	if x != nil {
+		return x.BeforeBlock
+	}
+	return 0
+}
+
+func (x *ShareGrant) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ShareGrant) GetCountersignature() []byte {
+	if x != nil {
+		return x.Countersignature
+	}
+	return nil
+}
+
+type ShareSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareIdOne       string `protobuf:"bytes,1,opt,name=share_id_one,json=shareIdOne,proto3" json:"share_id_one,omitempty"`
+	QuantityOne      uint64 `protobuf:"varint,2,opt,name=quantity_one,json=quantityOne,proto3" json:"quantity_one,omitempty"`
+	OwnerOne         string `protobuf:"bytes,3,opt,name=owner_one,json=ownerOne,proto3" json:"owner_one,omitempty"`
+	ShareIdTwo       string `protobuf:"bytes,4,opt,name=share_id_two,json=shareIdTwo,proto3" json:"share_id_two,omitempty"`
+	QuantityTwo      uint64 `protobuf:"varint,5,opt,name=quantity_two,json=quantityTwo,proto3" json:"quantity_two,omitempty"`
+	OwnerTwo         string `protobuf:"bytes,6,opt,name=owner_two,json=ownerTwo,proto3" json:"owner_two,omitempty"`
+	BeforeBlock      uint64 `protobuf:"varint,7,opt,name=before_block,json=beforeBlock,proto3" json:"before_block,omitempty"`
+	Signature        []byte `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	Countersignature []byte `protobuf:"bytes,9,opt,name=countersignature,proto3" json:"countersignature,omitempty"`
+}
+
+func (x *ShareSwap) Reset() {
+	*x = ShareSwap{}
+	mi := &file_bitmarkd_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareSwap) ProtoMessage() {}
+
+func (x *ShareSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareSwap.ProtoReflect.Descriptor instead.
+func (*ShareSwap) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ShareSwap) GetShareIdOne() string {
+	if x != nil {
+		return x.ShareIdOne
+	}
+	return ""
+}
+
+func (x *ShareSwap) GetQuantityOne() uint64 {
+	if x != nil {
+		return x.QuantityOne
+	}
+	return 0
+}
+
+func (x *ShareSwap) GetOwnerOne() string {
+	if x != nil {
+		return x.OwnerOne
+	}
+	return ""
+}
+
+func (x *ShareSwap) GetShareIdTwo() string {
+	if x != nil {
+		return x.ShareIdTwo
+	}
+	return ""
+}
+
+func (x *ShareSwap) GetQuantityTwo() uint64 {
+	if x != nil {
+		return x.QuantityTwo
+	}
+	return 0
+}
+
+func (x *ShareSwap) GetOwnerTwo() string {
+	if x != nil {
+		return x.OwnerTwo
+	}
+	return ""
+}
+
+func (x *ShareSwap) GetBeforeBlock() uint64 {
+	if x != nil {
+		return x.BeforeBlock
+	}
+	return 0
+}
+
+func (x *ShareSwap) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *ShareSwap) GetCountersignature() []byte {
+	if x != nil {
+		return x.Countersignature
+	}
+	return nil
+}
+
+// any transaction record
+type TransactionRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Record:
+	//	*TransactionRecord_BaseData
+	//	*TransactionRecord_AssetData
+	//	*TransactionRecord_BitmarkIssue
+	//	*TransactionRecord_BitmarkTransferUnratified
+	//	*TransactionRecord_BitmarkTransferCountersigned
+	//	*TransactionRecord_BlockFoundation
+	//	*TransactionRecord_BlockOwnerTransfer
+	//	*TransactionRecord_BitmarkShare
+	//	*TransactionRecord_ShareGrant
+	//	*TransactionRecord_ShareSwap
+	Record isTransactionRecord_Record `protobuf_oneof:"record"`
+}
+
+func (x *TransactionRecord) Reset() {
+	*x = TransactionRecord{}
+	mi := &file_bitmarkd_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionRecord) ProtoMessage() {}
+
+func (x *TransactionRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionRecord.ProtoReflect.Descriptor instead.
+func (*TransactionRecord) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *TransactionRecord) GetRecord() isTransactionRecord_Record {
+	if m != nil {
+		return m.Record
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetBaseData() *OldBaseData {
+	if x, ok := x.GetRecord().(*TransactionRecord_BaseData); ok {
+		return x.BaseData
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetAssetData() *AssetData {
+	if x, ok := x.GetRecord().(*TransactionRecord_AssetData); ok {
+		return x.AssetData
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetBitmarkIssue() *BitmarkIssue {
+	if x, ok := x.GetRecord().(*TransactionRecord_BitmarkIssue); ok {
+		return x.BitmarkIssue
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetBitmarkTransferUnratified() *BitmarkTransfer {
+	if x, ok := x.GetRecord().(*TransactionRecord_BitmarkTransferUnratified); ok {
+		return x.BitmarkTransferUnratified
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetBitmarkTransferCountersigned() *BitmarkTransfer {
+	if x, ok := x.GetRecord().(*TransactionRecord_BitmarkTransferCountersigned); ok {
+		return x.BitmarkTransferCountersigned
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetBlockFoundation() *BlockFoundation {
+	if x, ok := x.GetRecord().(*TransactionRecord_BlockFoundation); ok {
+		return x.BlockFoundation
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetBlockOwnerTransfer() *BlockOwnerTransfer {
+	if x, ok := x.GetRecord().(*TransactionRecord_BlockOwnerTransfer); ok {
+		return x.BlockOwnerTransfer
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetBitmarkShare() *BitmarkShare {
+	if x, ok := x.GetRecord().(*TransactionRecord_BitmarkShare); ok {
+		return x.BitmarkShare
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetShareGrant() *ShareGrant {
+	if x, ok := x.GetRecord().(*TransactionRecord_ShareGrant); ok {
+		return x.ShareGrant
+	}
+	return nil
+}
+
+func (x *TransactionRecord) GetShareSwap() *ShareSwap {
+	if x, ok := x.GetRecord().(*TransactionRecord_ShareSwap); ok {
+		return x.ShareSwap
+	}
+	return nil
+}
+
+type isTransactionRecord_Record interface {
+	isTransactionRecord_Record()
+}
+
+type TransactionRecord_BaseData struct {
+	BaseData *OldBaseData `protobuf:"bytes,1,opt,name=base_data,json=baseData,proto3,oneof"`
+}
+
+type TransactionRecord_AssetData struct {
+	AssetData *AssetData `protobuf:"bytes,2,opt,name=asset_data,json=assetData,proto3,oneof"`
+}
+
+type TransactionRecord_BitmarkIssue struct {
+	BitmarkIssue *BitmarkIssue `protobuf:"bytes,3,opt,name=bitmark_issue,json=bitmarkIssue,proto3,oneof"`
+}
+
+type TransactionRecord_BitmarkTransferUnratified struct {
+	BitmarkTransferUnratified *BitmarkTransfer `protobuf:"bytes,4,opt,name=bitmark_transfer_unratified,json=bitmarkTransferUnratified,proto3,oneof"`
+}
+
+type TransactionRecord_BitmarkTransferCountersigned struct {
+	BitmarkTransferCountersigned *BitmarkTransfer `protobuf:"bytes,5,opt,name=bitmark_transfer_countersigned,json=bitmarkTransferCountersigned,proto3,oneof"`
+}
+
+type TransactionRecord_BlockFoundation struct {
+	BlockFoundation *BlockFoundation `protobuf:"bytes,6,opt,name=block_foundation,json=blockFoundation,proto3,oneof"`
+}
+
+type TransactionRecord_BlockOwnerTransfer struct {
+	BlockOwnerTransfer *BlockOwnerTransfer `protobuf:"bytes,7,opt,name=block_owner_transfer,json=blockOwnerTransfer,proto3,oneof"`
+}
+
+type TransactionRecord_BitmarkShare struct {
+	BitmarkShare *BitmarkShare `protobuf:"bytes,8,opt,name=bitmark_share,json=bitmarkShare,proto3,oneof"`
+}
+
+type TransactionRecord_ShareGrant struct {
+	ShareGrant *ShareGrant `protobuf:"bytes,9,opt,name=share_grant,json=shareGrant,proto3,oneof"`
+}
+
+type TransactionRecord_ShareSwap struct {
+	ShareSwap *ShareSwap `protobuf:"bytes,10,opt,name=share_swap,json=shareSwap,proto3,oneof"`
+}
+
+func (*TransactionRecord_BaseData) isTransactionRecord_Record() {}
+
+func (*TransactionRecord_AssetData) isTransactionRecord_Record() {}
+
+func (*TransactionRecord_BitmarkIssue) isTransactionRecord_Record() {}
+
+func (*TransactionRecord_BitmarkTransferUnratified) isTransactionRecord_Record() {}
+
+func (*TransactionRecord_BitmarkTransferCountersigned) isTransactionRecord_Record() {}
+
+func (*TransactionRecord_BlockFoundation) isTransactionRecord_Record() {}
+
+func (*TransactionRecord_BlockOwnerTransfer) isTransactionRecord_Record() {}
+
+func (*TransactionRecord_BitmarkShare) isTransactionRecord_Record() {}
+
+func (*TransactionRecord_ShareGrant) isTransactionRecord_Record() {}
+
+func (*TransactionRecord_ShareSwap) isTransactionRecord_Record() {}
+
+// difficulty and nonce are little endian hex as in JSON-RPC
+type BlockHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version          uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	TransactionCount uint32 `protobuf:"varint,2,opt,name=transaction_count,json=transactionCount,proto3" json:"transaction_count,omitempty"`
+	Number           uint64 `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	PreviousBlock    string `protobuf:"bytes,4,opt,name=previous_block,json=previousBlock,proto3" json:"previous_block,omitempty"`
+	MerkleRoot       string `protobuf:"bytes,5,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Timestamp        uint64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Difficulty       string `protobuf:"bytes,7,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Nonce            string `protobuf:"bytes,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *BlockHeader) Reset() {
+	*x = BlockHeader{}
+	mi := &file_bitmarkd_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockHeader) ProtoMessage() {}
+
+func (x *BlockHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
+func (*BlockHeader) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BlockHeader) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetTransactionCount() uint32 {
+	if x != nil {
+		return x.TransactionCount
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetPreviousBlock() string {
+	if x != nil {
+		return x.PreviousBlock
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetMerkleRoot() string {
+	if x != nil {
+		return x.MerkleRoot
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *BlockHeader) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type BlockTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int64              `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	TxId  string             `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Type  string             `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Data  *TransactionRecord `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BlockTransaction) Reset() {
+	*x = BlockTransaction{}
+	mi := &file_bitmarkd_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTransaction) ProtoMessage() {}
+
+func (x *BlockTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTransaction.ProtoReflect.Descriptor instead.
+func (*BlockTransaction) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BlockTransaction) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BlockTransaction) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *BlockTransaction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BlockTransaction) GetData() *TransactionRecord {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// transactions are only present when decoded
+type Block struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Digest       string              `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	Header       *BlockHeader        `protobuf:"bytes,2,opt,name=header,proto3" json:"header,omitempty"`
+	Transactions []*BlockTransaction `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Packed       []byte              `protobuf:"bytes,4,opt,name=packed,proto3" json:"packed,omitempty"`
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
+	mi := &file_bitmarkd_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block) ProtoMessage() {}
+
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Block) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *Block) GetHeader() *BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Block) GetTransactions() []*BlockTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *Block) GetPacked() []byte {
+	if x != nil {
+		return x.Packed
+	}
+	return nil
+}
+
+// the reply to a transfer of a bitmark or of block ownership
+// bitmark_id is only set for a bitmark
+type TransferReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId      string                         `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BitmarkId string                         `protobuf:"bytes,2,opt,name=bitmark_id,json=bitmarkId,proto3" json:"bitmark_id,omitempty"`
+	PayId     string                         `protobuf:"bytes,3,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Payments  map[string]*PaymentAlternative `protobuf:"bytes,4,rep,name=payments,proto3" json:"payments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *TransferReply) Reset() {
+	*x = TransferReply{}
+	mi := &file_bitmarkd_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferReply) ProtoMessage() {}
+
+func (x *TransferReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferReply.ProtoReflect.Descriptor instead.
+func (*TransferReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TransferReply) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TransferReply) GetBitmarkId() string {
+	if x != nil {
+		return x.BitmarkId
+	}
+	return ""
+}
+
+func (x *TransferReply) GetPayId() string {
+	if x != nil {
+		return x.PayId
+	}
+	return ""
+}
+
+func (x *TransferReply) GetPayments() map[string]*PaymentAlternative {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type AssetsGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fingerprints []string `protobuf:"bytes,1,rep,name=fingerprints,proto3" json:"fingerprints,omitempty"`
+	Ids          []string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *AssetsGetRequest) Reset() {
+	*x = AssetsGetRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetsGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetsGetRequest) ProtoMessage() {}
+
+func (x *AssetsGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetsGetRequest.ProtoReflect.Descriptor instead.
+func (*AssetsGetRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AssetsGetRequest) GetFingerprints() []string {
+	if x != nil {
+		return x.Fingerprints
+	}
+	return nil
+}
+
+func (x *AssetsGetRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// record is empty for an asset that was not found
+type AssetRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record    string     `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	Confirmed bool       `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Id        string     `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Data      *AssetData `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *AssetRecord) Reset() {
+	*x = AssetRecord{}
+	mi := &file_bitmarkd_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetRecord) ProtoMessage() {}
+
+func (x *AssetRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetRecord.ProtoReflect.Descriptor instead.
+func (*AssetRecord) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AssetRecord) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+func (x *AssetRecord) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *AssetRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssetRecord) GetData() *AssetData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AssetsGetReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assets []*AssetRecord `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+}
+
+func (x *AssetsGetReply) Reset() {
+	*x = AssetsGetReply{}
+	mi := &file_bitmarkd_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetsGetReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetsGetReply) ProtoMessage() {}
+
+func (x *AssetsGetReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetsGetReply.ProtoReflect.Descriptor instead.
+func (*AssetsGetReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AssetsGetReply) GetAssets() []*AssetRecord {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+type BitmarkTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfer *BitmarkTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (x *BitmarkTransferRequest) Reset() {
+	*x = BitmarkTransferRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarkTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarkTransferRequest) ProtoMessage() {}
+
+func (x *BitmarkTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarkTransferRequest.ProtoReflect.Descriptor instead.
+func (*BitmarkTransferRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BitmarkTransferRequest) GetTransfer() *BitmarkTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type BitmarkReplaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId      string           `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Transfer  *BitmarkTransfer `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	Signature []byte           `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *BitmarkReplaceRequest) Reset() {
+	*x = BitmarkReplaceRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarkReplaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarkReplaceRequest) ProtoMessage() {}
+
+func (x *BitmarkReplaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarkReplaceRequest.ProtoReflect.Descriptor instead.
+func (*BitmarkReplaceRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BitmarkReplaceRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *BitmarkReplaceRequest) GetTransfer() *BitmarkTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *BitmarkReplaceRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type BitmarkProvenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId  string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *BitmarkProvenanceRequest) Reset() {
+	*x = BitmarkProvenanceRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarkProvenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarkProvenanceRequest) ProtoMessage() {}
+
+func (x *BitmarkProvenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarkProvenanceRequest.ProtoReflect.Descriptor instead.
+func (*BitmarkProvenanceRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BitmarkProvenanceRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *BitmarkProvenanceRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// metadata is only set by FullProvenance
+type ProvenanceRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record   string             `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	IsOwner  bool               `protobuf:"varint,2,opt,name=is_owner,json=isOwner,proto3" json:"is_owner,omitempty"`
+	TxId     string             `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	InBlock  uint64             `protobuf:"varint,4,opt,name=in_block,json=inBlock,proto3" json:"in_block,omitempty"`
+	AssetId  string             `protobuf:"bytes,5,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Data     *TransactionRecord `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	Metadata map[string]string  `protobuf:"bytes,7,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ProvenanceRecord) Reset() {
+	*x = ProvenanceRecord{}
+	mi := &file_bitmarkd_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProvenanceRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProvenanceRecord) ProtoMessage() {}
+
+func (x *ProvenanceRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProvenanceRecord.ProtoReflect.Descriptor instead.
+func (*ProvenanceRecord) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProvenanceRecord) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetIsOwner() bool {
+	if x != nil {
+		return x.IsOwner
+	}
+	return false
+}
+
+func (x *ProvenanceRecord) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetInBlock() uint64 {
+	if x != nil {
+		return x.InBlock
+	}
+	return 0
+}
+
+func (x *ProvenanceRecord) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *ProvenanceRecord) GetData() *TransactionRecord {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ProvenanceRecord) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type BitmarkProvenanceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*ProvenanceRecord `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BitmarkProvenanceReply) Reset() {
+	*x = BitmarkProvenanceReply{}
+	mi := &file_bitmarkd_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarkProvenanceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarkProvenanceReply) ProtoMessage() {}
+
+func (x *BitmarkProvenanceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarkProvenanceReply.ProtoReflect.Descriptor instead.
+func (*BitmarkProvenanceReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BitmarkProvenanceReply) GetData() []*ProvenanceRecord {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BitmarkFullProvenanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BitmarkId string `protobuf:"bytes,1,opt,name=bitmark_id,json=bitmarkId,proto3" json:"bitmark_id,omitempty"`
+}
+
+func (x *BitmarkFullProvenanceRequest) Reset() {
+	*x = BitmarkFullProvenanceRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarkFullProvenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarkFullProvenanceRequest) ProtoMessage() {}
+
+func (x *BitmarkFullProvenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarkFullProvenanceRequest.ProtoReflect.Descriptor instead.
+func (*BitmarkFullProvenanceRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BitmarkFullProvenanceRequest) GetBitmarkId() string {
+	if x != nil {
+		return x.BitmarkId
+	}
+	return ""
+}
+
+type BitmarkFullProvenanceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*ProvenanceRecord `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *BitmarkFullProvenanceReply) Reset() {
+	*x = BitmarkFullProvenanceReply{}
+	mi := &file_bitmarkd_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarkFullProvenanceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarkFullProvenanceReply) ProtoMessage() {}
+
+func (x *BitmarkFullProvenanceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarkFullProvenanceReply.ProtoReflect.Descriptor instead.
+func (*BitmarkFullProvenanceReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BitmarkFullProvenanceReply) GetData() []*ProvenanceRecord {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type BitmarksCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assets []*AssetData    `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	Issues []*BitmarkIssue `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *BitmarksCreateRequest) Reset() {
+	*x = BitmarksCreateRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarksCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarksCreateRequest) ProtoMessage() {}
+
+func (x *BitmarksCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarksCreateRequest.ProtoReflect.Descriptor instead.
+func (*BitmarksCreateRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BitmarksCreateRequest) GetAssets() []*AssetData {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *BitmarksCreateRequest) GetIssues() []*BitmarkIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type AssetStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Duplicate bool   `protobuf:"varint,2,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
+}
+
+func (x *AssetStatus) Reset() {
+	*x = AssetStatus{}
+	mi := &file_bitmarkd_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetStatus) ProtoMessage() {}
+
+func (x *AssetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetStatus.ProtoReflect.Descriptor instead.
+func (*AssetStatus) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AssetStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AssetStatus) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
+}
+
+type BitmarksCreateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Assets     []*AssetStatus                 `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"`
+	Issues     []string                       `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+	PayId      string                         `protobuf:"bytes,3,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	PayNonce   string                         `protobuf:"bytes,4,opt,name=pay_nonce,json=payNonce,proto3" json:"pay_nonce,omitempty"`
+	Difficulty string                         `protobuf:"bytes,5,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Payments   map[string]*PaymentAlternative `protobuf:"bytes,6,rep,name=payments,proto3" json:"payments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BitmarksCreateReply) Reset() {
+	*x = BitmarksCreateReply{}
+	mi := &file_bitmarkd_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarksCreateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarksCreateReply) ProtoMessage() {}
+
+func (x *BitmarksCreateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarksCreateReply.ProtoReflect.Descriptor instead.
+func (*BitmarksCreateReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BitmarksCreateReply) GetAssets() []*AssetStatus {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *BitmarksCreateReply) GetIssues() []string {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *BitmarksCreateReply) GetPayId() string {
+	if x != nil {
+		return x.PayId
+	}
+	return ""
+}
+
+func (x *BitmarksCreateReply) GetPayNonce() string {
+	if x != nil {
+		return x.PayNonce
+	}
+	return ""
+}
+
+func (x *BitmarksCreateReply) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *BitmarksCreateReply) GetPayments() map[string]*PaymentAlternative {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type BitmarksProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayId string `protobuf:"bytes,1,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (x *BitmarksProofRequest) Reset() {
+	*x = BitmarksProofRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarksProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarksProofRequest) ProtoMessage() {}
+
+func (x *BitmarksProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarksProofRequest.ProtoReflect.Descriptor instead.
+func (*BitmarksProofRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BitmarksProofRequest) GetPayId() string {
+	if x != nil {
+		return x.PayId
+	}
+	return ""
+}
+
+func (x *BitmarksProofRequest) GetNonce() string {
+	if x != nil {
+		return x.Nonce
+	}
+	return ""
+}
+
+type BitmarksProofReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *BitmarksProofReply) Reset() {
+	*x = BitmarksProofReply{}
+	mi := &file_bitmarkd_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BitmarksProofReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BitmarksProofReply) ProtoMessage() {}
+
+func (x *BitmarksProofReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BitmarksProofReply.ProtoReflect.Descriptor instead.
+func (*BitmarksProofReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BitmarksProofReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type OwnerBitmarksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Start uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *OwnerBitmarksRequest) Reset() {
+	*x = OwnerBitmarksRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerBitmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerBitmarksRequest) ProtoMessage() {}
+
+func (x *OwnerBitmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerBitmarksRequest.ProtoReflect.Descriptor instead.
+func (*OwnerBitmarksRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OwnerBitmarksRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *OwnerBitmarksRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *OwnerBitmarksRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// block_number is only set for a block owner
+type OwnedBitmark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	N           uint64  `protobuf:"varint,1,opt,name=n,proto3" json:"n,omitempty"`
+	TxId        string  `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Issue       string  `protobuf:"bytes,3,opt,name=issue,proto3" json:"issue,omitempty"`
+	Item        string  `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	AssetId     string  `protobuf:"bytes,5,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	BlockNumber *uint64 `protobuf:"varint,6,opt,name=block_number,json=blockNumber,proto3,oneof" json:"block_number,omitempty"`
+}
+
+func (x *OwnedBitmark) Reset() {
+	*x = OwnedBitmark{}
+	mi := &file_bitmarkd_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnedBitmark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnedBitmark) ProtoMessage() {}
+
+func (x *OwnedBitmark) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnedBitmark.ProtoReflect.Descriptor instead.
+func (*OwnedBitmark) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *OwnedBitmark) GetN() uint64 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *OwnedBitmark) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *OwnedBitmark) GetIssue() string {
+	if x != nil {
+		return x.Issue
+	}
+	return ""
+}
+
+func (x *OwnedBitmark) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *OwnedBitmark) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *OwnedBitmark) GetBlockNumber() uint64 {
+	if x != nil && x.BlockNumber != nil {
+		return *x.BlockNumber
+	}
+	return 0
+}
+
+// data is unset for a "Block" record
+type OwnerRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record  string             `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	TxId    string             `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	InBlock uint64             `protobuf:"varint,3,opt,name=in_block,json=inBlock,proto3" json:"in_block,omitempty"`
+	AssetId string             `protobuf:"bytes,4,opt,name=asset_id,json=assetId,proto3" json:"asset_id,omitempty"`
+	Data    *TransactionRecord `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *OwnerRecord) Reset() {
+	*x = OwnerRecord{}
+	mi := &file_bitmarkd_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerRecord) ProtoMessage() {}
+
+func (x *OwnerRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerRecord.ProtoReflect.Descriptor instead.
+func (*OwnerRecord) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *OwnerRecord) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+func (x *OwnerRecord) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *OwnerRecord) GetInBlock() uint64 {
+	if x != nil {
+		return x.InBlock
+	}
+	return 0
+}
+
+func (x *OwnerRecord) GetAssetId() string {
+	if x != nil {
+		return x.AssetId
+	}
+	return ""
+}
+
+func (x *OwnerRecord) GetData() *TransactionRecord {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type OwnerBitmarksReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Next uint64                  `protobuf:"varint,1,opt,name=next,proto3" json:"next,omitempty"`
+	Data []*OwnedBitmark         `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Tx   map[string]*OwnerRecord `protobuf:"bytes,3,rep,name=tx,proto3" json:"tx,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *OwnerBitmarksReply) Reset() {
+	*x = OwnerBitmarksReply{}
+	mi := &file_bitmarkd_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerBitmarksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerBitmarksReply) ProtoMessage() {}
+
+func (x *OwnerBitmarksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerBitmarksReply.ProtoReflect.Descriptor instead.
+func (*OwnerBitmarksReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *OwnerBitmarksReply) GetNext() uint64 {
+	if x != nil {
+		return x.Next
+	}
+	return 0
+}
+
+func (x *OwnerBitmarksReply) GetData() []*OwnedBitmark {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *OwnerBitmarksReply) GetTx() map[string]*OwnerRecord {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type NodeListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start uint64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *NodeListRequest) Reset() {
+	*x = NodeListRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeListRequest) ProtoMessage() {}
+
+func (x *NodeListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeListRequest.ProtoReflect.Descriptor instead.
+func (*NodeListRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *NodeListRequest) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *NodeListRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NodeEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fingerprint string   `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Connections []string `protobuf:"bytes,2,rep,name=connections,proto3" json:"connections,omitempty"`
+}
+
+func (x *NodeEntry) Reset() {
+	*x = NodeEntry{}
+	mi := &file_bitmarkd_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeEntry) ProtoMessage() {}
+
+func (x *NodeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeEntry.ProtoReflect.Descriptor instead.
+func (*NodeEntry) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *NodeEntry) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *NodeEntry) GetConnections() []string {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+type NodeListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nodes     []*NodeEntry `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	NextStart uint64       `protobuf:"varint,2,opt,name=next_start,json=nextStart,proto3" json:"next_start,omitempty"`
+}
+
+func (x *NodeListReply) Reset() {
+	*x = NodeListReply{}
+	mi := &file_bitmarkd_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeListReply) ProtoMessage() {}
+
+func (x *NodeListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeListReply.ProtoReflect.Descriptor instead.
+func (*NodeListReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NodeListReply) GetNodes() []*NodeEntry {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *NodeListReply) GetNextStart() uint64 {
+	if x != nil {
+		return x.NextStart
+	}
+	return 0
+}
+
+type NodeInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NodeInfoRequest) Reset() {
+	*x = NodeInfoRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfoRequest) ProtoMessage() {}
+
+func (x *NodeInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfoRequest.ProtoReflect.Descriptor instead.
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{38}
+}
+
+type NodeInfoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chain               string                  `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	Mode                string                  `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	Block               *NodeInfoReply_Block    `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	Miner               *NodeInfoReply_Miner    `protobuf:"bytes,4,opt,name=miner,proto3" json:"miner,omitempty"`
+	Rpcs                uint64                  `protobuf:"varint,5,opt,name=rpcs,proto3" json:"rpcs,omitempty"`
+	Peers               uint64                  `protobuf:"varint,6,opt,name=peers,proto3" json:"peers,omitempty"`
+	TransactionCounters *NodeInfoReply_Counters `protobuf:"bytes,7,opt,name=transaction_counters,json=transactionCounters,proto3" json:"transaction_counters,omitempty"`
+	Difficulty          float64                 `protobuf:"fixed64,8,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Hashrate            float64                 `protobuf:"fixed64,9,opt,name=hashrate,proto3" json:"hashrate,omitempty"`
+	Version             string                  `protobuf:"bytes,10,opt,name=version,proto3" json:"version,omitempty"`
+	Uptime              string                  `protobuf:"bytes,11,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	PublicKey           string                  `protobuf:"bytes,12,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Sync                *NodeInfoReply_Sync     `protobuf:"bytes,13,opt,name=sync,proto3" json:"sync,omitempty"`
+}
+
+func (x *NodeInfoReply) Reset() {
+	*x = NodeInfoReply{}
+	mi := &file_bitmarkd_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfoReply) ProtoMessage() {}
+
+func (x *NodeInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfoReply.ProtoReflect.Descriptor instead.
+func (*NodeInfoReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NodeInfoReply) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+func (x *NodeInfoReply) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *NodeInfoReply) GetBlock() *NodeInfoReply_Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *NodeInfoReply) GetMiner() *NodeInfoReply_Miner {
+	if x != nil {
+		return x.Miner
+	}
+	return nil
+}
+
+func (x *NodeInfoReply) GetRpcs() uint64 {
+	if x != nil {
+		return x.Rpcs
+	}
+	return 0
+}
+
+func (x *NodeInfoReply) GetPeers() uint64 {
+	if x != nil {
+		return x.Peers
+	}
+	return 0
+}
+
+func (x *NodeInfoReply) GetTransactionCounters() *NodeInfoReply_Counters {
+	if x != nil {
+		return x.TransactionCounters
+	}
+	return nil
+}
+
+func (x *NodeInfoReply) GetDifficulty() float64 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *NodeInfoReply) GetHashrate() float64 {
+	if x != nil {
+		return x.Hashrate
+	}
+	return 0
+}
+
+func (x *NodeInfoReply) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NodeInfoReply) GetUptime() string {
+	if x != nil {
+		return x.Uptime
+	}
+	return ""
+}
+
+func (x *NodeInfoReply) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *NodeInfoReply) GetSync() *NodeInfoReply_Sync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+type NodeForksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *NodeForksRequest) Reset() {
+	*x = NodeForksRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeForksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeForksRequest) ProtoMessage() {}
+
+func (x *NodeForksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeForksRequest.ProtoReflect.Descriptor instead.
+func (*NodeForksRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{40}
+}
+
+// times are in seconds since the Unix epoch
+type ForkTip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Digest    string   `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Peers     []string `protobuf:"bytes,3,rep,name=peers,proto3" json:"peers,omitempty"`
+	FirstSeen int64    `protobuf:"varint,4,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen  int64    `protobuf:"varint,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+}
+
+func (x *ForkTip) Reset() {
+	*x = ForkTip{}
+	mi := &file_bitmarkd_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForkTip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkTip) ProtoMessage() {}
+
+func (x *ForkTip) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkTip.ProtoReflect.Descriptor instead.
+func (*ForkTip) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ForkTip) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ForkTip) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *ForkTip) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *ForkTip) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *ForkTip) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+type Reorg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Start     uint64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	Depth     uint64 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Peer      string `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"`
+	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *Reorg) Reset() {
+	*x = Reorg{}
+	mi := &file_bitmarkd_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reorg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reorg) ProtoMessage() {}
+
+func (x *Reorg) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reorg.ProtoReflect.Descriptor instead.
+func (*Reorg) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Reorg) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Reorg) GetStart() uint64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Reorg) GetDepth() uint64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Reorg) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *Reorg) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type NodeForksReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaximumDepth uint64     `protobuf:"varint,1,opt,name=maximum_depth,json=maximumDepth,proto3" json:"maximum_depth,omitempty"`
+	Tips         []*ForkTip `protobuf:"bytes,2,rep,name=tips,proto3" json:"tips,omitempty"`
+	Reorgs       []*Reorg   `protobuf:"bytes,3,rep,name=reorgs,proto3" json:"reorgs,omitempty"`
+	Halted       *Reorg     `protobuf:"bytes,4,opt,name=halted,proto3" json:"halted,omitempty"`
+}
+
+func (x *NodeForksReply) Reset() {
+	*x = NodeForksReply{}
+	mi := &file_bitmarkd_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeForksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeForksReply) ProtoMessage() {}
+
+func (x *NodeForksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeForksReply.ProtoReflect.Descriptor instead.
+func (*NodeForksReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *NodeForksReply) GetMaximumDepth() uint64 {
+	if x != nil {
+		return x.MaximumDepth
+	}
+	return 0
+}
+
+func (x *NodeForksReply) GetTips() []*ForkTip {
+	if x != nil {
+		return x.Tips
+	}
+	return nil
+}
+
+func (x *NodeForksReply) GetReorgs() []*Reorg {
+	if x != nil {
+		return x.Reorgs
+	}
+	return nil
+}
+
+func (x *NodeForksReply) GetHalted() *Reorg {
+	if x != nil {
+		return x.Halted
+	}
+	return nil
+}
+
+type NodeBlockDumpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Binary bool   `protobuf:"varint,2,opt,name=binary,proto3" json:"binary,omitempty"`
+}
+
+func (x *NodeBlockDumpRequest) Reset() {
+	*x = NodeBlockDumpRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeBlockDumpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBlockDumpRequest) ProtoMessage() {}
+
+func (x *NodeBlockDumpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBlockDumpRequest.ProtoReflect.Descriptor instead.
+func (*NodeBlockDumpRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *NodeBlockDumpRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *NodeBlockDumpRequest) GetBinary() bool {
+	if x != nil {
+		return x.Binary
+	}
+	return false
+}
+
+type NodeBlockDumpReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block *Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *NodeBlockDumpReply) Reset() {
+	*x = NodeBlockDumpReply{}
+	mi := &file_bitmarkd_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeBlockDumpReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBlockDumpReply) ProtoMessage() {}
+
+func (x *NodeBlockDumpReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBlockDumpReply.ProtoReflect.Descriptor instead.
+func (*NodeBlockDumpReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NodeBlockDumpReply) GetBlock() *Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type NodeBlockDecodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Packed []byte `protobuf:"bytes,1,opt,name=packed,proto3" json:"packed,omitempty"`
+}
+
+func (x *NodeBlockDecodeRequest) Reset() {
+	*x = NodeBlockDecodeRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeBlockDecodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBlockDecodeRequest) ProtoMessage() {}
+
+func (x *NodeBlockDecodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBlockDecodeRequest.ProtoReflect.Descriptor instead.
+func (*NodeBlockDecodeRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *NodeBlockDecodeRequest) GetPacked() []byte {
+	if x != nil {
+		return x.Packed
+	}
+	return nil
+}
+
+type NodeBlockDecodeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block *Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *NodeBlockDecodeReply) Reset() {
+	*x = NodeBlockDecodeReply{}
+	mi := &file_bitmarkd_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeBlockDecodeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBlockDecodeReply) ProtoMessage() {}
+
+func (x *NodeBlockDecodeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBlockDecodeReply.ProtoReflect.Descriptor instead.
+func (*NodeBlockDecodeReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *NodeBlockDecodeReply) GetBlock() *Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type NodeBlockDumpRangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Count  int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Txs    bool   `protobuf:"varint,3,opt,name=txs,proto3" json:"txs,omitempty"`
+}
+
+func (x *NodeBlockDumpRangeRequest) Reset() {
+	*x = NodeBlockDumpRangeRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeBlockDumpRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBlockDumpRangeRequest) ProtoMessage() {}
+
+func (x *NodeBlockDumpRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBlockDumpRangeRequest.ProtoReflect.Descriptor instead.
+func (*NodeBlockDumpRangeRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NodeBlockDumpRangeRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *NodeBlockDumpRangeRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NodeBlockDumpRangeRequest) GetTxs() bool {
+	if x != nil {
+		return x.Txs
+	}
+	return false
+}
+
+type NodeBlockDumpRangeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*Block `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *NodeBlockDumpRangeReply) Reset() {
+	*x = NodeBlockDumpRangeReply{}
+	mi := &file_bitmarkd_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeBlockDumpRangeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBlockDumpRangeReply) ProtoMessage() {}
+
+func (x *NodeBlockDumpRangeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBlockDumpRangeReply.ProtoReflect.Descriptor instead.
+func (*NodeBlockDumpRangeReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NodeBlockDumpRangeReply) GetBlocks() []*Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type NodeBlockFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *NodeBlockFilterRequest) Reset() {
+	*x = NodeBlockFilterRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeBlockFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBlockFilterRequest) ProtoMessage() {}
+
+func (x *NodeBlockFilterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBlockFilterRequest.ProtoReflect.Descriptor instead.
+func (*NodeBlockFilterRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NodeBlockFilterRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// filter is hex
+type NodeBlockFilterReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Digest string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Count  uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+}
+
+func (x *NodeBlockFilterReply) Reset() {
+	*x = NodeBlockFilterReply{}
+	mi := &file_bitmarkd_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeBlockFilterReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeBlockFilterReply) ProtoMessage() {}
+
+func (x *NodeBlockFilterReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeBlockFilterReply.ProtoReflect.Descriptor instead.
+func (*NodeBlockFilterReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *NodeBlockFilterReply) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *NodeBlockFilterReply) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *NodeBlockFilterReply) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *NodeBlockFilterReply) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+type TransactionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *TransactionStatusRequest) Reset() {
+	*x = TransactionStatusRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStatusRequest) ProtoMessage() {}
+
+func (x *TransactionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*TransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TransactionStatusRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type TransactionStatusReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *TransactionStatusReply) Reset() {
+	*x = TransactionStatusReply{}
+	mi := &file_bitmarkd_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionStatusReply) ProtoMessage() {}
+
+func (x *TransactionStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionStatusReply.ProtoReflect.Descriptor instead.
+func (*TransactionStatusReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TransactionStatusReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type TransactionInclusionProofRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId        string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *TransactionInclusionProofRequest) Reset() {
+	*x = TransactionInclusionProofRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionInclusionProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionInclusionProofRequest) ProtoMessage() {}
+
+func (x *TransactionInclusionProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionInclusionProofRequest.ProtoReflect.Descriptor instead.
+func (*TransactionInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *TransactionInclusionProofRequest) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TransactionInclusionProofRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+// packed_header is hex
+type TransactionInclusionProofReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId         string       `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BlockNumber  uint64       `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	Digest       string       `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	Header       *BlockHeader `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	PackedHeader string       `protobuf:"bytes,5,opt,name=packed_header,json=packedHeader,proto3" json:"packed_header,omitempty"`
+	Index        uint64       `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	Branch       []string     `protobuf:"bytes,7,rep,name=branch,proto3" json:"branch,omitempty"`
+}
+
+func (x *TransactionInclusionProofReply) Reset() {
+	*x = TransactionInclusionProofReply{}
+	mi := &file_bitmarkd_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionInclusionProofReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionInclusionProofReply) ProtoMessage() {}
+
+func (x *TransactionInclusionProofReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionInclusionProofReply.ProtoReflect.Descriptor instead.
+func (*TransactionInclusionProofReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *TransactionInclusionProofReply) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TransactionInclusionProofReply) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *TransactionInclusionProofReply) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *TransactionInclusionProofReply) GetHeader() *BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *TransactionInclusionProofReply) GetPackedHeader() string {
+	if x != nil {
+		return x.PackedHeader
+	}
+	return ""
+}
+
+func (x *TransactionInclusionProofReply) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TransactionInclusionProofReply) GetBranch() []string {
+	if x != nil {
+		return x.Branch
+	}
+	return nil
+}
+
+type BlockOwnerTxIDForBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockNumber uint64 `protobuf:"varint,1,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+}
+
+func (x *BlockOwnerTxIDForBlockRequest) Reset() {
+	*x = BlockOwnerTxIDForBlockRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockOwnerTxIDForBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockOwnerTxIDForBlockRequest) ProtoMessage() {}
+
+func (x *BlockOwnerTxIDForBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockOwnerTxIDForBlockRequest.ProtoReflect.Descriptor instead.
+func (*BlockOwnerTxIDForBlockRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *BlockOwnerTxIDForBlockRequest) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+type BlockOwnerTxIDForBlockReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId string `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+}
+
+func (x *BlockOwnerTxIDForBlockReply) Reset() {
+	*x = BlockOwnerTxIDForBlockReply{}
+	mi := &file_bitmarkd_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockOwnerTxIDForBlockReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockOwnerTxIDForBlockReply) ProtoMessage() {}
+
+func (x *BlockOwnerTxIDForBlockReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockOwnerTxIDForBlockReply.ProtoReflect.Descriptor instead.
+func (*BlockOwnerTxIDForBlockReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *BlockOwnerTxIDForBlockReply) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+type BlockOwnerTransferRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transfer *BlockOwnerTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+}
+
+func (x *BlockOwnerTransferRequest) Reset() {
+	*x = BlockOwnerTransferRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockOwnerTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockOwnerTransferRequest) ProtoMessage() {}
+
+func (x *BlockOwnerTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockOwnerTransferRequest.ProtoReflect.Descriptor instead.
+func (*BlockOwnerTransferRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *BlockOwnerTransferRequest) GetTransfer() *BlockOwnerTransfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type ShareCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Share *BitmarkShare `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *ShareCreateRequest) Reset() {
+	*x = ShareCreateRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCreateRequest) ProtoMessage() {}
+
+func (x *ShareCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCreateRequest.ProtoReflect.Descriptor instead.
+func (*ShareCreateRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ShareCreateRequest) GetShare() *BitmarkShare {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type ShareCreateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxId     string                         `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	ShareId  string                         `protobuf:"bytes,2,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	PayId    string                         `protobuf:"bytes,3,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Payments map[string]*PaymentAlternative `protobuf:"bytes,4,rep,name=payments,proto3" json:"payments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ShareCreateReply) Reset() {
+	*x = ShareCreateReply{}
+	mi := &file_bitmarkd_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCreateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCreateReply) ProtoMessage() {}
+
+func (x *ShareCreateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCreateReply.ProtoReflect.Descriptor instead.
+func (*ShareCreateReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ShareCreateReply) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ShareCreateReply) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *ShareCreateReply) GetPayId() string {
+	if x != nil {
+		return x.PayId
+	}
+	return ""
+}
+
+func (x *ShareCreateReply) GetPayments() map[string]*PaymentAlternative {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type ShareBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ShareId string `protobuf:"bytes,2,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	Count   int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ShareBalanceRequest) Reset() {
+	*x = ShareBalanceRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareBalanceRequest) ProtoMessage() {}
+
+func (x *ShareBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareBalanceRequest.ProtoReflect.Descriptor instead.
+func (*ShareBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ShareBalanceRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ShareBalanceRequest) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *ShareBalanceRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ShareBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareId   string `protobuf:"bytes,1,opt,name=share_id,json=shareId,proto3" json:"share_id,omitempty"`
+	Confirmed uint64 `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Spend     uint64 `protobuf:"varint,3,opt,name=spend,proto3" json:"spend,omitempty"`
+	Available uint64 `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+}
+
+func (x *ShareBalance) Reset() {
+	*x = ShareBalance{}
+	mi := &file_bitmarkd_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareBalance) ProtoMessage() {}
+
+func (x *ShareBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareBalance.ProtoReflect.Descriptor instead.
+func (*ShareBalance) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ShareBalance) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *ShareBalance) GetConfirmed() uint64 {
+	if x != nil {
+		return x.Confirmed
+	}
+	return 0
+}
+
+func (x *ShareBalance) GetSpend() uint64 {
+	if x != nil {
+		return x.Spend
+	}
+	return 0
+}
+
+func (x *ShareBalance) GetAvailable() uint64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+type ShareBalanceReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Balances []*ShareBalance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+}
+
+func (x *ShareBalanceReply) Reset() {
+	*x = ShareBalanceReply{}
+	mi := &file_bitmarkd_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareBalanceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareBalanceReply) ProtoMessage() {}
+
+func (x *ShareBalanceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareBalanceReply.ProtoReflect.Descriptor instead.
+func (*ShareBalanceReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ShareBalanceReply) GetBalances() []*ShareBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type ShareGrantRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grant *ShareGrant `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+}
+
+func (x *ShareGrantRequest) Reset() {
+	*x = ShareGrantRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGrantRequest) ProtoMessage() {}
+
+func (x *ShareGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGrantRequest.ProtoReflect.Descriptor instead.
+func (*ShareGrantRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ShareGrantRequest) GetGrant() *ShareGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+type ShareGrantReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remaining uint64                         `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	TxId      string                         `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	PayId     string                         `protobuf:"bytes,3,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Payments  map[string]*PaymentAlternative `protobuf:"bytes,4,rep,name=payments,proto3" json:"payments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ShareGrantReply) Reset() {
+	*x = ShareGrantReply{}
+	mi := &file_bitmarkd_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareGrantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareGrantReply) ProtoMessage() {}
+
+func (x *ShareGrantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareGrantReply.ProtoReflect.Descriptor instead.
+func (*ShareGrantReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ShareGrantReply) GetRemaining() uint64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *ShareGrantReply) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ShareGrantReply) GetPayId() string {
+	if x != nil {
+		return x.PayId
+	}
+	return ""
+}
+
+func (x *ShareGrantReply) GetPayments() map[string]*PaymentAlternative {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type ShareSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Swap *ShareSwap `protobuf:"bytes,1,opt,name=swap,proto3" json:"swap,omitempty"`
+}
+
+func (x *ShareSwapRequest) Reset() {
+	*x = ShareSwapRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareSwapRequest) ProtoMessage() {}
+
+func (x *ShareSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareSwapRequest.ProtoReflect.Descriptor instead.
+func (*ShareSwapRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ShareSwapRequest) GetSwap() *ShareSwap {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+type ShareSwapReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemainingOne uint64                         `protobuf:"varint,1,opt,name=remaining_one,json=remainingOne,proto3" json:"remaining_one,omitempty"`
+	RemainingTwo uint64                         `protobuf:"varint,2,opt,name=remaining_two,json=remainingTwo,proto3" json:"remaining_two,omitempty"`
+	TxId         string                         `protobuf:"bytes,3,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	PayId        string                         `protobuf:"bytes,4,opt,name=pay_id,json=payId,proto3" json:"pay_id,omitempty"`
+	Payments     map[string]*PaymentAlternative `protobuf:"bytes,5,rep,name=payments,proto3" json:"payments,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ShareSwapReply) Reset() {
+	*x = ShareSwapReply{}
+	mi := &file_bitmarkd_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareSwapReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareSwapReply) ProtoMessage() {}
+
+func (x *ShareSwapReply) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareSwapReply.ProtoReflect.Descriptor instead.
+func (*ShareSwapReply) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ShareSwapReply) GetRemainingOne() uint64 {
+	if x != nil {
+		return x.RemainingOne
+	}
+	return 0
+}
+
+func (x *ShareSwapReply) GetRemainingTwo() uint64 {
+	if x != nil {
+		return x.RemainingTwo
+	}
+	return 0
+}
+
+func (x *ShareSwapReply) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *ShareSwapReply) GetPayId() string {
+	if x != nil {
+		return x.PayId
+	}
+	return ""
+}
+
+func (x *ShareSwapReply) GetPayments() map[string]*PaymentAlternative {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+// subscribe to events from the time of the call onwards
+type EventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EventsRequest) Reset() {
+	*x = EventsRequest{}
+	mi := &file_bitmarkd_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsRequest) ProtoMessage() {}
+
+func (x *EventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsRequest.ProtoReflect.Descriptor instead.
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{68}
+}
+
+// a block stored by this node
+type BlockEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number uint64 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Digest string `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	Packed []byte `protobuf:"bytes,3,opt,name=packed,proto3" json:"packed,omitempty"`
+}
+
+func (x *BlockEvent) Reset() {
+	*x = BlockEvent{}
+	mi := &file_bitmarkd_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockEvent) ProtoMessage() {}
+
+func (x *BlockEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockEvent.ProtoReflect.Descriptor instead.
+func (*BlockEvent) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *BlockEvent) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *BlockEvent) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *BlockEvent) GetPacked() []byte {
+	if x != nil {
+		return x.Packed
+	}
+	return nil
+}
+
+// a transaction received by this node, either from a client or a peer
+// tx_id is the asset id for an asset record
+type TransactionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Record string `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	TxId   string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Packed []byte `protobuf:"bytes,3,opt,name=packed,proto3" json:"packed,omitempty"`
+}
+
+func (x *TransactionEvent) Reset() {
+	*x = TransactionEvent{}
+	mi := &file_bitmarkd_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionEvent) ProtoMessage() {}
+
+func (x *TransactionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionEvent.ProtoReflect.Descriptor instead.
+func (*TransactionEvent) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *TransactionEvent) GetRecord() string {
+	if x != nil {
+		return x.Record
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetTxId() string {
+	if x != nil {
+		return x.TxId
+	}
+	return ""
+}
+
+func (x *TransactionEvent) GetPacked() []byte {
+	if x != nil {
+		return x.Packed
+	}
+	return nil
+}
+
+type NodeInfoReply_Block struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash   string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *NodeInfoReply_Block) Reset() {
+	*x = NodeInfoReply_Block{}
+	mi := &file_bitmarkd_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfoReply_Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfoReply_Block) ProtoMessage() {}
+
+func (x *NodeInfoReply_Block) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfoReply_Block.ProtoReflect.Descriptor instead.
+func (*NodeInfoReply_Block) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *NodeInfoReply_Block) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *NodeInfoReply_Block) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type NodeInfoReply_Miner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success uint64 `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Failed  uint64 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *NodeInfoReply_Miner) Reset() {
+	*x = NodeInfoReply_Miner{}
+	mi := &file_bitmarkd_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfoReply_Miner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfoReply_Miner) ProtoMessage() {}
+
+func (x *NodeInfoReply_Miner) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfoReply_Miner.ProtoReflect.Descriptor instead.
+func (*NodeInfoReply_Miner) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{39, 1}
+}
+
+func (x *NodeInfoReply_Miner) GetSuccess() uint64 {
+	if x != nil {
+		return x.Success
+	}
+	return 0
+}
+
+func (x *NodeInfoReply_Miner) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type NodeInfoReply_Counters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending  int64 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Verified int64 `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+}
+
+func (x *NodeInfoReply_Counters) Reset() {
+	*x = NodeInfoReply_Counters{}
+	mi := &file_bitmarkd_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfoReply_Counters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfoReply_Counters) ProtoMessage() {}
+
+func (x *NodeInfoReply_Counters) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfoReply_Counters.ProtoReflect.Descriptor instead.
+func (*NodeInfoReply_Counters) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{39, 2}
+}
+
+func (x *NodeInfoReply_Counters) GetPending() int64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *NodeInfoReply_Counters) GetVerified() int64 {
+	if x != nil {
+		return x.Verified
+	}
+	return 0
+}
+
+type NodeInfoReply_Sync struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Target  uint64 `protobuf:"varint,1,opt,name=target,proto3" json:"target,omitempty"`
+	Headers uint64 `protobuf:"varint,2,opt,name=headers,proto3" json:"headers,omitempty"`
+	Blocks  uint64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *NodeInfoReply_Sync) Reset() {
+	*x = NodeInfoReply_Sync{}
+	mi := &file_bitmarkd_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfoReply_Sync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfoReply_Sync) ProtoMessage() {}
+
+func (x *NodeInfoReply_Sync) ProtoReflect() protoreflect.Message {
+	mi := &file_bitmarkd_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfoReply_Sync.ProtoReflect.Descriptor instead.
+func (*NodeInfoReply_Sync) Descriptor() ([]byte, []int) {
+	return file_bitmarkd_proto_rawDescGZIP(), []int{39, 3}
+}
+
+func (x *NodeInfoReply_Sync) GetTarget() uint64 {
+	if x != nil {
+		return x.Target
+	}
+	return 0
+}
+
+func (x *NodeInfoReply_Sync) GetHeaders() uint64 {
+	if x != nil {
+		return x.Headers
+	}
+	return 0
+}
+
+func (x *NodeInfoReply_Sync) GetBlocks() uint64 {
+	if x != nil {
+		return x.Blocks
+	}
+	return 0
+}
+
+var File_bitmarkd_proto protoreflect.FileDescriptor
+
+var file_bitmarkd_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0b, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x22, 0x57, 0x0a,
+	0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x30, 0x0a, 0x08,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x9b,
+	0x01, 0x0a, 0x09, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x73, 0x0a, 0x0c,
+	0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0xb3, 0x01, 0x0a, 0x0f, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x2c, 0x0a, 0x06, 0x65, 0x73, 0x63,
+	0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x9c, 0x01, 0x0a, 0x0b, 0x4f, 0x6c, 0x64, 0x42,
+	0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xfa, 0x01, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xd8, 0x02, 0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x2c,
+	0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x1a, 0x3b, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c,
+	0x0a, 0x0c, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69,
+	0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe4, 0x01, 0x0a,
+	0x0a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x5f, 0x6f, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64,
+	0x4f, 0x6e, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4f, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x4f, 0x6e, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x5f,
+	0x74, 0x77, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x49, 0x64, 0x54, 0x77, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x74, 0x77, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x54, 0x77, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x5f, 0x74, 0x77, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x54, 0x77, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x10, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0xee, 0x05, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x69,
+	0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6c, 0x64, 0x42, 0x61, 0x73,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x37, 0x0a, 0x0a, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
+	0x09, 0x61, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x40, 0x0a, 0x0d, 0x62, 0x69,
+	0x74, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x48, 0x00, 0x52, 0x0c,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x12, 0x5e, 0x0a, 0x1b,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x75, 0x6e, 0x72, 0x61, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x19, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x55, 0x6e, 0x72, 0x61, 0x74, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x64, 0x0a, 0x1e,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x48, 0x00, 0x52, 0x1c, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x12, 0x49, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x53, 0x0a,
+	0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x69,
+	0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x12,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x40, 0x0a, 0x0d, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74,
+	0x12, 0x37, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x48, 0x00, 0x52, 0x09,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x22, 0x88, 0x02, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a,
+	0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72,
+	0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22, 0x85,
+	0x01, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xac, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x22, 0xfe, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70,
+	0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79,
+	0x49, 0x64, 0x12, 0x44, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x5c, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x10, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69,
+	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x22, 0x7f, 0x0a, 0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x42, 0x0a, 0x0e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x06, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x22, 0x52, 0x0a, 0x16, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x38, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x42, 0x69,
+	0x74, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x45, 0x0a, 0x18, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05,
+	0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xca, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x47,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x4b, 0x0a, 0x16, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x3d, 0x0a, 0x1c, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x75, 0x6c, 0x6c,
+	0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x49, 0x64,
+	0x22, 0x4f, 0x0a, 0x1a, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x75, 0x6c, 0x6c, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x31,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x7a, 0x0a, 0x15, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x3b, 0x0a,
+	0x0b, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x22, 0xdd, 0x02, 0x0a, 0x13, 0x42,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x61, 0x73,
+	0x73, 0x65, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06,
+	0x70, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
+	0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x79, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x12, 0x4a, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x5c, 0x0a, 0x0d,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x43, 0x0a, 0x14, 0x42, 0x69,
+	0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x22,
+	0x2c, 0x0a, 0x12, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x58, 0x0a,
+	0x14, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x0c, 0x4f, 0x77, 0x6e, 0x65,
+	0x64, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x0c, 0x0a, 0x01, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x01, 0x6e, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64,
+	0x12, 0x26, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x0b, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x73, 0x73, 0x65, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xe1, 0x01, 0x0a, 0x12, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x37, 0x0a, 0x02, 0x74, 0x78,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x54, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x02, 0x74, 0x78, 0x1a, 0x4f, 0x0a, 0x07, 0x54, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x4f, 0x0a, 0x09, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x5c, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf1, 0x05, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x36, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x36, 0x0a, 0x05, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x70, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x04, 0x72, 0x70, 0x63, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x56, 0x0a, 0x14, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x13,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x68, 0x61, 0x73, 0x68, 0x72, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x33, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x52,
+	0x04, 0x73, 0x79, 0x6e, 0x63, 0x1a, 0x33, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x1a, 0x39, 0x0a, 0x05, 0x4d, 0x69,
+	0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x1a, 0x40, 0x0a, 0x08, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x1a, 0x50, 0x0a, 0x04, 0x53, 0x79, 0x6e, 0x63, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x4e, 0x6f, 0x64,
+	0x65, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01,
+	0x0a, 0x07, 0x46, 0x6f, 0x72, 0x6b, 0x54, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1b,
+	0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x22, 0x7d, 0x0a, 0x05, 0x52,
+	0x65, 0x6f, 0x72, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x65, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xb7, 0x01, 0x0a, 0x0e, 0x4e,
+	0x6f, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x44, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x6f, 0x72, 0x6b, 0x54, 0x69, 0x70, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x06,
+	0x72, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67,
+	0x52, 0x06, 0x72, 0x65, 0x6f, 0x72, 0x67, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x68, 0x61, 0x6c, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6f, 0x72, 0x67, 0x52, 0x06, 0x68, 0x61,
+	0x6c, 0x74, 0x65, 0x64, 0x22, 0x46, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x22, 0x3e, 0x0a, 0x12,
+	0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x30, 0x0a, 0x16,
+	0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x22, 0x40,
+	0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x63, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x5b, 0x0a, 0x19, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x6d,
+	0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x78, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x74, 0x78, 0x73, 0x22, 0x45, 0x0a,
+	0x17, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x22, 0x30, 0x0a, 0x16, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x74, 0x0a, 0x14, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x2f, 0x0a, 0x18,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x30, 0x0a,
+	0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x5a, 0x0a, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xf5, 0x01, 0x0a, 0x1e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x13,
+	0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x78, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x22, 0x42, 0x0a, 0x1d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x54, 0x78, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x32, 0x0a, 0x1b, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x78, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x19, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x08, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x80, 0x02, 0x0a,
+	0x10, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x78, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49,
+	0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x1a, 0x5c, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x5c, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7b, 0x0a,
+	0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x4a, 0x0a, 0x11, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x35, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x42, 0x0a, 0x11, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47,
+	0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x67,
+	0x72, 0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x05, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x22, 0x81, 0x02, 0x0a, 0x0f, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x13, 0x0a, 0x05,
+	0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49,
+	0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x61, 0x79, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x47, 0x72,
+	0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x1a, 0x5c, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e,
+	0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22, 0xab,
+	0x02, 0x0a, 0x0e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6f,
+	0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x4f, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x77, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x77, 0x6f, 0x12, 0x13, 0x0a, 0x05, 0x74,
+	0x78, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x49, 0x64,
+	0x12, 0x15, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x61, 0x79, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x5c,
+	0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x35, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x0f, 0x0a, 0x0d,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x54, 0x0a,
+	0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x64, 0x22, 0x57, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12,
+	0x13, 0x0a, 0x05, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x78, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x32, 0x4b, 0x0a, 0x06,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x41, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x1d, 0x2e,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x73, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xe1, 0x02, 0x0a, 0x07, 0x42, 0x69,
+	0x74, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x4b, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x12, 0x23, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x49, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x22, 0x2e,
+	0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x58, 0x0a,
+	0x0a, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e, 0x62, 0x69,
+	0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72,
+	0x6b, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x64, 0x0a, 0x0e, 0x46, 0x75, 0x6c, 0x6c, 0x50,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x46,
+	0x75, 0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x46, 0x75, 0x6c, 0x6c, 0x50, 0x72,
+	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0xa7, 0x01,
+	0x0a, 0x08, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x4e, 0x0a, 0x06, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4b, 0x0a, 0x05, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x12, 0x21, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0x57, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x4e, 0x0a, 0x08, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x21, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x42, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x32, 0xef, 0x04, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1c, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x40, 0x0a, 0x04, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x43, 0x0a,
+	0x05, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x1d, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x46, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x4f, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x6d, 0x70, 0x12,
+	0x21, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x55, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x23, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72,
+	0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44,
+	0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x5e, 0x0a, 0x0e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x75, 0x6d, 0x70,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x55, 0x0a, 0x0b, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x3f, 0x0a, 0x06, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x69,
+	0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72,
+	0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x30, 0x01, 0x32, 0x98, 0x02, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x54, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x6c, 0x0a, 0x0e, 0x49, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x2d, 0x2e, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x62, 0x69, 0x74, 0x6d,
+	0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x32, 0xc2, 0x01,
+	0x0a, 0x0a, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x64, 0x0a, 0x0c,
+	0x54, 0x78, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2a, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x78, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61,
+	0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x54, 0x78, 0x49, 0x44, 0x46, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x4e, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x26,
+	0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x32, 0xa9, 0x02, 0x0a, 0x05, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x48, 0x0a, 0x06,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72,
+	0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x4b, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x20, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x45, 0x0a, 0x05, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x62,
+	0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x47, 0x72, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x42, 0x0a, 0x04, 0x53, 0x77,
+	0x61, 0x70, 0x12, 0x1d, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b, 0x64, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x42, 0x28,
+	0x5a, 0x26, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x74,
+	0x6d, 0x61, 0x72, 0x6b, 0x2d, 0x69, 0x6e, 0x63, 0x2f, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x72, 0x6b,
+	0x64, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_bitmarkd_proto_rawDescOnce sync.Once
+	file_bitmarkd_proto_rawDescData = file_bitmarkd_proto_rawDesc
+)
+
+func file_bitmarkd_proto_rawDescGZIP() []byte {
+	file_bitmarkd_proto_rawDescOnce.Do(func() {
+		file_bitmarkd_proto_rawDescData = protoimpl.X.CompressGZIP(file_bitmarkd_proto_rawDescData)
+	})
+	return file_bitmarkd_proto_rawDescData
+}
+
+var file_bitmarkd_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
+var file_bitmarkd_proto_goTypes = []any{
+	(*Payment)(nil),                          // 0: bitmarkd.v1.Payment
+	(*PaymentAlternative)(nil),               // 1: bitmarkd.v1.PaymentAlternative
+	(*AssetData)(nil),                        // 2: bitmarkd.v1.AssetData
+	(*BitmarkIssue)(nil),                     // 3: bitmarkd.v1.BitmarkIssue
+	(*BitmarkTransfer)(nil),                  // 4: bitmarkd.v1.BitmarkTransfer
+	(*OldBaseData)(nil),                      // 5: bitmarkd.v1.OldBaseData
+	(*BlockFoundation)(nil),                  // 6: bitmarkd.v1.BlockFoundation
+	(*BlockOwnerTransfer)(nil),               // 7: bitmarkd.v1.BlockOwnerTransfer
+	(*BitmarkShare)(nil),                     // 8: bitmarkd.v1.BitmarkShare
+	(*ShareGrant)(nil),                       // 9: bitmarkd.v1.ShareGrant
+	(*ShareSwap)(nil),                        // 10: bitmarkd.v1.ShareSwap
+	(*TransactionRecord)(nil),                // 11: bitmarkd.v1.TransactionRecord
+	(*BlockHeader)(nil),                      // 12: bitmarkd.v1.BlockHeader
+	(*BlockTransaction)(nil),                 // 13: bitmarkd.v1.BlockTransaction
+	(*Block)(nil),                            // 14: bitmarkd.v1.Block
+	(*TransferReply)(nil),                    // 15: bitmarkd.v1.TransferReply
+	(*AssetsGetRequest)(nil),                 // 16: bitmarkd.v1.AssetsGetRequest
+	(*AssetRecord)(nil),                      // 17: bitmarkd.v1.AssetRecord
+	(*AssetsGetReply)(nil),                   // 18: bitmarkd.v1.AssetsGetReply
+	(*BitmarkTransferRequest)(nil),           // 19: bitmarkd.v1.BitmarkTransferRequest
+	(*BitmarkReplaceRequest)(nil),            // 20: bitmarkd.v1.BitmarkReplaceRequest
+	(*BitmarkProvenanceRequest)(nil),         // 21: bitmarkd.v1.BitmarkProvenanceRequest
+	(*ProvenanceRecord)(nil),                 // 22: bitmarkd.v1.ProvenanceRecord
+	(*BitmarkProvenanceReply)(nil),           // 23: bitmarkd.v1.BitmarkProvenanceReply
+	(*BitmarkFullProvenanceRequest)(nil),     // 24: bitmarkd.v1.BitmarkFullProvenanceRequest
+	(*BitmarkFullProvenanceReply)(nil),       // 25: bitmarkd.v1.BitmarkFullProvenanceReply
+	(*BitmarksCreateRequest)(nil),            // 26: bitmarkd.v1.BitmarksCreateRequest
+	(*AssetStatus)(nil),                      // 27: bitmarkd.v1.AssetStatus
+	(*BitmarksCreateReply)(nil),              // 28: bitmarkd.v1.BitmarksCreateReply
+	(*BitmarksProofRequest)(nil),             // 29: bitmarkd.v1.BitmarksProofRequest
+	(*BitmarksProofReply)(nil),               // 30: bitmarkd.v1.BitmarksProofReply
+	(*OwnerBitmarksRequest)(nil),             // 31: bitmarkd.v1.OwnerBitmarksRequest
+	(*OwnedBitmark)(nil),                     // 32: bitmarkd.v1.OwnedBitmark
+	(*OwnerRecord)(nil),                      // 33: bitmarkd.v1.OwnerRecord
+	(*OwnerBitmarksReply)(nil),               // 34: bitmarkd.v1.OwnerBitmarksReply
+	(*NodeListRequest)(nil),                  // 35: bitmarkd.v1.NodeListRequest
+	(*NodeEntry)(nil),                        // 36: bitmarkd.v1.NodeEntry
+	(*NodeListReply)(nil),                    // 37: bitmarkd.v1.NodeListReply
+	(*NodeInfoRequest)(nil),                  // 38: bitmarkd.v1.NodeInfoRequest
+	(*NodeInfoReply)(nil),                    // 39: bitmarkd.v1.NodeInfoReply
+	(*NodeForksRequest)(nil),                 // 40: bitmarkd.v1.NodeForksRequest
+	(*ForkTip)(nil),                          // 41: bitmarkd.v1.ForkTip
+	(*Reorg)(nil),                            // 42: bitmarkd.v1.Reorg
+	(*NodeForksReply)(nil),                   // 43: bitmarkd.v1.NodeForksReply
+	(*NodeBlockDumpRequest)(nil),             // 44: bitmarkd.v1.NodeBlockDumpRequest
+	(*NodeBlockDumpReply)(nil),               // 45: bitmarkd.v1.NodeBlockDumpReply
+	(*NodeBlockDecodeRequest)(nil),           // 46: bitmarkd.v1.NodeBlockDecodeRequest
+	(*NodeBlockDecodeReply)(nil),             // 47: bitmarkd.v1.NodeBlockDecodeReply
+	(*NodeBlockDumpRangeRequest)(nil),        // 48: bitmarkd.v1.NodeBlockDumpRangeRequest
+	(*NodeBlockDumpRangeReply)(nil),          // 49: bitmarkd.v1.NodeBlockDumpRangeReply
+	(*NodeBlockFilterRequest)(nil),           // 50: bitmarkd.v1.NodeBlockFilterRequest
+	(*NodeBlockFilterReply)(nil),             // 51: bitmarkd.v1.NodeBlockFilterReply
+	(*TransactionStatusRequest)(nil),         // 52: bitmarkd.v1.TransactionStatusRequest
+	(*TransactionStatusReply)(nil),           // 53: bitmarkd.v1.TransactionStatusReply
+	(*TransactionInclusionProofRequest)(nil), // 54: bitmarkd.v1.TransactionInclusionProofRequest
+	(*TransactionInclusionProofReply)(nil),   // 55: bitmarkd.v1.TransactionInclusionProofReply
+	(*BlockOwnerTxIDForBlockRequest)(nil),    // 56: bitmarkd.v1.BlockOwnerTxIDForBlockRequest
+	(*BlockOwnerTxIDForBlockReply)(nil),      // 57: bitmarkd.v1.BlockOwnerTxIDForBlockReply
+	(*BlockOwnerTransferRequest)(nil),        // 58: bitmarkd.v1.BlockOwnerTransferRequest
+	(*ShareCreateRequest)(nil),               // 59: bitmarkd.v1.ShareCreateRequest
+	(*ShareCreateReply)(nil),                 // 60: bitmarkd.v1.ShareCreateReply
+	(*ShareBalanceRequest)(nil),              // 61: bitmarkd.v1.ShareBalanceRequest
+	(*ShareBalance)(nil),                     // 62: bitmarkd.v1.ShareBalance
+	(*ShareBalanceReply)(nil),                // 63: bitmarkd.v1.ShareBalanceReply
+	(*ShareGrantRequest)(nil),                // 64: bitmarkd.v1.ShareGrantRequest
+	(*ShareGrantReply)(nil),                  // 65: bitmarkd.v1.ShareGrantReply
+	(*ShareSwapRequest)(nil),                 // 66: bitmarkd.v1.ShareSwapRequest
+	(*ShareSwapReply)(nil),                   // 67: bitmarkd.v1.ShareSwapReply
+	(*EventsRequest)(nil),                    // 68: bitmarkd.v1.EventsRequest
+	(*BlockEvent)(nil),                       // 69: bitmarkd.v1.BlockEvent
+	(*TransactionEvent)(nil),                 // 70: bitmarkd.v1.TransactionEvent
+	nil,                                      // 71: bitmarkd.v1.BlockFoundation.PaymentsEntry
+	nil,                                      // 72: bitmarkd.v1.BlockOwnerTransfer.PaymentsEntry
+	nil,                                      // 73: bitmarkd.v1.TransferReply.PaymentsEntry
+	nil,                                      // 74: bitmarkd.v1.ProvenanceRecord.MetadataEntry
+	nil,                                      // 75: bitmarkd.v1.BitmarksCreateReply.PaymentsEntry
+	nil,                                      // 76: bitmarkd.v1.OwnerBitmarksReply.TxEntry
+	(*NodeInfoReply_Block)(nil),              // 77: bitmarkd.v1.NodeInfoReply.Block
+	(*NodeInfoReply_Miner)(nil),              // 78: bitmarkd.v1.NodeInfoReply.Miner
+	(*NodeInfoReply_Counters)(nil),           // 79: bitmarkd.v1.NodeInfoReply.Counters
+	(*NodeInfoReply_Sync)(nil),               // 80: bitmarkd.v1.NodeInfoReply.Sync
+	nil,                                      // 81: bitmarkd.v1.ShareCreateReply.PaymentsEntry
+	nil,                                      // 82: bitmarkd.v1.ShareGrantReply.PaymentsEntry
+	nil,                                      // 83: bitmarkd.v1.ShareSwapReply.PaymentsEntry
+}
+var file_bitmarkd_proto_depIdxs = []int32{
+	0,  // 0: bitmarkd.v1.PaymentAlternative.payments:type_name -> bitmarkd.v1.Payment
+	0,  // 1: bitmarkd.v1.BitmarkTransfer.escrow:type_name -> bitmarkd.v1.Payment
+	71, // 2: bitmarkd.v1.BlockFoundation.payments:type_name -> bitmarkd.v1.BlockFoundation.PaymentsEntry
+	0,  // 3: bitmarkd.v1.BlockOwnerTransfer.escrow:type_name -> bitmarkd.v1.Payment
+	72, // 4: bitmarkd.v1.BlockOwnerTransfer.payments:type_name -> bitmarkd.v1.BlockOwnerTransfer.PaymentsEntry
+	5,  // 5: bitmarkd.v1.TransactionRecord.base_data:type_name -> bitmarkd.v1.OldBaseData
+	2,  // 6: bitmarkd.v1.TransactionRecord.asset_data:type_name -> bitmarkd.v1.AssetData
+	3,  // 7: bitmarkd.v1.TransactionRecord.bitmark_issue:type_name -> bitmarkd.v1.BitmarkIssue
+	4,  // 8: bitmarkd.v1.TransactionRecord.bitmark_transfer_unratified:type_name -> bitmarkd.v1.BitmarkTransfer
+	4,  // 9: bitmarkd.v1.TransactionRecord.bitmark_transfer_countersigned:type_name -> bitmarkd.v1.BitmarkTransfer
+	6,  // 10: bitmarkd.v1.TransactionRecord.block_foundation:type_name -> bitmarkd.v1.BlockFoundation
+	7,  // 11: bitmarkd.v1.TransactionRecord.block_owner_transfer:type_name -> bitmarkd.v1.BlockOwnerTransfer
+	8,  // 12: bitmarkd.v1.TransactionRecord.bitmark_share:type_name -> bitmarkd.v1.BitmarkShare
+	9,  // 13: bitmarkd.v1.TransactionRecord.share_grant:type_name -> bitmarkd.v1.ShareGrant
+	10, // 14: bitmarkd.v1.TransactionRecord.share_swap:type_name -> bitmarkd.v1.ShareSwap
+	11, // 15: bitmarkd.v1.BlockTransaction.data:type_name -> bitmarkd.v1.TransactionRecord
+	12, // 16: bitmarkd.v1.Block.header:type_name -> bitmarkd.v1.BlockHeader
+	13, // 17: bitmarkd.v1.Block.transactions:type_name -> bitmarkd.v1.BlockTransaction
+	73, // 18: bitmarkd.v1.TransferReply.payments:type_name -> bitmarkd.v1.TransferReply.PaymentsEntry
+	2,  // 19: bitmarkd.v1.AssetRecord.data:type_name -> bitmarkd.v1.AssetData
+	17, // 20: bitmarkd.v1.AssetsGetReply.assets:type_name -> bitmarkd.v1.AssetRecord
+	4,  // 21: bitmarkd.v1.BitmarkTransferRequest.transfer:type_name -> bitmarkd.v1.BitmarkTransfer
+	4,  // 22: bitmarkd.v1.BitmarkReplaceRequest.transfer:type_name -> bitmarkd.v1.BitmarkTransfer
+	11, // 23: bitmarkd.v1.ProvenanceRecord.data:type_name -> bitmarkd.v1.TransactionRecord
+	74, // 24: bitmarkd.v1.ProvenanceRecord.metadata:type_name -> bitmarkd.v1.ProvenanceRecord.MetadataEntry
+	22, // 25: bitmarkd.v1.BitmarkProvenanceReply.data:type_name -> bitmarkd.v1.ProvenanceRecord
+	22, // 26: bitmarkd.v1.BitmarkFullProvenanceReply.data:type_name -> bitmarkd.v1.ProvenanceRecord
+	2,  // 27: bitmarkd.v1.BitmarksCreateRequest.assets:type_name -> bitmarkd.v1.AssetData
+	3,  // 28: bitmarkd.v1.BitmarksCreateRequest.issues:type_name -> bitmarkd.v1.BitmarkIssue
+	27, // 29: bitmarkd.v1.BitmarksCreateReply.assets:type_name -> bitmarkd.v1.AssetStatus
+	75, // 30: bitmarkd.v1.BitmarksCreateReply.payments:type_name -> bitmarkd.v1.BitmarksCreateReply.PaymentsEntry
+	11, // 31: bitmarkd.v1.OwnerRecord.data:type_name -> bitmarkd.v1.TransactionRecord
+	32, // 32: bitmarkd.v1.OwnerBitmarksReply.data:type_name -> bitmarkd.v1.OwnedBitmark
+	76, // 33: bitmarkd.v1.OwnerBitmarksReply.tx:type_name -> bitmarkd.v1.OwnerBitmarksReply.TxEntry
+	36, // 34: bitmarkd.v1.NodeListReply.nodes:type_name -> bitmarkd.v1.NodeEntry
+	77, // 35: bitmarkd.v1.NodeInfoReply.block:type_name -> bitmarkd.v1.NodeInfoReply.Block
+	78, // 36: bitmarkd.v1.NodeInfoReply.miner:type_name -> bitmarkd.v1.NodeInfoReply.Miner
+	79, // 37: bitmarkd.v1.NodeInfoReply.transaction_counters:type_name -> bitmarkd.v1.NodeInfoReply.Counters
+	80, // 38: bitmarkd.v1.NodeInfoReply.sync:type_name -> bitmarkd.v1.NodeInfoReply.Sync
+	41, // 39: bitmarkd.v1.NodeForksReply.tips:type_name -> bitmarkd.v1.ForkTip
+	42, // 40: bitmarkd.v1.NodeForksReply.reorgs:type_name -> bitmarkd.v1.Reorg
+	42, // 41: bitmarkd.v1.NodeForksReply.halted:type_name -> bitmarkd.v1.Reorg
+	14, // 42: bitmarkd.v1.NodeBlockDumpReply.block:type_name -> bitmarkd.v1.Block
+	14, // 43: bitmarkd.v1.NodeBlockDecodeReply.block:type_name -> bitmarkd.v1.Block
+	14, // 44: bitmarkd.v1.NodeBlockDumpRangeReply.blocks:type_name -> bitmarkd.v1.Block
+	12, // 45: bitmarkd.v1.TransactionInclusionProofReply.header:type_name -> bitmarkd.v1.BlockHeader
+	7,  // 46: bitmarkd.v1.BlockOwnerTransferRequest.transfer:type_name -> bitmarkd.v1.BlockOwnerTransfer
+	8,  // 47: bitmarkd.v1.ShareCreateRequest.share:type_name -> bitmarkd.v1.BitmarkShare
+	81, // 48: bitmarkd.v1.ShareCreateReply.payments:type_name -> bitmarkd.v1.ShareCreateReply.PaymentsEntry
+	62, // 49: bitmarkd.v1.ShareBalanceReply.balances:type_name -> bitmarkd.v1.ShareBalance
+	9,  // 50: bitmarkd.v1.ShareGrantRequest.grant:type_name -> bitmarkd.v1.ShareGrant
+	82, // 51: bitmarkd.v1.ShareGrantReply.payments:type_name -> bitmarkd.v1.ShareGrantReply.PaymentsEntry
+	10, // 52: bitmarkd.v1.ShareSwapRequest.swap:type_name -> bitmarkd.v1.ShareSwap
+	83, // 53: bitmarkd.v1.ShareSwapReply.payments:type_name -> bitmarkd.v1.ShareSwapReply.PaymentsEntry
+	1,  // 54: bitmarkd.v1.TransferReply.PaymentsEntry.value:type_name -> bitmarkd.v1.PaymentAlternative
+	1,  // 55: bitmarkd.v1.BitmarksCreateReply.PaymentsEntry.value:type_name -> bitmarkd.v1.PaymentAlternative
+	33, // 56: bitmarkd.v1.OwnerBitmarksReply.TxEntry.value:type_name -> bitmarkd.v1.OwnerRecord
+	1,  // 57: bitmarkd.v1.ShareCreateReply.PaymentsEntry.value:type_name -> bitmarkd.v1.PaymentAlternative
+	1,  // 58: bitmarkd.v1.ShareGrantReply.PaymentsEntry.value:type_name -> bitmarkd.v1.PaymentAlternative
+	1,  // 59: bitmarkd.v1.ShareSwapReply.PaymentsEntry.value:type_name -> bitmarkd.v1.PaymentAlternative
+	16, // 60: bitmarkd.v1.Assets.Get:input_type -> bitmarkd.v1.AssetsGetRequest
+	19, // 61: bitmarkd.v1.Bitmark.Transfer:input_type -> bitmarkd.v1.BitmarkTransferRequest
+	20, // 62: bitmarkd.v1.Bitmark.Replace:input_type -> bitmarkd.v1.BitmarkReplaceRequest
+	21, // 63: bitmarkd.v1.Bitmark.Provenance:input_type -> bitmarkd.v1.BitmarkProvenanceRequest
+	24, // 64: bitmarkd.v1.Bitmark.FullProvenance:input_type -> bitmarkd.v1.BitmarkFullProvenanceRequest
+	26, // 65: bitmarkd.v1.Bitmarks.Create:input_type -> bitmarkd.v1.BitmarksCreateRequest
+	29, // 66: bitmarkd.v1.Bitmarks.Proof:input_type -> bitmarkd.v1.BitmarksProofRequest
+	31, // 67: bitmarkd.v1.Owner.Bitmarks:input_type -> bitmarkd.v1.OwnerBitmarksRequest
+	35, // 68: bitmarkd.v1.Node.List:input_type -> bitmarkd.v1.NodeListRequest
+	38, // 69: bitmarkd.v1.Node.Info:input_type -> bitmarkd.v1.NodeInfoRequest
+	40, // 70: bitmarkd.v1.Node.Forks:input_type -> bitmarkd.v1.NodeForksRequest
+	44, // 71: bitmarkd.v1.Node.BlockDump:input_type -> bitmarkd.v1.NodeBlockDumpRequest
+	46, // 72: bitmarkd.v1.Node.BlockDecode:input_type -> bitmarkd.v1.NodeBlockDecodeRequest
+	48, // 73: bitmarkd.v1.Node.BlockDumpRange:input_type -> bitmarkd.v1.NodeBlockDumpRangeRequest
+	50, // 74: bitmarkd.v1.Node.BlockFilter:input_type -> bitmarkd.v1.NodeBlockFilterRequest
+	68, // 75: bitmarkd.v1.Node.Blocks:input_type -> bitmarkd.v1.EventsRequest
+	52, // 76: bitmarkd.v1.Transaction.Status:input_type -> bitmarkd.v1.TransactionStatusRequest
+	54, // 77: bitmarkd.v1.Transaction.InclusionProof:input_type -> bitmarkd.v1.TransactionInclusionProofRequest
+	68, // 78: bitmarkd.v1.Transaction.Events:input_type -> bitmarkd.v1.EventsRequest
+	56, // 79: bitmarkd.v1.BlockOwner.TxIDForBlock:input_type -> bitmarkd.v1.BlockOwnerTxIDForBlockRequest
+	58, // 80: bitmarkd.v1.BlockOwner.Transfer:input_type -> bitmarkd.v1.BlockOwnerTransferRequest
+	59, // 81: bitmarkd.v1.Share.Create:input_type -> bitmarkd.v1.ShareCreateRequest
+	61, // 82: bitmarkd.v1.Share.Balance:input_type -> bitmarkd.v1.ShareBalanceRequest
+	64, // 83: bitmarkd.v1.Share.Grant:input_type -> bitmarkd.v1.ShareGrantRequest
+	66, // 84: bitmarkd.v1.Share.Swap:input_type -> bitmarkd.v1.ShareSwapRequest
+	18, // 85: bitmarkd.v1.Assets.Get:output_type -> bitmarkd.v1.AssetsGetReply
+	15, // 86: bitmarkd.v1.Bitmark.Transfer:output_type -> bitmarkd.v1.TransferReply
+	15, // 87: bitmarkd.v1.Bitmark.Replace:output_type -> bitmarkd.v1.TransferReply
+	23, // 88: bitmarkd.v1.Bitmark.Provenance:output_type -> bitmarkd.v1.BitmarkProvenanceReply
+	25, // 89: bitmarkd.v1.Bitmark.FullProvenance:output_type -> bitmarkd.v1.BitmarkFullProvenanceReply
+	28, // 90: bitmarkd.v1.Bitmarks.Create:output_type -> bitmarkd.v1.BitmarksCreateReply
+	30, // 91: bitmarkd.v1.Bitmarks.Proof:output_type -> bitmarkd.v1.BitmarksProofReply
+	34, // 92: bitmarkd.v1.Owner.Bitmarks:output_type -> bitmarkd.v1.OwnerBitmarksReply
+	37, // 93: bitmarkd.v1.Node.List:output_type -> bitmarkd.v1.NodeListReply
+	39, // 94: bitmarkd.v1.Node.Info:output_type -> bitmarkd.v1.NodeInfoReply
+	43, // 95: bitmarkd.v1.Node.Forks:output_type -> bitmarkd.v1.NodeForksReply
+	45, // 96: bitmarkd.v1.Node.BlockDump:output_type -> bitmarkd.v1.NodeBlockDumpReply
+	47, // 97: bitmarkd.v1.Node.BlockDecode:output_type -> bitmarkd.v1.NodeBlockDecodeReply
+	49, // 98: bitmarkd.v1.Node.BlockDumpRange:output_type -> bitmarkd.v1.NodeBlockDumpRangeReply
+	51, // 99: bitmarkd.v1.Node.BlockFilter:output_type -> bitmarkd.v1.NodeBlockFilterReply
+	69, // 100: bitmarkd.v1.Node.Blocks:output_type -> bitmarkd.v1.BlockEvent
+	53, // 101: bitmarkd.v1.Transaction.Status:output_type -> bitmarkd.v1.TransactionStatusReply
+	55, // 102: bitmarkd.v1.Transaction.InclusionProof:output_type -> bitmarkd.v1.TransactionInclusionProofReply
+	70, // 103: bitmarkd.v1.Transaction.Events:output_type -> bitmarkd.v1.TransactionEvent
+	57, // 104: bitmarkd.v1.BlockOwner.TxIDForBlock:output_type -> bitmarkd.v1.BlockOwnerTxIDForBlockReply
+	15, // 105: bitmarkd.v1.BlockOwner.Transfer:output_type -> bitmarkd.v1.TransferReply
+	60, // 106: bitmarkd.v1.Share.Create:output_type -> bitmarkd.v1.ShareCreateReply
+	63, // 107: bitmarkd.v1.Share.Balance:output_type -> bitmarkd.v1.ShareBalanceReply
+	65, // 108: bitmarkd.v1.Share.Grant:output_type -> bitmarkd.v1.ShareGrantReply
+	67, // 109: bitmarkd.v1.Share.Swap:output_type -> bitmarkd.v1.ShareSwapReply
+	85, // [85:110] is the sub-list for method output_type
+	60, // [60:85] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
+}
+
+func init() { file_bitmarkd_proto_init() }
+func file_bitmarkd_proto_init() {
+	if File_bitmarkd_proto != nil {
+		return
+	}
+	file_bitmarkd_proto_msgTypes[11].OneofWrappers = []any{
+		(*TransactionRecord_BaseData)(nil),
+		(*TransactionRecord_AssetData)(nil),
+		(*TransactionRecord_BitmarkIssue)(nil),
+		(*TransactionRecord_BitmarkTransferUnratified)(nil),
+		(*TransactionRecord_BitmarkTransferCountersigned)(nil),
+		(*TransactionRecord_BlockFoundation)(nil),
+		(*TransactionRecord_BlockOwnerTransfer)(nil),
+		(*TransactionRecord_BitmarkShare)(nil),
+		(*TransactionRecord_ShareGrant)(nil),
+		(*TransactionRecord_ShareSwap)(nil),
+	}
+	file_bitmarkd_proto_msgTypes[32].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_bitmarkd_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   84,
+			NumExtensions: 0,
+			NumServices:   8,
+		},
+		GoTypes:           file_bitmarkd_proto_goTypes,
+		DependencyIndexes: file_bitmarkd_proto_depIdxs,
+		MessageInfos:      file_bitmarkd_proto_msgTypes,
+	}.Build()
+	File_bitmarkd_proto = out.File
+	file_bitmarkd_proto_rawDesc = nil
+	file_bitmarkd_proto_goTypes = nil
+	file_bitmarkd_proto_depIdxs = nil
+}
@@ -0,0 +1,1508 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// gRPC access to the bitmarkd client API
+//
+// every unary method calls the JSON-RPC method of the same service and
+// name, e.g. Assets/Get is Assets.Get, with its arguments and result
+// carried in the fields of typed messages named after the method
+//
+// digests, identifiers and accounts are the same text as in JSON-RPC;
+// signatures and packed records are bytes; an empty string is an
+// absent value
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.3
+// source: bitmarkd.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Assets_Get_FullMethodName = "/bitmarkd.v1.Assets/Get"
+)
+
+// AssetsClient is the client API for Assets service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AssetsClient interface {
+	Get(ctx context.Context, in *AssetsGetRequest, opts ...grpc.CallOption) (*AssetsGetReply, error)
+}
+
+type assetsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssetsClient(cc grpc.ClientConnInterface) AssetsClient {
+	return &assetsClient{cc}
+}
+
+func (c *assetsClient) Get(ctx context.Context, in *AssetsGetRequest, opts ...grpc.CallOption) (*AssetsGetReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssetsGetReply)
+	err := c.cc.Invoke(ctx, Assets_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssetsServer is the server API for Assets service.
+// All implementations must embed UnimplementedAssetsServer
+// for forward compatibility.
+type AssetsServer interface {
+	Get(context.Context, *AssetsGetRequest) (*AssetsGetReply, error)
+	mustEmbedUnimplementedAssetsServer()
+}
+
+// UnimplementedAssetsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssetsServer struct{}
+
+func (UnimplementedAssetsServer) Get(context.Context, *AssetsGetRequest) (*AssetsGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedAssetsServer) mustEmbedUnimplementedAssetsServer() {}
+func (UnimplementedAssetsServer) testEmbeddedByValue()                {}
+
+// UnsafeAssetsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssetsServer will
+// result in compilation errors.
+type UnsafeAssetsServer interface {
+	mustEmbedUnimplementedAssetsServer()
+}
+
+func RegisterAssetsServer(s grpc.ServiceRegistrar, srv AssetsServer) {
+	// If the following call pancis, it indicates UnimplementedAssetsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Assets_ServiceDesc, srv)
+}
+
+func _Assets_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssetsGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Assets_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsServer).Get(ctx, req.(*AssetsGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Assets_ServiceDesc is the grpc.ServiceDesc for Assets service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Assets_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitmarkd.v1.Assets",
+	HandlerType: (*AssetsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Assets_Get_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitmarkd.proto",
+}
+
+const (
+	Bitmark_Transfer_FullMethodName       = "/bitmarkd.v1.Bitmark/Transfer"
+	Bitmark_Replace_FullMethodName        = "/bitmarkd.v1.Bitmark/Replace"
+	Bitmark_Provenance_FullMethodName     = "/bitmarkd.v1.Bitmark/Provenance"
+	Bitmark_FullProvenance_FullMethodName = "/bitmarkd.v1.Bitmark/FullProvenance"
+)
+
+// BitmarkClient is the client API for Bitmark service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BitmarkClient interface {
+	Transfer(ctx context.Context, in *BitmarkTransferRequest, opts ...grpc.CallOption) (*TransferReply, error)
+	Replace(ctx context.Context, in *BitmarkReplaceRequest, opts ...grpc.CallOption) (*TransferReply, error)
+	Provenance(ctx context.Context, in *BitmarkProvenanceRequest, opts ...grpc.CallOption) (*BitmarkProvenanceReply, error)
+	FullProvenance(ctx context.Context, in *BitmarkFullProvenanceRequest, opts ...grpc.CallOption) (*BitmarkFullProvenanceReply, error)
+}
+
+type bitmarkClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBitmarkClient(cc grpc.ClientConnInterface) BitmarkClient {
+	return &bitmarkClient{cc}
+}
+
+func (c *bitmarkClient) Transfer(ctx context.Context, in *BitmarkTransferRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, Bitmark_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bitmarkClient) Replace(ctx context.Context, in *BitmarkReplaceRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, Bitmark_Replace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bitmarkClient) Provenance(ctx context.Context, in *BitmarkProvenanceRequest, opts ...grpc.CallOption) (*BitmarkProvenanceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BitmarkProvenanceReply)
+	err := c.cc.Invoke(ctx, Bitmark_Provenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bitmarkClient) FullProvenance(ctx context.Context, in *BitmarkFullProvenanceRequest, opts ...grpc.CallOption) (*BitmarkFullProvenanceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BitmarkFullProvenanceReply)
+	err := c.cc.Invoke(ctx, Bitmark_FullProvenance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BitmarkServer is the server API for Bitmark service.
+// All implementations must embed UnimplementedBitmarkServer
+// for forward compatibility.
+type BitmarkServer interface {
+	Transfer(context.Context, *BitmarkTransferRequest) (*TransferReply, error)
+	Replace(context.Context, *BitmarkReplaceRequest) (*TransferReply, error)
+	Provenance(context.Context, *BitmarkProvenanceRequest) (*BitmarkProvenanceReply, error)
+	FullProvenance(context.Context, *BitmarkFullProvenanceRequest) (*BitmarkFullProvenanceReply, error)
+	mustEmbedUnimplementedBitmarkServer()
+}
+
+// UnimplementedBitmarkServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBitmarkServer struct{}
+
+func (UnimplementedBitmarkServer) Transfer(context.Context, *BitmarkTransferRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedBitmarkServer) Replace(context.Context, *BitmarkReplaceRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replace not implemented")
+}
+func (UnimplementedBitmarkServer) Provenance(context.Context, *BitmarkProvenanceRequest) (*BitmarkProvenanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Provenance not implemented")
+}
+func (UnimplementedBitmarkServer) FullProvenance(context.Context, *BitmarkFullProvenanceRequest) (*BitmarkFullProvenanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FullProvenance not implemented")
+}
+func (UnimplementedBitmarkServer) mustEmbedUnimplementedBitmarkServer() {}
+func (UnimplementedBitmarkServer) testEmbeddedByValue()                 {}
+
+// UnsafeBitmarkServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BitmarkServer will
+// result in compilation errors.
+type UnsafeBitmarkServer interface {
+	mustEmbedUnimplementedBitmarkServer()
+}
+
+func RegisterBitmarkServer(s grpc.ServiceRegistrar, srv BitmarkServer) {
+	// If the following call pancis, it indicates UnimplementedBitmarkServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Bitmark_ServiceDesc, srv)
+}
+
+func _Bitmark_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitmarkTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitmarkServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bitmark_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitmarkServer).Transfer(ctx, req.(*BitmarkTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bitmark_Replace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitmarkReplaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitmarkServer).Replace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bitmark_Replace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitmarkServer).Replace(ctx, req.(*BitmarkReplaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bitmark_Provenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitmarkProvenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitmarkServer).Provenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bitmark_Provenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitmarkServer).Provenance(ctx, req.(*BitmarkProvenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bitmark_FullProvenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitmarkFullProvenanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitmarkServer).FullProvenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bitmark_FullProvenance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitmarkServer).FullProvenance(ctx, req.(*BitmarkFullProvenanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Bitmark_ServiceDesc is the grpc.ServiceDesc for Bitmark service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Bitmark_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitmarkd.v1.Bitmark",
+	HandlerType: (*BitmarkServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Transfer",
+			Handler:    _Bitmark_Transfer_Handler,
+		},
+		{
+			MethodName: "Replace",
+			Handler:    _Bitmark_Replace_Handler,
+		},
+		{
+			MethodName: "Provenance",
+			Handler:    _Bitmark_Provenance_Handler,
+		},
+		{
+			MethodName: "FullProvenance",
+			Handler:    _Bitmark_FullProvenance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitmarkd.proto",
+}
+
+const (
+	Bitmarks_Create_FullMethodName = "/bitmarkd.v1.Bitmarks/Create"
+	Bitmarks_Proof_FullMethodName  = "/bitmarkd.v1.Bitmarks/Proof"
+)
+
+// BitmarksClient is the client API for Bitmarks service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BitmarksClient interface {
+	Create(ctx context.Context, in *BitmarksCreateRequest, opts ...grpc.CallOption) (*BitmarksCreateReply, error)
+	Proof(ctx context.Context, in *BitmarksProofRequest, opts ...grpc.CallOption) (*BitmarksProofReply, error)
+}
+
+type bitmarksClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBitmarksClient(cc grpc.ClientConnInterface) BitmarksClient {
+	return &bitmarksClient{cc}
+}
+
+func (c *bitmarksClient) Create(ctx context.Context, in *BitmarksCreateRequest, opts ...grpc.CallOption) (*BitmarksCreateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BitmarksCreateReply)
+	err := c.cc.Invoke(ctx, Bitmarks_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bitmarksClient) Proof(ctx context.Context, in *BitmarksProofRequest, opts ...grpc.CallOption) (*BitmarksProofReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BitmarksProofReply)
+	err := c.cc.Invoke(ctx, Bitmarks_Proof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BitmarksServer is the server API for Bitmarks service.
+// All implementations must embed UnimplementedBitmarksServer
+// for forward compatibility.
+type BitmarksServer interface {
+	Create(context.Context, *BitmarksCreateRequest) (*BitmarksCreateReply, error)
+	Proof(context.Context, *BitmarksProofRequest) (*BitmarksProofReply, error)
+	mustEmbedUnimplementedBitmarksServer()
+}
+
+// UnimplementedBitmarksServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBitmarksServer struct{}
+
+func (UnimplementedBitmarksServer) Create(context.Context, *BitmarksCreateRequest) (*BitmarksCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedBitmarksServer) Proof(context.Context, *BitmarksProofRequest) (*BitmarksProofReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proof not implemented")
+}
+func (UnimplementedBitmarksServer) mustEmbedUnimplementedBitmarksServer() {}
+func (UnimplementedBitmarksServer) testEmbeddedByValue()                  {}
+
+// UnsafeBitmarksServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BitmarksServer will
+// result in compilation errors.
+type UnsafeBitmarksServer interface {
+	mustEmbedUnimplementedBitmarksServer()
+}
+
+func RegisterBitmarksServer(s grpc.ServiceRegistrar, srv BitmarksServer) {
+	// If the following call pancis, it indicates UnimplementedBitmarksServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Bitmarks_ServiceDesc, srv)
+}
+
+func _Bitmarks_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitmarksCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitmarksServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bitmarks_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitmarksServer).Create(ctx, req.(*BitmarksCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bitmarks_Proof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitmarksProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BitmarksServer).Proof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Bitmarks_Proof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BitmarksServer).Proof(ctx, req.(*BitmarksProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Bitmarks_ServiceDesc is the grpc.ServiceDesc for Bitmarks service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Bitmarks_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitmarkd.v1.Bitmarks",
+	HandlerType: (*BitmarksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Bitmarks_Create_Handler,
+		},
+		{
+			MethodName: "Proof",
+			Handler:    _Bitmarks_Proof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitmarkd.proto",
+}
+
+const (
+	Owner_Bitmarks_FullMethodName = "/bitmarkd.v1.Owner/Bitmarks"
+)
+
+// OwnerClient is the client API for Owner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OwnerClient interface {
+	Bitmarks(ctx context.Context, in *OwnerBitmarksRequest, opts ...grpc.CallOption) (*OwnerBitmarksReply, error)
+}
+
+type ownerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOwnerClient(cc grpc.ClientConnInterface) OwnerClient {
+	return &ownerClient{cc}
+}
+
+func (c *ownerClient) Bitmarks(ctx context.Context, in *OwnerBitmarksRequest, opts ...grpc.CallOption) (*OwnerBitmarksReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OwnerBitmarksReply)
+	err := c.cc.Invoke(ctx, Owner_Bitmarks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OwnerServer is the server API for Owner service.
+// All implementations must embed UnimplementedOwnerServer
+// for forward compatibility.
+type OwnerServer interface {
+	Bitmarks(context.Context, *OwnerBitmarksRequest) (*OwnerBitmarksReply, error)
+	mustEmbedUnimplementedOwnerServer()
+}
+
+// UnimplementedOwnerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOwnerServer struct{}
+
+func (UnimplementedOwnerServer) Bitmarks(context.Context, *OwnerBitmarksRequest) (*OwnerBitmarksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bitmarks not implemented")
+}
+func (UnimplementedOwnerServer) mustEmbedUnimplementedOwnerServer() {}
+func (UnimplementedOwnerServer) testEmbeddedByValue()               {}
+
+// UnsafeOwnerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OwnerServer will
+// result in compilation errors.
+type UnsafeOwnerServer interface {
+	mustEmbedUnimplementedOwnerServer()
+}
+
+func RegisterOwnerServer(s grpc.ServiceRegistrar, srv OwnerServer) {
+	// If the following call pancis, it indicates UnimplementedOwnerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Owner_ServiceDesc, srv)
+}
+
+func _Owner_Bitmarks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OwnerBitmarksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OwnerServer).Bitmarks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Owner_Bitmarks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OwnerServer).Bitmarks(ctx, req.(*OwnerBitmarksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Owner_ServiceDesc is the grpc.ServiceDesc for Owner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Owner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitmarkd.v1.Owner",
+	HandlerType: (*OwnerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Bitmarks",
+			Handler:    _Owner_Bitmarks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitmarkd.proto",
+}
+
+const (
+	Node_List_FullMethodName           = "/bitmarkd.v1.Node/List"
+	Node_Info_FullMethodName           = "/bitmarkd.v1.Node/Info"
+	Node_Forks_FullMethodName          = "/bitmarkd.v1.Node/Forks"
+	Node_BlockDump_FullMethodName      = "/bitmarkd.v1.Node/BlockDump"
+	Node_BlockDecode_FullMethodName    = "/bitmarkd.v1.Node/BlockDecode"
+	Node_BlockDumpRange_FullMethodName = "/bitmarkd.v1.Node/BlockDumpRange"
+	Node_BlockFilter_FullMethodName    = "/bitmarkd.v1.Node/BlockFilter"
+	Node_Blocks_FullMethodName         = "/bitmarkd.v1.Node/Blocks"
+)
+
+// NodeClient is the client API for Node service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NodeClient interface {
+	List(ctx context.Context, in *NodeListRequest, opts ...grpc.CallOption) (*NodeListReply, error)
+	Info(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfoReply, error)
+	Forks(ctx context.Context, in *NodeForksRequest, opts ...grpc.CallOption) (*NodeForksReply, error)
+	BlockDump(ctx context.Context, in *NodeBlockDumpRequest, opts ...grpc.CallOption) (*NodeBlockDumpReply, error)
+	BlockDecode(ctx context.Context, in *NodeBlockDecodeRequest, opts ...grpc.CallOption) (*NodeBlockDecodeReply, error)
+	BlockDumpRange(ctx context.Context, in *NodeBlockDumpRangeRequest, opts ...grpc.CallOption) (*NodeBlockDumpRangeReply, error)
+	BlockFilter(ctx context.Context, in *NodeBlockFilterRequest, opts ...grpc.CallOption) (*NodeBlockFilterReply, error)
+	// blocks as they are stored
+	Blocks(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlockEvent], error)
+}
+
+type nodeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNodeClient(cc grpc.ClientConnInterface) NodeClient {
+	return &nodeClient{cc}
+}
+
+func (c *nodeClient) List(ctx context.Context, in *NodeListRequest, opts ...grpc.CallOption) (*NodeListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeListReply)
+	err := c.cc.Invoke(ctx, Node_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Info(ctx context.Context, in *NodeInfoRequest, opts ...grpc.CallOption) (*NodeInfoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeInfoReply)
+	err := c.cc.Invoke(ctx, Node_Info_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Forks(ctx context.Context, in *NodeForksRequest, opts ...grpc.CallOption) (*NodeForksReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeForksReply)
+	err := c.cc.Invoke(ctx, Node_Forks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) BlockDump(ctx context.Context, in *NodeBlockDumpRequest, opts ...grpc.CallOption) (*NodeBlockDumpReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeBlockDumpReply)
+	err := c.cc.Invoke(ctx, Node_BlockDump_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) BlockDecode(ctx context.Context, in *NodeBlockDecodeRequest, opts ...grpc.CallOption) (*NodeBlockDecodeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeBlockDecodeReply)
+	err := c.cc.Invoke(ctx, Node_BlockDecode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) BlockDumpRange(ctx context.Context, in *NodeBlockDumpRangeRequest, opts ...grpc.CallOption) (*NodeBlockDumpRangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeBlockDumpRangeReply)
+	err := c.cc.Invoke(ctx, Node_BlockDumpRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) BlockFilter(ctx context.Context, in *NodeBlockFilterRequest, opts ...grpc.CallOption) (*NodeBlockFilterReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NodeBlockFilterReply)
+	err := c.cc.Invoke(ctx, Node_BlockFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Blocks(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BlockEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Node_ServiceDesc.Streams[0], Node_Blocks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EventsRequest, BlockEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Node_BlocksClient = grpc.ServerStreamingClient[BlockEvent]
+
+// NodeServer is the server API for Node service.
+// All implementations must embed UnimplementedNodeServer
+// for forward compatibility.
+type NodeServer interface {
+	List(context.Context, *NodeListRequest) (*NodeListReply, error)
+	Info(context.Context, *NodeInfoRequest) (*NodeInfoReply, error)
+	Forks(context.Context, *NodeForksRequest) (*NodeForksReply, error)
+	BlockDump(context.Context, *NodeBlockDumpRequest) (*NodeBlockDumpReply, error)
+	BlockDecode(context.Context, *NodeBlockDecodeRequest) (*NodeBlockDecodeReply, error)
+	BlockDumpRange(context.Context, *NodeBlockDumpRangeRequest) (*NodeBlockDumpRangeReply, error)
+	BlockFilter(context.Context, *NodeBlockFilterRequest) (*NodeBlockFilterReply, error)
+	// blocks as they are stored
+	Blocks(*EventsRequest, grpc.ServerStreamingServer[BlockEvent]) error
+	mustEmbedUnimplementedNodeServer()
+}
+
+// UnimplementedNodeServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNodeServer struct{}
+
+func (UnimplementedNodeServer) List(context.Context, *NodeListRequest) (*NodeListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedNodeServer) Info(context.Context, *NodeInfoRequest) (*NodeInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
+}
+func (UnimplementedNodeServer) Forks(context.Context, *NodeForksRequest) (*NodeForksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Forks not implemented")
+}
+func (UnimplementedNodeServer) BlockDump(context.Context, *NodeBlockDumpRequest) (*NodeBlockDumpReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockDump not implemented")
+}
+func (UnimplementedNodeServer) BlockDecode(context.Context, *NodeBlockDecodeRequest) (*NodeBlockDecodeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockDecode not implemented")
+}
+func (UnimplementedNodeServer) BlockDumpRange(context.Context, *NodeBlockDumpRangeRequest) (*NodeBlockDumpRangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockDumpRange not implemented")
+}
+func (UnimplementedNodeServer) BlockFilter(context.Context, *NodeBlockFilterRequest) (*NodeBlockFilterReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockFilter not implemented")
+}
+func (UnimplementedNodeServer) Blocks(*EventsRequest, grpc.ServerStreamingServer[BlockEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Blocks not implemented")
+}
+func (UnimplementedNodeServer) mustEmbedUnimplementedNodeServer() {}
+func (UnimplementedNodeServer) testEmbeddedByValue()              {}
+
+// UnsafeNodeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NodeServer will
+// result in compilation errors.
+type UnsafeNodeServer interface {
+	mustEmbedUnimplementedNodeServer()
+}
+
+func RegisterNodeServer(s grpc.ServiceRegistrar, srv NodeServer) {
+	// If the following call pancis, it indicates UnimplementedNodeServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Node_ServiceDesc, srv)
+}
+
+func _Node_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).List(ctx, req.(*NodeListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Info(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_Info_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Info(ctx, req.(*NodeInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Forks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Forks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_Forks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Forks(ctx, req.(*NodeForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_BlockDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeBlockDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).BlockDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_BlockDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).BlockDump(ctx, req.(*NodeBlockDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_BlockDecode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeBlockDecodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).BlockDecode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_BlockDecode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).BlockDecode(ctx, req.(*NodeBlockDecodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_BlockDumpRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeBlockDumpRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).BlockDumpRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_BlockDumpRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).BlockDumpRange(ctx, req.(*NodeBlockDumpRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_BlockFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeBlockFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).BlockFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Node_BlockFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).BlockFilter(ctx, req.(*NodeBlockFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Blocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NodeServer).Blocks(m, &grpc.GenericServerStream[EventsRequest, BlockEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Node_BlocksServer = grpc.ServerStreamingServer[BlockEvent]
+
+// Node_ServiceDesc is the grpc.ServiceDesc for Node service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Node_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitmarkd.v1.Node",
+	HandlerType: (*NodeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Node_List_Handler,
+		},
+		{
+			MethodName: "Info",
+			Handler:    _Node_Info_Handler,
+		},
+		{
+			MethodName: "Forks",
+			Handler:    _Node_Forks_Handler,
+		},
+		{
+			MethodName: "BlockDump",
+			Handler:    _Node_BlockDump_Handler,
+		},
+		{
+			MethodName: "BlockDecode",
+			Handler:    _Node_BlockDecode_Handler,
+		},
+		{
+			MethodName: "BlockDumpRange",
+			Handler:    _Node_BlockDumpRange_Handler,
+		},
+		{
+			MethodName: "BlockFilter",
+			Handler:    _Node_BlockFilter_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Blocks",
+			Handler:       _Node_Blocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bitmarkd.proto",
+}
+
+const (
+	Transaction_Status_FullMethodName         = "/bitmarkd.v1.Transaction/Status"
+	Transaction_InclusionProof_FullMethodName = "/bitmarkd.v1.Transaction/InclusionProof"
+	Transaction_Events_FullMethodName         = "/bitmarkd.v1.Transaction/Events"
+)
+
+// TransactionClient is the client API for Transaction service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransactionClient interface {
+	Status(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatusReply, error)
+	InclusionProof(ctx context.Context, in *TransactionInclusionProofRequest, opts ...grpc.CallOption) (*TransactionInclusionProofReply, error)
+	// assets, issues and transfers as they are received
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionEvent], error)
+}
+
+type transactionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionClient(cc grpc.ClientConnInterface) TransactionClient {
+	return &transactionClient{cc}
+}
+
+func (c *transactionClient) Status(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatusReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionStatusReply)
+	err := c.cc.Invoke(ctx, Transaction_Status_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) InclusionProof(ctx context.Context, in *TransactionInclusionProofRequest, opts ...grpc.CallOption) (*TransactionInclusionProofReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactionInclusionProofReply)
+	err := c.cc.Invoke(ctx, Transaction_InclusionProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TransactionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Transaction_ServiceDesc.Streams[0], Transaction_Events_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EventsRequest, TransactionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Transaction_EventsClient = grpc.ServerStreamingClient[TransactionEvent]
+
+// TransactionServer is the server API for Transaction service.
+// All implementations must embed UnimplementedTransactionServer
+// for forward compatibility.
+type TransactionServer interface {
+	Status(context.Context, *TransactionStatusRequest) (*TransactionStatusReply, error)
+	InclusionProof(context.Context, *TransactionInclusionProofRequest) (*TransactionInclusionProofReply, error)
+	// assets, issues and transfers as they are received
+	Events(*EventsRequest, grpc.ServerStreamingServer[TransactionEvent]) error
+	mustEmbedUnimplementedTransactionServer()
+}
+
+// UnimplementedTransactionServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTransactionServer struct{}
+
+func (UnimplementedTransactionServer) Status(context.Context, *TransactionStatusRequest) (*TransactionStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Status not implemented")
+}
+func (UnimplementedTransactionServer) InclusionProof(context.Context, *TransactionInclusionProofRequest) (*TransactionInclusionProofReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InclusionProof not implemented")
+}
+func (UnimplementedTransactionServer) Events(*EventsRequest, grpc.ServerStreamingServer[TransactionEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedTransactionServer) mustEmbedUnimplementedTransactionServer() {}
+func (UnimplementedTransactionServer) testEmbeddedByValue()                     {}
+
+// UnsafeTransactionServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionServer will
+// result in compilation errors.
+type UnsafeTransactionServer interface {
+	mustEmbedUnimplementedTransactionServer()
+}
+
+func RegisterTransactionServer(s grpc.ServiceRegistrar, srv TransactionServer) {
+	// If the following call pancis, it indicates UnimplementedTransactionServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Transaction_ServiceDesc, srv)
+}
+
+func _Transaction_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_Status_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).Status(ctx, req.(*TransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_InclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServer).InclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Transaction_InclusionProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServer).InclusionProof(ctx, req.(*TransactionInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Transaction_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransactionServer).Events(m, &grpc.GenericServerStream[EventsRequest, TransactionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Transaction_EventsServer = grpc.ServerStreamingServer[TransactionEvent]
+
+// Transaction_ServiceDesc is the grpc.ServiceDesc for Transaction service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Transaction_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitmarkd.v1.Transaction",
+	HandlerType: (*TransactionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _Transaction_Status_Handler,
+		},
+		{
+			MethodName: "InclusionProof",
+			Handler:    _Transaction_InclusionProof_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _Transaction_Events_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bitmarkd.proto",
+}
+
+const (
+	BlockOwner_TxIDForBlock_FullMethodName = "/bitmarkd.v1.BlockOwner/TxIDForBlock"
+	BlockOwner_Transfer_FullMethodName     = "/bitmarkd.v1.BlockOwner/Transfer"
+)
+
+// BlockOwnerClient is the client API for BlockOwner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BlockOwnerClient interface {
+	TxIDForBlock(ctx context.Context, in *BlockOwnerTxIDForBlockRequest, opts ...grpc.CallOption) (*BlockOwnerTxIDForBlockReply, error)
+	Transfer(ctx context.Context, in *BlockOwnerTransferRequest, opts ...grpc.CallOption) (*TransferReply, error)
+}
+
+type blockOwnerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlockOwnerClient(cc grpc.ClientConnInterface) BlockOwnerClient {
+	return &blockOwnerClient{cc}
+}
+
+func (c *blockOwnerClient) TxIDForBlock(ctx context.Context, in *BlockOwnerTxIDForBlockRequest, opts ...grpc.CallOption) (*BlockOwnerTxIDForBlockReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockOwnerTxIDForBlockReply)
+	err := c.cc.Invoke(ctx, BlockOwner_TxIDForBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockOwnerClient) Transfer(ctx context.Context, in *BlockOwnerTransferRequest, opts ...grpc.CallOption) (*TransferReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferReply)
+	err := c.cc.Invoke(ctx, BlockOwner_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockOwnerServer is the server API for BlockOwner service.
+// All implementations must embed UnimplementedBlockOwnerServer
+// for forward compatibility.
+type BlockOwnerServer interface {
+	TxIDForBlock(context.Context, *BlockOwnerTxIDForBlockRequest) (*BlockOwnerTxIDForBlockReply, error)
+	Transfer(context.Context, *BlockOwnerTransferRequest) (*TransferReply, error)
+	mustEmbedUnimplementedBlockOwnerServer()
+}
+
+// UnimplementedBlockOwnerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBlockOwnerServer struct{}
+
+func (UnimplementedBlockOwnerServer) TxIDForBlock(context.Context, *BlockOwnerTxIDForBlockRequest) (*BlockOwnerTxIDForBlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxIDForBlock not implemented")
+}
+func (UnimplementedBlockOwnerServer) Transfer(context.Context, *BlockOwnerTransferRequest) (*TransferReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedBlockOwnerServer) mustEmbedUnimplementedBlockOwnerServer() {}
+func (UnimplementedBlockOwnerServer) testEmbeddedByValue()                    {}
+
+// UnsafeBlockOwnerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlockOwnerServer will
+// result in compilation errors.
+type UnsafeBlockOwnerServer interface {
+	mustEmbedUnimplementedBlockOwnerServer()
+}
+
+func RegisterBlockOwnerServer(s grpc.ServiceRegistrar, srv BlockOwnerServer) {
+	// If the following call pancis, it indicates UnimplementedBlockOwnerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BlockOwner_ServiceDesc, srv)
+}
+
+func _BlockOwner_TxIDForBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockOwnerTxIDForBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockOwnerServer).TxIDForBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockOwner_TxIDForBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockOwnerServer).TxIDForBlock(ctx, req.(*BlockOwnerTxIDForBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockOwner_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockOwnerTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockOwnerServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlockOwner_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockOwnerServer).Transfer(ctx, req.(*BlockOwnerTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BlockOwner_ServiceDesc is the grpc.ServiceDesc for BlockOwner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlockOwner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitmarkd.v1.BlockOwner",
+	HandlerType: (*BlockOwnerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TxIDForBlock",
+			Handler:    _BlockOwner_TxIDForBlock_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _BlockOwner_Transfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitmarkd.proto",
+}
+
+const (
+	Share_Create_FullMethodName  = "/bitmarkd.v1.Share/Create"
+	Share_Balance_FullMethodName = "/bitmarkd.v1.Share/Balance"
+	Share_Grant_FullMethodName   = "/bitmarkd.v1.Share/Grant"
+	Share_Swap_FullMethodName    = "/bitmarkd.v1.Share/Swap"
+)
+
+// ShareClient is the client API for Share service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShareClient interface {
+	Create(ctx context.Context, in *ShareCreateRequest, opts ...grpc.CallOption) (*ShareCreateReply, error)
+	Balance(ctx context.Context, in *ShareBalanceRequest, opts ...grpc.CallOption) (*ShareBalanceReply, error)
+	Grant(ctx context.Context, in *ShareGrantRequest, opts ...grpc.CallOption) (*ShareGrantReply, error)
+	Swap(ctx context.Context, in *ShareSwapRequest, opts ...grpc.CallOption) (*ShareSwapReply, error)
+}
+
+type shareClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShareClient(cc grpc.ClientConnInterface) ShareClient {
+	return &shareClient{cc}
+}
+
+func (c *shareClient) Create(ctx context.Context, in *ShareCreateRequest, opts ...grpc.CallOption) (*ShareCreateReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareCreateReply)
+	err := c.cc.Invoke(ctx, Share_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) Balance(ctx context.Context, in *ShareBalanceRequest, opts ...grpc.CallOption) (*ShareBalanceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareBalanceReply)
+	err := c.cc.Invoke(ctx, Share_Balance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) Grant(ctx context.Context, in *ShareGrantRequest, opts ...grpc.CallOption) (*ShareGrantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareGrantReply)
+	err := c.cc.Invoke(ctx, Share_Grant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shareClient) Swap(ctx context.Context, in *ShareSwapRequest, opts ...grpc.CallOption) (*ShareSwapReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareSwapReply)
+	err := c.cc.Invoke(ctx, Share_Swap_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShareServer is the server API for Share service.
+// All implementations must embed UnimplementedShareServer
+// for forward compatibility.
+type ShareServer interface {
+	Create(context.Context, *ShareCreateRequest) (*ShareCreateReply, error)
+	Balance(context.Context, *ShareBalanceRequest) (*ShareBalanceReply, error)
+	Grant(context.Context, *ShareGrantRequest) (*ShareGrantReply, error)
+	Swap(context.Context, *ShareSwapRequest) (*ShareSwapReply, error)
+	mustEmbedUnimplementedShareServer()
+}
+
+// UnimplementedShareServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedShareServer struct{}
+
+func (UnimplementedShareServer) Create(context.Context, *ShareCreateRequest) (*ShareCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedShareServer) Balance(context.Context, *ShareBalanceRequest) (*ShareBalanceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
+func (UnimplementedShareServer) Grant(context.Context, *ShareGrantRequest) (*ShareGrantReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Grant not implemented")
+}
+func (UnimplementedShareServer) Swap(context.Context, *ShareSwapRequest) (*ShareSwapReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (UnimplementedShareServer) mustEmbedUnimplementedShareServer() {}
+func (UnimplementedShareServer) testEmbeddedByValue()               {}
+
+// UnsafeShareServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShareServer will
+// result in compilation errors.
+type UnsafeShareServer interface {
+	mustEmbedUnimplementedShareServer()
+}
+
+func RegisterShareServer(s grpc.ServiceRegistrar, srv ShareServer) {
+	// If the following call pancis, it indicates UnimplementedShareServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Share_ServiceDesc, srv)
+}
+
+func _Share_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Share_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).Create(ctx, req.(*ShareCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Share_Balance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).Balance(ctx, req.(*ShareBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_Grant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).Grant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Share_Grant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).Grant(ctx, req.(*ShareGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Share_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShareServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Share_Swap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShareServer).Swap(ctx, req.(*ShareSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Share_ServiceDesc is the grpc.ServiceDesc for Share service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Share_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitmarkd.v1.Share",
+	HandlerType: (*ShareServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Share_Create_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _Share_Balance_Handler,
+		},
+		{
+			MethodName: "Grant",
+			Handler:    _Share_Grant_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Share_Swap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "bitmarkd.proto",
+}
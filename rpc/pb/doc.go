@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package pb - protobuf messages and gRPC services for the client API
+//
+// generated from bitmarkd.proto, which is the reference for clients
+package pb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative bitmarkd.proto
@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reservoir
+
+import (
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	pool "github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/bitmark-inc/bitmarkd/rpc/ratelimit"
+	"github.com/bitmark-inc/logger"
+	"golang.org/x/time/rate"
+)
+
+// Reservoir
+// ---------
+
+const (
+	rateLimitReservoir = 10
+	rateBurstReservoir = 20
+)
+
+// Reservoir - an RPC entry for inspecting the reservoir
+//
+// only served on the restricted HTTPS endpoint
+type Reservoir struct {
+	Log     *logger.L
+	Limiter *rate.Limiter
+	Rsvr    pool.Reservoir
+}
+
+// ListArguments - arguments for list RPC request
+type ListArguments struct {
+	Start pay.PayId `json:"start"`
+	Count int       `json:"count"`
+}
+
+// ListReply - results from list RPC
+type ListReply struct {
+	Items []pool.ListInfo `json:"items"`
+	Next  pay.PayId       `json:"next"`
+}
+
+func New(log *logger.L, rsvr pool.Reservoir) *Reservoir {
+	return &Reservoir{
+		Log:     log,
+		Limiter: rate.NewLimiter(rateLimitReservoir, rateBurstReservoir),
+		Rsvr:    rsvr,
+	}
+}
+
+// List - page through the pending and verified items
+func (r *Reservoir) List(arguments *ListArguments, reply *ListReply) error {
+	if err := ratelimit.Limit(r.Limiter); err != nil {
+		return err
+	}
+
+	if r.Rsvr == nil {
+		return fault.MissingReservoir
+	}
+
+	if arguments == nil || arguments.Count <= 0 || arguments.Count > pool.MaximumListCount {
+		return fault.InvalidCount
+	}
+
+	r.Log.Infof("Reservoir.List: %+v", arguments)
+
+	items, err := r.Rsvr.List(arguments.Start, arguments.Count)
+	if err != nil {
+		return err
+	}
+
+	reply.Items = items
+	reply.Next = arguments.Start
+	if n := len(items); n > 0 {
+		reply.Next = items[n-1].PayId
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2020 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package reservoir_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/pay"
+	pool "github.com/bitmark-inc/bitmarkd/reservoir"
+	"github.com/bitmark-inc/bitmarkd/rpc/fixtures"
+	"github.com/bitmark-inc/bitmarkd/rpc/mocks"
+	"github.com/bitmark-inc/bitmarkd/rpc/reservoir"
+	"github.com/bitmark-inc/logger"
+)
+
+func TestReservoirList(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	r := mocks.NewMockReservoir(ctl)
+
+	rs := reservoir.New(logger.New(fixtures.LogCategory), r)
+
+	arg := reservoir.ListArguments{
+		Start: pay.PayId{1},
+		Count: 2,
+	}
+
+	items := []pool.ListInfo{
+		{
+			PayId: pay.PayId{2},
+			State: pool.StatePending.String(),
+			Type:  "BitmarkTransferUnratified",
+			TxIds: []merkle.Digest{{1, 2, 3}},
+		},
+		{
+			PayId: pay.PayId{3},
+			State: pool.StateVerified.String(),
+			Type:  "PaidIssue",
+			TxIds: []merkle.Digest{{4, 5, 6}, {7, 8, 9}},
+		},
+	}
+
+	r.EXPECT().List(arg.Start, arg.Count).Return(items, nil).Times(1)
+
+	var reply reservoir.ListReply
+	err := rs.List(&arg, &reply)
+	assert.Nil(t, err, "wrong List")
+	assert.Equal(t, items, reply.Items, "wrong items")
+	assert.Equal(t, pay.PayId{3}, reply.Next, "wrong next")
+}
+
+func TestReservoirListWhenEmpty(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	r := mocks.NewMockReservoir(ctl)
+
+	rs := reservoir.New(logger.New(fixtures.LogCategory), r)
+
+	arg := reservoir.ListArguments{
+		Start: pay.PayId{5},
+		Count: 10,
+	}
+
+	r.EXPECT().List(arg.Start, arg.Count).Return([]pool.ListInfo{}, nil).Times(1)
+
+	var reply reservoir.ListReply
+	err := rs.List(&arg, &reply)
+	assert.Nil(t, err, "wrong List")
+	assert.Equal(t, 0, len(reply.Items), "wrong items")
+	assert.Equal(t, arg.Start, reply.Next, "wrong next")
+}
+
+func TestReservoirListWhenInvalidCount(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	r := mocks.NewMockReservoir(ctl)
+
+	rs := reservoir.New(logger.New(fixtures.LogCategory), r)
+
+	var reply reservoir.ListReply
+	err := rs.List(&reservoir.ListArguments{Count: 0}, &reply)
+	assert.Equal(t, fault.InvalidCount, err, "wrong zero count")
+
+	err = rs.List(&reservoir.ListArguments{Count: pool.MaximumListCount + 1}, &reply)
+	assert.Equal(t, fault.InvalidCount, err, "wrong large count")
+}
+
+func TestReservoirListWhenReservoirEmpty(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	rs := reservoir.New(logger.New(fixtures.LogCategory), nil)
+
+	var reply reservoir.ListReply
+	err := rs.List(&reservoir.ListArguments{Count: 1}, &reply)
+	assert.Equal(t, fault.MissingReservoir, err, "wrong error")
+}
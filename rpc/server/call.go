@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"reflect"
+)
+
+// Error - error returned by the called RPC, only its text survives JSON-RPC
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+// connection to run a single request through the JSON-RPC codec
+type callConnection struct {
+	in  io.Reader
+	out io.Writer
+}
+
+func (c *callConnection) Read(p []byte) (int, error) {
+	return c.in.Read(p)
+}
+func (c *callConnection) Write(d []byte) (int, error) {
+	return c.out.Write(d)
+}
+func (c *callConnection) Close() error {
+	return nil
+}
+
+// Call - call an RPC on the server as if it had arrived as JSON-RPC
+//
+// arguments are the JSON encoded parameter of the method and the JSON
+// encoded result is returned. An error from the method is returned as
+// Error, any other error is from encoding or decoding.
+func Call(server *rpc.Server, serviceMethod string, arguments json.RawMessage) (json.RawMessage, error) {
+	if 0 == len(arguments) {
+		arguments = json.RawMessage("{}")
+	}
+
+	request, err := json.Marshal(struct {
+		Id     int               `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}{
+		Id:     1,
+		Method: serviceMethod,
+		Params: []json.RawMessage{arguments},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &bytes.Buffer{}
+	serverCodec := jsonrpc.NewServerCodec(&callConnection{in: bytes.NewReader(request), out: out})
+	err = server.ServeRequest(serverCodec)
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Result json.RawMessage `json:"result"`
+		Error  *string         `json:"error"`
+	}
+	err = json.Unmarshal(out.Bytes(), &response)
+	if err != nil {
+		return nil, err
+	}
+	if nil != response.Error {
+		return nil, Error(*response.Error)
+	}
+
+	return response.Result, nil
+}
+
+// codec to run a single request with Go values instead of an encoding
+type valueCodec struct {
+	serviceMethod string
+	arguments     interface{}
+	reply         interface{}
+	err           string
+}
+
+func (c *valueCodec) ReadRequestHeader(r *rpc.Request) error {
+	r.ServiceMethod = c.serviceMethod
+	r.Seq = 1
+	return nil
+}
+
+func (c *valueCodec) ReadRequestBody(body interface{}) error {
+	if nil == body {
+		return nil
+	}
+	return copyValue(body, c.arguments)
+}
+
+func (c *valueCodec) WriteResponse(r *rpc.Response, body interface{}) error {
+	c.err = r.Error
+	c.reply = body
+	return nil
+}
+
+func (c *valueCodec) Close() error {
+	return nil
+}
+
+// Invoke - call an RPC on the server with its own argument and reply
+// types
+//
+// arguments and reply are pointers to the types of the method's
+// parameters, reply is set from the method's reply. An error from the
+// method is returned as Error, any other error is from a type mismatch
+// or an unknown method.
+func Invoke(server *rpc.Server, serviceMethod string, arguments interface{}, reply interface{}) error {
+	codec := &valueCodec{
+		serviceMethod: serviceMethod,
+		arguments:     arguments,
+	}
+	err := server.ServeRequest(codec)
+	if err != nil {
+		return err
+	}
+	if "" != codec.err {
+		return Error(codec.err)
+	}
+
+	return copyValue(reply, codec.reply)
+}
+
+// set the value pointed to by to from the value pointed to by from
+func copyValue(to interface{}, from interface{}) error {
+	t := reflect.ValueOf(to)
+	f := reflect.ValueOf(from)
+	if reflect.Ptr != t.Kind() || t.IsNil() || t.Type() != f.Type() || f.IsNil() {
+		return fmt.Errorf("rpc: value type: %T  expected: %T", from, to)
+	}
+	t.Elem().Set(f.Elem())
+	return nil
+}
@@ -14,13 +14,18 @@ import (
 	"github.com/bitmark-inc/bitmarkd/counter"
 	"github.com/bitmark-inc/bitmarkd/mode"
 	"github.com/bitmark-inc/bitmarkd/ownership"
+	"github.com/bitmark-inc/bitmarkd/payment"
+	"github.com/bitmark-inc/bitmarkd/peer/reputation"
 	"github.com/bitmark-inc/bitmarkd/reservoir"
 	"github.com/bitmark-inc/bitmarkd/rpc/assets"
+	"github.com/bitmark-inc/bitmarkd/rpc/bans"
 	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
 	"github.com/bitmark-inc/bitmarkd/rpc/bitmarks"
 	"github.com/bitmark-inc/bitmarkd/rpc/blockowner"
+	"github.com/bitmark-inc/bitmarkd/rpc/lightning"
 	"github.com/bitmark-inc/bitmarkd/rpc/node"
 	"github.com/bitmark-inc/bitmarkd/rpc/owner"
+	rpcreservoir "github.com/bitmark-inc/bitmarkd/rpc/reservoir"
 	"github.com/bitmark-inc/bitmarkd/rpc/share"
 	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
 	"github.com/bitmark-inc/bitmarkd/storage"
@@ -34,6 +39,7 @@ func Create(log *logger.L, version string, rpcCount *counter.Counter, readOnly b
 		Assets:            storage.Pool.Assets,
 		BlockOwnerPayment: storage.Pool.BlockOwnerPayment,
 		Blocks:            storage.Pool.Blocks,
+		BlockFilters:      storage.Pool.BlockFilters,
 		Transactions:      storage.Pool.Transactions,
 		OwnerTxIndex:      storage.Pool.OwnerTxIndex,
 		OwnerData:         storage.Pool.OwnerData,
@@ -48,9 +54,26 @@ func Create(log *logger.L, version string, rpcCount *counter.Counter, readOnly b
 	_ = server.Register(bitmarks.New(log, pools, mode.Is, reservoir.Get(), readOnly))
 	_ = server.Register(owner.New(log, pools, ownership.Get(), readOnly))
 	_ = server.Register(node.New(log, pools, start, version, rpcCount, announce.Get(), readOnly))
-	_ = server.Register(transaction.New(log, start, reservoir.Get(), readOnly))
+	_ = server.Register(transaction.New(log, start, reservoir.Get(), pools, blockrecord.Get(), mode.IsTesting, readOnly))
 	_ = server.Register(blockowner.New(log, pools, mode.Is, mode.IsTesting, reservoir.Get(), blockrecord.Get(), readOnly))
 	_ = server.Register(share.New(log, mode.Is, reservoir.Get(), readOnly))
+	_ = server.Register(lightning.New(log, payment.LightningInvoice, readOnly))
 
 	return server
 }
+
+// CreateRestricted - servers for RPCs that must only be reached through
+// an access controlled endpoint, keyed by the endpoint's allow entry
+func CreateRestricted(log *logger.L) map[string]*rpc.Server {
+
+	reservoirServer := rpc.NewServer()
+	_ = reservoirServer.Register(rpcreservoir.New(log, reservoir.Get()))
+
+	bansServer := rpc.NewServer()
+	_ = bansServer.Register(bans.New(log, reputation.Get()))
+
+	return map[string]*rpc.Server{
+		"reservoir": reservoirServer,
+		"bans":      bansServer,
+	}
+}
@@ -6,12 +6,14 @@
 package rpc
 
 import (
+	"net/rpc"
 	"sync"
 	"time"
 
 	"github.com/bitmark-inc/bitmarkd/announce"
 	"github.com/bitmark-inc/bitmarkd/counter"
 	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
 	"github.com/bitmark-inc/bitmarkd/rpc/certificate"
 	"github.com/bitmark-inc/bitmarkd/rpc/handler"
 	"github.com/bitmark-inc/bitmarkd/rpc/listeners"
@@ -29,6 +31,10 @@ type rpcData struct {
 	initialised bool
 
 	rpcCounter counter.Counter
+
+	// for reload
+	handler handler.Handler
+	keys    *apikey.Keys
 }
 
 // global data
@@ -37,6 +43,8 @@ var globalData rpcData
 // Initialise - setup peer background processes
 func Initialise(rpcConfiguration *listeners.RPCConfiguration,
 	httpsConfiguration *listeners.HTTPSConfiguration,
+	grpcConfiguration *listeners.GRPCConfiguration,
+	apiKeyConfiguration *apikey.Configuration,
 	version string,
 	ann announce.Announce,
 	readOnly bool,
@@ -66,8 +74,20 @@ func Initialise(rpcConfiguration *listeners.RPCConfiguration,
 
 	log.Infof("rpc certificate: SHA3-256 fingerprint: %x", tlsFingerprint)
 
+	err = certificate.SetClient(globalData.log, "rpc", tlsConfig, &rpcConfiguration.Client)
+	if err != nil {
+		return err
+	}
+
+	keys, err := apikey.New(apiKeyConfiguration)
+	if err != nil {
+		log.Errorf("api keys error: %s", err)
+		return err
+	}
+
 	// servers
 	s := server.Create(globalData.log, version, &globalData.rpcCounter, readOnly)
+	_ = s.Register(apikey.NewAuth(keys))
 
 	rpcListener, err := listeners.NewRPC(
 		rpcConfiguration,
@@ -77,6 +97,7 @@ func Initialise(rpcConfiguration *listeners.RPCConfiguration,
 		ann,
 		tlsConfig,
 		tlsFingerprint,
+		keys,
 		readOnly,
 	)
 	if err != nil {
@@ -94,12 +115,29 @@ func Initialise(rpcConfiguration *listeners.RPCConfiguration,
 	}
 	log.Infof("https certificate: SHA3-256 fingerprint: %x", tlsFingerprint)
 
+	err = certificate.SetClient(globalData.log, "https", tlsConfig, &httpsConfiguration.Client)
+	if err != nil {
+		return err
+	}
+
 	hdlr := handler.New(
 		globalData.log,
 		s, time.Now(),
 		version,
 		httpsConfiguration.MaximumConnections,
 	)
+	for api, restricted := range server.CreateRestricted(globalData.log) {
+		hdlr.SetRestricted(api, restricted)
+	}
+	hdlr.SetKeys(keys)
+
+	globalData.handler = hdlr
+	globalData.keys = keys
+
+	keysServer := rpc.NewServer()
+	_ = keysServer.Register(apikey.NewUsage(keys))
+	hdlr.SetRestricted("keys", keysServer)
+
 	httpsListener, err := listeners.NewHTTPS(
 		httpsConfiguration,
 		globalData.log,
@@ -116,12 +154,83 @@ func Initialise(rpcConfiguration *listeners.RPCConfiguration,
 		return err
 	}
 
+	if len(grpcConfiguration.Listen) != 0 {
+		tlsConfig, tlsFingerprint, err = certificate.Get(globalData.log, "grpc", grpcConfiguration.Certificate, grpcConfiguration.PrivateKey)
+		if err != nil {
+			return err
+		}
+		log.Infof("grpc certificate: SHA3-256 fingerprint: %x", tlsFingerprint)
+
+		err = certificate.SetClient(globalData.log, "grpc", tlsConfig, &grpcConfiguration.Client)
+		if err != nil {
+			return err
+		}
+	}
+
+	grpcListener, err := listeners.NewGRPC(
+		grpcConfiguration,
+		globalData.log,
+		tlsConfig,
+		s,
+		keys,
+	)
+	if err != nil {
+		return err
+	}
+
+	if grpcListener != nil {
+		err = grpcListener.Serve()
+		if err != nil {
+			return err
+		}
+	}
+
 	// all data initialised
 	globalData.initialised = true
 
 	return nil
 }
 
+// SetAllow - replace the networks that can access the restricted
+// HTTPS APIs
+func SetAllow(allow map[string][]string) error {
+	globalData.Lock()
+	defer globalData.Unlock()
+
+	if !globalData.initialised {
+		return fault.NotInitialised
+	}
+
+	local, err := listeners.ParseAllow(allow)
+	if err != nil {
+		return err
+	}
+	globalData.handler.SetAllow(local)
+
+	return nil
+}
+
+// SetAPIKeys - replace the API keys and their limits
+//
+// API keys must have been configured at start to be changed
+func SetAPIKeys(configuration *apikey.Configuration) error {
+	globalData.Lock()
+	defer globalData.Unlock()
+
+	if !globalData.initialised {
+		return fault.NotInitialised
+	}
+
+	if nil == globalData.keys {
+		if 0 == len(configuration.Keys) && "" == configuration.KeyFile && !configuration.Required {
+			return nil
+		}
+		return fault.APIKeysNotEnabled
+	}
+
+	return globalData.keys.Reload(configuration)
+}
+
 // Finalise - stop all background tasks
 func Finalise() error {
 
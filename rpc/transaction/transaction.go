@@ -6,12 +6,18 @@
 package transaction
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"time"
 
+	"github.com/bitmark-inc/bitmarkd/blockdigest"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/merkle"
 	"github.com/bitmark-inc/bitmarkd/reservoir"
 	"github.com/bitmark-inc/bitmarkd/rpc/ratelimit"
+	"github.com/bitmark-inc/bitmarkd/storage"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 	"github.com/bitmark-inc/logger"
 	"golang.org/x/time/rate"
 )
@@ -23,11 +29,15 @@ const (
 
 // Transaction - an RPC entry for transaction related functions
 type Transaction struct {
-	Log      *logger.L
-	Limiter  *rate.Limiter
-	Start    time.Time
-	Rsvr     reservoir.Reservoir
-	ReadOnly bool
+	Log              *logger.L
+	Limiter          *rate.Limiter
+	Start            time.Time
+	Rsvr             reservoir.Reservoir
+	PoolTransactions storage.Handle
+	PoolBlocks       storage.Handle
+	Br               blockrecord.Record
+	IsTestingChain   func() bool
+	ReadOnly         bool
 }
 
 // Arguments - arguments for status RPC request
@@ -43,14 +53,21 @@ type StatusReply struct {
 func New(log *logger.L,
 	start time.Time,
 	rsvr reservoir.Reservoir,
+	pools reservoir.Handles,
+	br blockrecord.Record,
+	isTestingChain func() bool,
 	readOnly bool,
 ) *Transaction {
 	return &Transaction{
-		Log:      log,
-		Limiter:  rate.NewLimiter(rateLimitTransaction, rateBurstTransaction),
-		Start:    start,
-		Rsvr:     rsvr,
-		ReadOnly: readOnly,
+		Log:              log,
+		Limiter:          rate.NewLimiter(rateLimitTransaction, rateBurstTransaction),
+		Start:            start,
+		Rsvr:             rsvr,
+		PoolTransactions: pools.Transactions,
+		PoolBlocks:       pools.Blocks,
+		Br:               br,
+		IsTestingChain:   isTestingChain,
+		ReadOnly:         readOnly,
 	}
 }
 
@@ -67,3 +84,131 @@ func (t *Transaction) Status(arguments *Arguments, reply *StatusReply) error {
 	reply.Status = t.Rsvr.TransactionStatus(arguments.TxId).String()
 	return nil
 }
+
+// InclusionProofArguments - arguments for inclusion proof RPC request
+//
+// the block number is only needed for records that are not indexed by
+// transaction id: asset registrations and block foundations
+type InclusionProofArguments struct {
+	TxId        merkle.Digest `json:"txId"`
+	BlockNumber uint64        `json:"blockNumber,string"`
+}
+
+// InclusionProofReply - the header of the block holding a transaction
+// and the merkle branch from the transaction to the header's merkle root
+type InclusionProofReply struct {
+	TxId         merkle.Digest       `json:"txId"`
+	BlockNumber  uint64              `json:"blockNumber,string"`
+	Digest       blockdigest.Digest  `json:"digest"`
+	Header       *blockrecord.Header `json:"header"`
+	PackedHeader string              `json:"packedHeader"` // hex
+	Index        uint64              `json:"index,string"`
+	Branch       []merkle.Digest     `json:"branch"`
+}
+
+// InclusionProof - prove a confirmed transaction is in a block
+func (t *Transaction) InclusionProof(arguments *InclusionProofArguments, reply *InclusionProofReply) error {
+	if err := ratelimit.Limit(t.Limiter); err != nil {
+		return err
+	}
+
+	if t.PoolTransactions == nil || t.PoolBlocks == nil {
+		return fault.DatabaseIsNotSet
+	}
+
+	t.Log.Infof("Transaction.InclusionProof: %+v", arguments)
+
+	blockNumber := arguments.BlockNumber
+	if blockNumber == 0 {
+		n, packed := t.PoolTransactions.GetNB(arguments.TxId[:])
+		if packed == nil {
+			return fault.TransactionIsNotConfirmed
+		}
+		blockNumber = n
+	}
+
+	blockNumberKey := make([]byte, 8)
+	binary.BigEndian.PutUint64(blockNumberKey, blockNumber)
+	packedBlock := t.PoolBlocks.Get(blockNumberKey)
+	if packedBlock == nil {
+		return fault.BlockNotFound
+	}
+
+	header, digest, data, err := t.Br.ExtractHeader(packedBlock, 0, false)
+	if err != nil {
+		return err
+	}
+	packedHeader, err := blockrecord.PackedHeaderOf(packedBlock)
+	if err != nil {
+		return err
+	}
+
+	txIds := make([]merkle.Digest, header.TransactionCount)
+	index := -1
+	for i := range txIds {
+		_, n, err := transactionrecord.Packed(data).Unpack(t.IsTestingChain())
+		if err != nil {
+			return err
+		}
+		txIds[i] = merkle.NewDigest(data[:n])
+		if txIds[i] == arguments.TxId {
+			index = i
+		}
+		data = data[n:]
+	}
+	if index < 0 {
+		return fault.TransactionNotInBlock
+	}
+
+	branch, err := merkle.Branch(txIds, index)
+	if err != nil {
+		return err
+	}
+
+	reply.TxId = arguments.TxId
+	reply.BlockNumber = blockNumber
+	reply.Digest = digest
+	reply.Header = header
+	reply.PackedHeader = hex.EncodeToString(packedHeader[:])
+	reply.Index = uint64(index)
+	reply.Branch = branch
+
+	return nil
+}
+
+// UnpackHeader - the block header of the proof
+func (reply *InclusionProofReply) UnpackHeader() (*blockrecord.Header, error) {
+	packed, err := hex.DecodeString(reply.PackedHeader)
+	if err != nil {
+		return nil, err
+	}
+	packedHeader := blockrecord.PackedHeader{}
+	if len(packed) != len(packedHeader) {
+		return nil, fault.InvalidBlockHeaderSize
+	}
+	copy(packedHeader[:], packed)
+
+	if packedHeader.Digest() != reply.Digest {
+		return nil, fault.BlockDigestDoesNotMatch
+	}
+	return packedHeader.Unpack()
+}
+
+// Verify - check the proof needs nothing but itself: the packed header
+// hashes to the digest and the branch leads from the transaction to
+// the header's merkle root
+//
+// the caller must still check the digest is in a trusted header chain
+func (reply *InclusionProofReply) Verify() error {
+	header, err := reply.UnpackHeader()
+	if err != nil {
+		return err
+	}
+	if header.Number != reply.BlockNumber {
+		return fault.BlockNumberDoesNotMatch
+	}
+	if !merkle.VerifyProof(reply.TxId, reply.Index, uint64(header.TransactionCount), reply.Branch, header.MerkleRoot) {
+		return fault.MerkleRootDoesNotMatch
+	}
+	return nil
+}
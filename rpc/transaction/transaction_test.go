@@ -6,18 +6,25 @@
 package transaction_test
 
 import (
+	"encoding/binary"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ed25519"
 
+	"github.com/bitmark-inc/bitmarkd/account"
+	"github.com/bitmark-inc/bitmarkd/blockrecord"
+	"github.com/bitmark-inc/bitmarkd/difficulty"
 	"github.com/bitmark-inc/bitmarkd/fault"
 	"github.com/bitmark-inc/bitmarkd/merkle"
+	"github.com/bitmark-inc/bitmarkd/mode"
 	"github.com/bitmark-inc/bitmarkd/reservoir"
 	"github.com/bitmark-inc/bitmarkd/rpc/fixtures"
 	"github.com/bitmark-inc/bitmarkd/rpc/mocks"
 	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
 	"github.com/bitmark-inc/logger"
 )
 
@@ -32,7 +39,7 @@ func TestTransactionStatus(t *testing.T) {
 
 	now := time.Now()
 
-	tr := transaction.New(logger.New(fixtures.LogCategory), now, r)
+	tr := transaction.New(logger.New(fixtures.LogCategory), now, r, reservoir.Handles{}, nil, mode.IsTesting, false)
 
 	arg := transaction.Arguments{TxId: merkle.Digest{1, 2, 3, 4}}
 
@@ -53,7 +60,7 @@ func TestTransactionStatusWhenReservoirEmpty(t *testing.T) {
 
 	now := time.Now()
 
-	tr := transaction.New(logger.New(fixtures.LogCategory), now, nil)
+	tr := transaction.New(logger.New(fixtures.LogCategory), now, nil, reservoir.Handles{}, nil, mode.IsTesting, false)
 
 	arg := transaction.Arguments{TxId: merkle.Digest{1, 2, 3, 4}}
 
@@ -62,3 +69,136 @@ func TestTransactionStatusWhenReservoirEmpty(t *testing.T) {
 	assert.NotNil(t, err, "wrong Status")
 	assert.Equal(t, fault.MissingReservoir, err, "wrong error message")
 }
+
+// a block of signed issues, returns the packed block, its header and
+// the issue ids
+func testBlock(t *testing.T, number uint64, count int) ([]byte, *blockrecord.Header, []merkle.Digest) {
+	acc := &account.Account{
+		AccountInterface: &account.ED25519Account{
+			Test:      true,
+			PublicKey: fixtures.IssuerPublicKey,
+		},
+	}
+
+	data := []byte{}
+	txIds := make([]merkle.Digest, count)
+	for i := range txIds {
+		issue := transactionrecord.BitmarkIssue{
+			AssetId: transactionrecord.AssetIdentifier{1, 2, 3},
+			Owner:   acc,
+			Nonce:   uint64(i + 1),
+		}
+		packed, _ := issue.Pack(acc)
+		issue.Signature = ed25519.Sign(fixtures.IssuerPrivateKey, packed)
+		packed, err := issue.Pack(acc)
+		assert.Nil(t, err, "wrong pack")
+
+		txIds[i] = merkle.NewDigest(packed)
+		data = append(data, packed...)
+	}
+
+	tree := merkle.FullMerkleTree(txIds)
+	header := &blockrecord.Header{
+		Version:          3,
+		TransactionCount: uint16(count),
+		Number:           number,
+		MerkleRoot:       tree[len(tree)-1],
+		Timestamp:        uint64(time.Now().Unix()),
+		Difficulty:       difficulty.New(),
+		Nonce:            5,
+	}
+	packedHeader := header.Pack()
+
+	return append(packedHeader[:], data...), header, txIds
+}
+
+func TestTransactionInclusionProof(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	pTx := mocks.NewMockHandle(ctl)
+	pBlocks := mocks.NewMockHandle(ctl)
+	br := mocks.NewMockRecord(ctl)
+
+	tr := transaction.New(
+		logger.New(fixtures.LogCategory),
+		time.Now(),
+		nil,
+		reservoir.Handles{
+			Transactions: pTx,
+			Blocks:       pBlocks,
+		},
+		br,
+		func() bool { return true },
+		false,
+	)
+
+	blockNumber := uint64(100)
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, blockNumber)
+
+	packedBlock, header, txIds := testBlock(t, blockNumber, 3)
+	packedHeader, _ := blockrecord.PackedHeaderOf(packedBlock)
+	data := packedBlock[len(packedHeader):]
+
+	pTx.EXPECT().GetNB(txIds[2][:]).Return(blockNumber, []byte{1}).Times(1)
+	pBlocks.EXPECT().Get(key).Return(packedBlock).Times(1)
+	br.EXPECT().ExtractHeader(packedBlock, uint64(0), false).Return(header, packedHeader.Digest(), data, nil).Times(1)
+
+	arg := transaction.InclusionProofArguments{TxId: txIds[2]}
+
+	var reply transaction.InclusionProofReply
+	err := tr.InclusionProof(&arg, &reply)
+	assert.Nil(t, err, "wrong InclusionProof")
+	assert.Equal(t, blockNumber, reply.BlockNumber, "wrong block number")
+	assert.Equal(t, packedHeader.Digest(), reply.Digest, "wrong digest")
+	assert.Equal(t, uint64(2), reply.Index, "wrong index")
+	assert.Equal(t, 2, len(reply.Branch), "wrong branch length")
+	assert.Nil(t, reply.Verify(), "proof not verified")
+
+	reply.Index = 1
+	assert.Equal(t, fault.MerkleRootDoesNotMatch, reply.Verify(), "wrong index verified")
+
+	// the duplicated last transaction has the same branch
+	reply.Index = 3
+	assert.Equal(t, fault.MerkleRootDoesNotMatch, reply.Verify(), "index past the last transaction verified")
+
+	reply.Index = 2
+	reply.BlockNumber = 99
+	assert.Equal(t, fault.BlockNumberDoesNotMatch, reply.Verify(), "wrong block number verified")
+}
+
+func TestTransactionInclusionProofWhenNotConfirmed(t *testing.T) {
+	fixtures.SetupTestLogger()
+	defer fixtures.TeardownTestLogger()
+
+	ctl := gomock.NewController(t)
+	defer ctl.Finish()
+
+	pTx := mocks.NewMockHandle(ctl)
+	pBlocks := mocks.NewMockHandle(ctl)
+
+	tr := transaction.New(
+		logger.New(fixtures.LogCategory),
+		time.Now(),
+		nil,
+		reservoir.Handles{
+			Transactions: pTx,
+			Blocks:       pBlocks,
+		},
+		nil,
+		func() bool { return true },
+		false,
+	)
+
+	arg := transaction.InclusionProofArguments{TxId: merkle.Digest{1, 2, 3, 4}}
+
+	pTx.EXPECT().GetNB(arg.TxId[:]).Return(uint64(0), nil).Times(1)
+
+	var reply transaction.InclusionProofReply
+	err := tr.InclusionProof(&arg, &reply)
+	assert.Equal(t, fault.TransactionIsNotConfirmed, err, "wrong error")
+}
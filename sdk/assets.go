@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/assets"
+)
+
+// GetAssets - fetch assets by fingerprint or identifier
+func (c *Client) GetAssets(ctx context.Context, arguments *assets.GetArguments) (*assets.GetReply, error) {
+	var reply assets.GetReply
+	err := c.Call(ctx, "Assets.Get", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
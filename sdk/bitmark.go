@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/bitmark"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// Transfer - submit an unratified or countersigned bitmark transfer
+func (c *Client) Transfer(ctx context.Context, transfer transactionrecord.BitmarkTransfer) (*bitmark.TransferReply, error) {
+	var reply bitmark.TransferReply
+	err := c.Call(ctx, "Bitmark.Transfer", transfer, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Replace - replace a pending transfer
+func (c *Client) Replace(ctx context.Context, arguments *bitmark.ReplaceArguments) (*bitmark.TransferReply, error) {
+	var reply bitmark.TransferReply
+	err := c.Call(ctx, "Bitmark.Replace", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Provenance - the chain of transactions leading to a transaction
+func (c *Client) Provenance(ctx context.Context, arguments *bitmark.ProvenanceArguments) (*bitmark.ProvenanceReply, error) {
+	var reply bitmark.ProvenanceReply
+	err := c.Call(ctx, "Bitmark.Provenance", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// FullProvenance - the complete history of a bitmark
+func (c *Client) FullProvenance(ctx context.Context, arguments *bitmark.FullProvenanceArguments) (*bitmark.FullProvenanceReply, error) {
+	var reply bitmark.FullProvenanceReply
+	err := c.Call(ctx, "Bitmark.FullProvenance", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/bitmarks"
+)
+
+// Issue - register assets and issue bitmarks
+func (c *Client) Issue(ctx context.Context, arguments *bitmarks.CreateArguments) (*bitmarks.CreateReply, error) {
+	var reply bitmarks.CreateReply
+	err := c.Call(ctx, "Bitmarks.Create", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// IssueProof - submit the proof of work nonce for free issues
+func (c *Client) IssueProof(ctx context.Context, arguments *bitmarks.ProofArguments) (*bitmarks.ProofReply, error) {
+	var reply bitmarks.ProofReply
+	err := c.Call(ctx, "Bitmarks.Proof", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
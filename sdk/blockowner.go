@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/blockowner"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// BlockOwnerTxID - the transaction id of a block's ownership record
+func (c *Client) BlockOwnerTxID(ctx context.Context, arguments *blockowner.TxIDForBlockArguments) (*blockowner.TxIDForBlockReply, error) {
+	var reply blockowner.TxIDForBlockReply
+	err := c.Call(ctx, "BlockOwner.TxIDForBlock", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// BlockOwnerTransfer - submit a block ownership transfer
+func (c *Client) BlockOwnerTransfer(ctx context.Context, transfer *transactionrecord.BlockOwnerTransfer) (*blockowner.TransferReply, error) {
+	var reply blockowner.TransferReply
+	err := c.Call(ctx, "BlockOwner.Transfer", transfer, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
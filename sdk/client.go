@@ -0,0 +1,223 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"net/rpc"
+	"sync"
+	"time"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+)
+
+// default settings
+const (
+	defaultConnections = 2                // idle connections kept for each node
+	defaultAttempts    = 3                // nodes tried for each call
+	defaultTimeout     = 10 * time.Second // dial, handshake and authorisation
+	failedNodeDelay    = 30 * time.Second // avoid a failed node for this long
+)
+
+// Configuration - settings for a client
+type Configuration struct {
+	Nodes        []string         // host:port of bitmarkd RPC listeners
+	Fingerprints []string         // SHA3-256 hex of accepted server certificates
+	RootCAs      *x509.CertPool   // roots verifying servers without pins, nil for the system roots
+	Insecure     bool             // accept any server certificate when there are no pins
+	Connections  int              // idle connections kept for each node
+	Attempts     int              // nodes tried for each call
+	Timeout      time.Duration    // dial, handshake and authorisation timeout
+	Token        string           // API key presented on each connection
+	Certificate  *tls.Certificate // client certificate, if the nodes require one
+}
+
+// Client - pooled connections to a set of bitmarkd nodes
+type Client struct {
+	sync.Mutex
+
+	endpoints   []*endpoint
+	next        int
+	pins        [][32]byte
+	roots       *x509.CertPool
+	insecure    bool
+	connections int
+	attempts    int
+	timeout     time.Duration
+	token       string
+	certificate *tls.Certificate
+	closed      bool
+}
+
+// New - create a client, no connection is made until the first call
+func New(configuration *Configuration) (*Client, error) {
+	if 0 == len(configuration.Nodes) {
+		return nil, fault.ConnectIsRequired
+	}
+
+	pins := make([][32]byte, 0, len(configuration.Fingerprints))
+	for _, f := range configuration.Fingerprints {
+		pin, err := parseFingerprint(f)
+		if err != nil {
+			return nil, err
+		}
+		pins = append(pins, pin)
+	}
+
+	c := &Client{
+		pins:        pins,
+		roots:       configuration.RootCAs,
+		insecure:    configuration.Insecure,
+		connections: configuration.Connections,
+		attempts:    configuration.Attempts,
+		timeout:     configuration.Timeout,
+		token:       configuration.Token,
+		certificate: configuration.Certificate,
+	}
+	if c.connections <= 0 {
+		c.connections = defaultConnections
+	}
+	if c.attempts <= 0 {
+		c.attempts = defaultAttempts
+	}
+	if c.timeout <= 0 {
+		c.timeout = defaultTimeout
+	}
+
+	for _, address := range configuration.Nodes {
+		c.add(address, pins)
+	}
+
+	return c, nil
+}
+
+// Close - close all idle connections, connections in use are closed
+// as their calls complete
+func (c *Client) Close() error {
+	c.Lock()
+	defer c.Unlock()
+
+	c.closed = true
+	for _, e := range c.endpoints {
+		e.drain()
+	}
+	return nil
+}
+
+// Call - invoke an RPC method by name
+//
+// the call is retried on the next node if the connection fails
+// before the request is sent or the node is synchronising or rate
+// limiting; a connection failing after the request is sent is only
+// retried for methods that change nothing, as the node may already
+// have acted on it.  Any other error from the server is returned
+// directly
+func (c *Client) Call(ctx context.Context, serviceMethod string, arguments interface{}, reply interface{}) error {
+	var err error
+	for i := 0; i < c.attempts; i += 1 {
+		e, pickErr := c.pick()
+		if pickErr != nil {
+			return pickErr
+		}
+
+		var sent bool
+		sent, err = e.call(ctx, c, serviceMethod, arguments, reply)
+		if nil == err {
+			return nil
+		}
+		if nil != ctx.Err() {
+			return ctx.Err()
+		}
+		if !retryable(serviceMethod, sent, err) {
+			return err
+		}
+		e.setFailed()
+	}
+	return err
+}
+
+// add a node unless its address is already present
+func (c *Client) add(address string, pins [][32]byte) bool {
+	for _, e := range c.endpoints {
+		if address == e.address {
+			return false
+		}
+	}
+	c.endpoints = append(c.endpoints, newEndpoint(address, pins, c.connections))
+	return true
+}
+
+// next node in turn, passing over recently failed nodes unless
+// every node has failed
+func (c *Client) pick() (*endpoint, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.closed {
+		return nil, fault.NotConnected
+	}
+
+	n := len(c.endpoints)
+	for i := 0; i < n; i += 1 {
+		e := c.endpoints[(c.next+i)%n]
+		if !e.hasFailed() {
+			c.next = (c.next + i + 1) % n
+			return e, nil
+		}
+	}
+	e := c.endpoints[c.next%n]
+	c.next = (c.next + 1) % n
+	return e, nil
+}
+
+func (c *Client) isClosed() bool {
+	c.Lock()
+	defer c.Unlock()
+	return c.closed
+}
+
+// methods that change nothing on the node, so can be repeated
+var readOnly = map[string]bool{
+	"Assets.Get":                 true,
+	"Bitmark.FullProvenance":     true,
+	"Bitmark.Provenance":         true,
+	"BlockOwner.TxIDForBlock":    true,
+	"Node.BlockDecode":           true,
+	"Node.BlockDump":             true,
+	"Node.BlockDumpRange":        true,
+	"Node.BlockFilter":           true,
+	"Node.Forks":                 true,
+	"Node.Info":                  true,
+	"Node.List":                  true,
+	"Owner.Bitmarks":             true,
+	"Share.Balance":              true,
+	"Transaction.InclusionProof": true,
+	"Transaction.Status":         true,
+}
+
+// true if a failed call can be tried on another node: the request was
+// not sent, the node refused it before acting on it, or the method
+// changes nothing
+func retryable(serviceMethod string, sent bool, err error) bool {
+	if e, ok := err.(rpc.ServerError); ok {
+		return fault.NotAvailableDuringSynchronise.Error() == string(e) ||
+			fault.RateLimiting.Error() == string(e)
+	}
+	return !sent || readOnly[serviceMethod]
+}
+
+func parseFingerprint(s string) ([32]byte, error) {
+	var fingerprint [32]byte
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) != len(fingerprint) {
+		return fingerprint, fault.InvalidFingerprint
+	}
+	copy(fingerprint[:], b)
+	return fingerprint, nil
+}
@@ -0,0 +1,355 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/fixtures"
+	"github.com/bitmark-inc/bitmarkd/rpc/node"
+	"github.com/bitmark-inc/bitmarkd/sdk"
+)
+
+const fixtureDir = "../rpc/fixtures"
+
+type ListReply struct {
+	Nodes     []sdk.Node `json:"nodes"`
+	NextStart uint64     `json:"nextStart,string"`
+}
+
+// fake Node service
+type Node struct {
+	chain string
+	err   error
+	block chan struct{}
+	nodes []sdk.Node
+}
+
+func (n *Node) Info(_ *node.InfoArguments, reply *node.InfoReply) error {
+	if nil != n.block {
+		<-n.block
+	}
+	if nil != n.err {
+		return n.err
+	}
+	reply.Chain = n.chain
+	return nil
+}
+
+func (n *Node) List(arguments *node.Arguments, reply *ListReply) error {
+	if arguments.Start < uint64(len(n.nodes)) {
+		reply.Nodes = n.nodes[arguments.Start:]
+		reply.NextStart = uint64(len(n.nodes))
+	}
+	return nil
+}
+
+// start a TLS JSON RPC server, returns its address and fingerprint
+func startServer(t *testing.T, n *Node) (string, string) {
+	keyPair, err := tls.X509KeyPair([]byte(fixtures.Certificate(fixtureDir)), []byte(fixtures.Key(fixtureDir)))
+	assert.Nil(t, err, "key pair error")
+
+	return startServerWith(t, n, keyPair)
+}
+
+func startServerWith(t *testing.T, n *Node, keyPair tls.Certificate) (string, string) {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+	})
+	assert.Nil(t, err, "listen error")
+	t.Cleanup(func() { _ = listener.Close() })
+
+	server := rpc.NewServer()
+	_ = server.RegisterName("Node", n)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go server.ServeCodec(jsonrpc.NewServerCodec(conn))
+		}
+	}()
+
+	fingerprint := sha3.Sum256(keyPair.Certificate[0])
+	return listener.Addr().String(), hex.EncodeToString(fingerprint[:])
+}
+
+// a TLS server that closes each connection once a request arrives
+func startDroppingServer(t *testing.T) string {
+	keyPair, err := tls.X509KeyPair([]byte(fixtures.Certificate(fixtureDir)), []byte(fixtures.Key(fixtureDir)))
+	assert.Nil(t, err, "key pair error")
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{keyPair},
+	})
+	assert.Nil(t, err, "listen error")
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_, _ = conn.Read(make([]byte, 1))
+			_ = conn.Close()
+		}
+	}()
+	return listener.Addr().String()
+}
+
+// a self-signed certificate for the loopback address, and a pool
+// holding it
+func newLoopbackCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err, "key error")
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+		IsCA:         true,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, publicKey, privateKey)
+	assert.Nil(t, err, "certificate error")
+
+	certificate, err := x509.ParseCertificate(der)
+	assert.Nil(t, err, "parse error")
+	roots := x509.NewCertPool()
+	roots.AddCert(certificate)
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  privateKey,
+	}, roots
+}
+
+// an address with nothing listening
+func closedAddress(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err, "listen error")
+	address := listener.Addr().String()
+	_ = listener.Close()
+	return address
+}
+
+func TestNewWhenInvalid(t *testing.T) {
+	_, err := sdk.New(&sdk.Configuration{})
+	assert.Equal(t, fault.ConnectIsRequired, err, "wrong missing nodes error")
+
+	_, err = sdk.New(&sdk.Configuration{
+		Nodes:        []string{"127.0.0.1:2130"},
+		Fingerprints: []string{"0a0b"},
+	})
+	assert.Equal(t, fault.InvalidFingerprint, err, "wrong fingerprint error")
+}
+
+func TestCall(t *testing.T) {
+	address, fingerprint := startServer(t, &Node{chain: "testing"})
+
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes:        []string{address},
+		Fingerprints: []string{fingerprint},
+	})
+	assert.Nil(t, err, "wrong error")
+	defer client.Close()
+
+	for i := 0; i < 3; i += 1 {
+		info, err := client.Info(context.Background())
+		assert.Nil(t, err, "wrong info error")
+		assert.Equal(t, "testing", info.Chain, "wrong chain")
+	}
+
+	_ = client.Close()
+	_, err = client.Info(context.Background())
+	assert.Equal(t, fault.NotConnected, err, "wrong closed error")
+}
+
+func TestCallWhenNotPinned(t *testing.T) {
+	address, _ := startServer(t, &Node{chain: "testing"})
+
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes:        []string{address},
+		Fingerprints: []string{hex.EncodeToString(make([]byte, 32))},
+	})
+	assert.Nil(t, err, "wrong error")
+	defer client.Close()
+
+	_, err = client.Info(context.Background())
+	assert.True(t, errors.Is(err, fault.ServerCertificateNotPinned), "wrong error: %v", err)
+}
+
+func TestCallWhenNotVerified(t *testing.T) {
+	address, _ := startServer(t, &Node{chain: "testing"})
+
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes: []string{address},
+	})
+	assert.Nil(t, err, "wrong error")
+	defer client.Close()
+
+	_, err = client.Info(context.Background())
+	var verification *tls.CertificateVerificationError
+	assert.True(t, errors.As(err, &verification), "wrong error: %v", err)
+}
+
+func TestCallWithRoots(t *testing.T) {
+	keyPair, roots := newLoopbackCertificate(t)
+	address, _ := startServerWith(t, &Node{chain: "testing"}, keyPair)
+
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes:   []string{address},
+		RootCAs: roots,
+	})
+	assert.Nil(t, err, "wrong error")
+	defer client.Close()
+
+	info, err := client.Info(context.Background())
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, "testing", info.Chain, "wrong chain")
+}
+
+func TestCallRetriesNextNode(t *testing.T) {
+	syncing, _ := startServer(t, &Node{err: fault.NotAvailableDuringSynchronise})
+	address, _ := startServer(t, &Node{chain: "testing"})
+
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes:    []string{closedAddress(t), syncing, address},
+		Insecure: true,
+	})
+	assert.Nil(t, err, "wrong error")
+	defer client.Close()
+
+	info, err := client.Info(context.Background())
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, "testing", info.Chain, "wrong chain")
+
+	// failed nodes are passed over on later calls
+	info, err = client.Info(context.Background())
+	assert.Nil(t, err, "wrong error")
+	assert.Equal(t, "testing", info.Chain, "wrong chain")
+}
+
+func TestCallDoesNotRetryServerError(t *testing.T) {
+	failing, _ := startServer(t, &Node{err: fault.InvalidCount})
+	address, _ := startServer(t, &Node{chain: "testing"})
+
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes:    []string{failing, address},
+		Insecure: true,
+	})
+	assert.Nil(t, err, "wrong error")
+	defer client.Close()
+
+	_, err = client.Info(context.Background())
+	assert.Equal(t, rpc.ServerError(fault.InvalidCount.Error()), err, "wrong error")
+}
+
+func TestCallAfterSendRetriesOnlyReads(t *testing.T) {
+	dropping := startDroppingServer(t)
+	address, _ := startServer(t, &Node{chain: "testing"})
+
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes:    []string{dropping, address},
+		Attempts: 2,
+		Insecure: true,
+	})
+	assert.Nil(t, err, "wrong error")
+	defer client.Close()
+
+	// the write may have been applied, so it is not sent again
+	var reply struct{}
+	err = client.Call(context.Background(), "Bitmarks.Create", struct{}{}, &reply)
+	assert.NotNil(t, err, "write did not fail")
+	_, isServerError := err.(rpc.ServerError)
+	assert.False(t, isServerError, "write sent to the next node: %v", err)
+
+	info, err := client.Info(context.Background())
+	assert.Nil(t, err, "read not retried")
+	assert.Equal(t, "testing", info.Chain, "wrong chain")
+}
+
+func TestCallWhenCancelled(t *testing.T) {
+	block := make(chan struct{})
+	defer close(block)
+	address, _ := startServer(t, &Node{chain: "testing", block: block})
+
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes:    []string{address},
+		Insecure: true,
+	})
+	assert.Nil(t, err, "wrong error")
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = client.Info(ctx)
+	assert.Equal(t, context.DeadlineExceeded, err, "wrong error")
+}
+
+func TestDiscover(t *testing.T) {
+	address, fingerprint := startServer(t, &Node{chain: "announced"})
+
+	seed := &Node{chain: "seed"}
+	seedAddress, _ := startServer(t, seed)
+	seed.nodes = []sdk.Node{
+		{
+			Fingerprint: fingerprint,
+			Connections: []string{address},
+		},
+		{
+			Fingerprint: hex.EncodeToString(make([]byte, 32)),
+			Connections: []string{seedAddress},
+		},
+	}
+
+	client, err := sdk.New(&sdk.Configuration{
+		Nodes:    []string{seedAddress},
+		Insecure: true,
+	})
+	assert.Nil(t, err, "wrong error")
+	defer client.Close()
+
+	nodes, err := client.Nodes(context.Background())
+	assert.Nil(t, err, "wrong nodes error")
+	assert.Equal(t, seed.nodes, nodes, "wrong nodes")
+
+	added, err := client.Discover(context.Background())
+	assert.Nil(t, err, "wrong discover error")
+	assert.Equal(t, 1, added, "wrong added count")
+
+	chains := make(map[string]bool)
+	for i := 0; i < 2; i += 1 {
+		info, err := client.Info(context.Background())
+		assert.Nil(t, err, "wrong info error")
+		chains[info.Chain] = true
+	}
+	assert.Equal(t, map[string]bool{"seed": true, "announced": true}, chains, "wrong nodes called")
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package sdk - typed Go client for the bitmarkd JSON RPC services
+//
+// A Client keeps a pool of TLS connections to each configured
+// node. Server certificates can be pinned by their SHA3-256
+// fingerprint, the same value bitmarkd logs at start and announces
+// to its peers. Without pins a certificate must verify against the
+// system or configured roots; Insecure accepts any certificate, and
+// the API token is then sent to whichever server answers. A call is
+// retried on the next node if the connection fails before the request
+// is sent or the node is synchronising or rate limiting; a connection
+// lost after sending is retried only for read-only methods. Discover
+// adds the other nodes announced on the network.
+//
+//	client, err := sdk.New(&sdk.Configuration{
+//		Nodes:        []string{"node.example.com:2130"},
+//		Fingerprints: []string{"<hex fingerprint>"},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	defer client.Close()
+//
+//	info, err := client.Info(ctx)
+package sdk
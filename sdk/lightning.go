@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/lightning"
+)
+
+// Invoice - a lightning invoice for a pending payment
+func (c *Client) Invoice(ctx context.Context, arguments *lightning.InvoiceArguments) (*lightning.InvoiceReply, error) {
+	var reply lightning.InvoiceReply
+	err := c.Call(ctx, "Lightning.Invoice", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/node"
+)
+
+// limit for count
+const maximumNodeList = 100
+
+// Node - an RPC node announced on the network
+type Node struct {
+	Fingerprint string   `json:"fingerprint"` // SHA3-256 hex of its certificate
+	Connections []string `json:"connections"` // host:port
+}
+
+// reply from Node.List in a form that can be decoded
+type nodeListReply struct {
+	Nodes     []Node `json:"nodes"`
+	NextStart uint64 `json:"nextStart,string"`
+}
+
+// Nodes - every RPC node announced on the network
+func (c *Client) Nodes(ctx context.Context) ([]Node, error) {
+	nodes := make([]Node, 0)
+
+	start := uint64(0)
+	for {
+		arguments := node.Arguments{
+			Start: start,
+			Count: maximumNodeList,
+		}
+		var reply nodeListReply
+		err := c.Call(ctx, "Node.List", &arguments, &reply)
+		if err != nil {
+			return nil, err
+		}
+		if 0 == len(reply.Nodes) {
+			return nodes, nil
+		}
+		nodes = append(nodes, reply.Nodes...)
+		start = reply.NextStart
+	}
+}
+
+// Discover - add the announced nodes for calls to use
+//
+// each new node is pinned to the fingerprint it announced, returns
+// the number of nodes added
+func (c *Client) Discover(ctx context.Context) (int, error) {
+	nodes, err := c.Nodes(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	added := 0
+	for _, n := range nodes {
+		pin, err := parseFingerprint(n.Fingerprint)
+		if err != nil {
+			continue
+		}
+		for _, address := range n.Connections {
+			if c.add(address, [][32]byte{pin}) {
+				added += 1
+			}
+		}
+	}
+	return added, nil
+}
+
+// Info - node status and counters
+func (c *Client) Info(ctx context.Context) (*node.InfoReply, error) {
+	var reply node.InfoReply
+	err := c.Call(ctx, "Node.Info", &node.InfoArguments{}, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// Forks - competing chains and recent reorganisations
+func (c *Client) Forks(ctx context.Context) (*node.ForksReply, error) {
+	var reply node.ForksReply
+	err := c.Call(ctx, "Node.Forks", &node.ForksArguments{}, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// BlockDump - a single block
+func (c *Client) BlockDump(ctx context.Context, arguments *node.BlockDumpArguments) (*node.BlockDumpReply, error) {
+	var reply node.BlockDumpReply
+	err := c.Call(ctx, "Node.BlockDump", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// BlockDecode - decode a packed block
+func (c *Client) BlockDecode(ctx context.Context, arguments *node.BlockDecodeArguments) (*node.BlockDecodeReply, error) {
+	var reply node.BlockDecodeReply
+	err := c.Call(ctx, "Node.BlockDecode", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// BlockDumpRange - a run of blocks from a height
+func (c *Client) BlockDumpRange(ctx context.Context, arguments *node.BlockDumpRangeArguments) (*node.BlockDumpRangeReply, error) {
+	var reply node.BlockDumpRangeReply
+	err := c.Call(ctx, "Node.BlockDumpRange", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// BlockFilter - the compact filter of a block
+func (c *Client) BlockFilter(ctx context.Context, arguments *node.BlockFilterArguments) (*node.BlockFilterReply, error) {
+	var reply node.BlockFilterReply
+	err := c.Call(ctx, "Node.BlockFilter", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
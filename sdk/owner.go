@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/owner"
+)
+
+// Owned - a page of the bitmarks owned by an account
+func (c *Client) Owned(ctx context.Context, arguments *owner.BitmarksArguments) (*owner.BitmarksReply, error) {
+	var reply owner.BitmarksReply
+	err := c.Call(ctx, "Owner.Bitmarks", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
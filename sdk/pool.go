@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/bitmark-inc/bitmarkd/fault"
+	"github.com/bitmark-inc/bitmarkd/rpc/apikey"
+)
+
+// a node and its idle connections
+type endpoint struct {
+	sync.Mutex
+
+	address string
+	pins    [][32]byte
+	idle    chan *connection
+	failed  time.Time
+}
+
+// an open connection to a node
+type connection struct {
+	conn   net.Conn
+	client *rpc.Client
+}
+
+func newEndpoint(address string, pins [][32]byte, connections int) *endpoint {
+	return &endpoint{
+		address: address,
+		pins:    pins,
+		idle:    make(chan *connection, connections),
+	}
+}
+
+// run one call on an idle or new connection, returns true if the
+// request was sent
+//
+// on cancellation the connection is closed, which ends the call
+func (e *endpoint) call(ctx context.Context, c *Client, serviceMethod string, arguments interface{}, reply interface{}) (bool, error) {
+	conn, err := e.get(ctx, c)
+	if err != nil {
+		return false, err
+	}
+
+	call := conn.client.Go(serviceMethod, arguments, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-ctx.Done():
+		conn.close()
+		return true, ctx.Err()
+	case <-call.Done:
+	}
+
+	if nil != call.Error {
+		if _, ok := call.Error.(rpc.ServerError); !ok {
+			conn.close()
+			return true, call.Error
+		}
+	}
+
+	if c.isClosed() {
+		conn.close()
+	} else {
+		e.put(conn)
+	}
+	return true, call.Error
+}
+
+// take an idle connection or dial a new one
+func (e *endpoint) get(ctx context.Context, c *Client) (*connection, error) {
+	select {
+	case conn := <-e.idle:
+		return conn, nil
+	default:
+	}
+
+	dialer := &tls.Dialer{
+		NetDialer: &net.Dialer{
+			Timeout: c.timeout,
+		},
+		Config: e.tlsConfiguration(c),
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", e.address)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &connection{
+		conn:   conn,
+		client: jsonrpc.NewClient(conn),
+	}
+
+	if "" != c.token {
+		_ = conn.SetDeadline(time.Now().Add(c.timeout))
+		var reply apikey.AuthReply
+		err := r.client.Call(apikey.AuthMethod, &apikey.AuthArguments{Token: c.token}, &reply)
+		if err != nil {
+			r.close()
+			return nil, err
+		}
+		_ = conn.SetDeadline(time.Time{})
+	}
+
+	return r, nil
+}
+
+// return a connection to the idle pool, closing it if the pool is full
+func (e *endpoint) put(conn *connection) {
+	select {
+	case e.idle <- conn:
+	default:
+		conn.close()
+	}
+}
+
+// close all idle connections
+func (e *endpoint) drain() {
+	for {
+		select {
+		case conn := <-e.idle:
+			conn.close()
+		default:
+			return
+		}
+	}
+}
+
+func (e *endpoint) setFailed() {
+	e.Lock()
+	e.failed = time.Now()
+	e.Unlock()
+	e.drain()
+}
+
+func (e *endpoint) hasFailed() bool {
+	e.Lock()
+	defer e.Unlock()
+	return time.Since(e.failed) < failedNodeDelay
+}
+
+// TLS settings for this node, the server certificate is accepted if
+// its fingerprint is pinned, otherwise it must verify against the
+// roots unless the client is explicitly insecure
+func (e *endpoint) tlsConfiguration(c *Client) *tls.Config {
+	tlsConfiguration := &tls.Config{
+		RootCAs: c.roots,
+	}
+	if 0 != len(e.pins) {
+		// bitmarkd certificates are self-signed, the pin replaces
+		// the chain verification
+		tlsConfiguration.InsecureSkipVerify = true
+		tlsConfiguration.VerifyPeerCertificate = verifyPinned(e.pins)
+	} else if c.insecure {
+		tlsConfiguration.InsecureSkipVerify = true
+	}
+	if nil != c.certificate {
+		tlsConfiguration.Certificates = []tls.Certificate{*c.certificate}
+	}
+	return tlsConfiguration
+}
+
+func verifyPinned(pins [][32]byte) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if 0 == len(rawCerts) {
+			return fault.ServerCertificateNotPinned
+		}
+		fingerprint := sha3.Sum256(rawCerts[0])
+		for _, pin := range pins {
+			if pin == fingerprint {
+				return nil
+			}
+		}
+		return fault.ServerCertificateNotPinned
+	}
+}
+
+func (conn *connection) close() {
+	_ = conn.client.Close()
+}
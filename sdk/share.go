@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/share"
+	"github.com/bitmark-inc/bitmarkd/transactionrecord"
+)
+
+// CreateShare - convert a bitmark into shares
+func (c *Client) CreateShare(ctx context.Context, create *transactionrecord.BitmarkShare) (*share.CreateReply, error) {
+	var reply share.CreateReply
+	err := c.Call(ctx, "Share.Create", create, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// ShareBalance - the share balances of an account
+func (c *Client) ShareBalance(ctx context.Context, arguments *share.BalanceArguments) (*share.BalanceReply, error) {
+	var reply share.BalanceReply
+	err := c.Call(ctx, "Share.Balance", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// GrantShares - submit a countersigned share grant
+func (c *Client) GrantShares(ctx context.Context, grant *transactionrecord.ShareGrant) (*share.GrantReply, error) {
+	var reply share.GrantReply
+	err := c.Call(ctx, "Share.Grant", grant, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// SwapShares - submit a countersigned share swap
+func (c *Client) SwapShares(ctx context.Context, swap *transactionrecord.ShareSwap) (*share.SwapReply, error) {
+	var reply share.SwapReply
+	err := c.Call(ctx, "Share.Swap", swap, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: ISC
+// Copyright (c) 2014-2026 Bitmark Inc.
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sdk
+
+import (
+	"context"
+
+	"github.com/bitmark-inc/bitmarkd/rpc/transaction"
+)
+
+// Status - the status of a transaction
+func (c *Client) Status(ctx context.Context, arguments *transaction.Arguments) (*transaction.StatusReply, error) {
+	var reply transaction.StatusReply
+	err := c.Call(ctx, "Transaction.Status", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
+
+// InclusionProof - the merkle path from a transaction to its block
+func (c *Client) InclusionProof(ctx context.Context, arguments *transaction.InclusionProofArguments) (*transaction.InclusionProofReply, error) {
+	var reply transaction.InclusionProofReply
+	err := c.Call(ctx, "Transaction.InclusionProof", arguments, &reply)
+	if err != nil {
+		return nil, err
+	}
+	return &reply, nil
+}
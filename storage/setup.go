@@ -25,6 +25,8 @@ type pools struct {
 	BlockHeaderHash   Handle `prefix:"2" pool:"PoolHandle"`
 	BlockOwnerPayment Handle `prefix:"H" pool:"PoolHandle"`
 	BlockOwnerTxIndex Handle `prefix:"I" pool:"PoolHandle"`
+	BlockFilters      Handle `prefix:"C" pool:"PoolHandle"`
+	Headers           Handle `prefix:"E" pool:"PoolHandle"`
 	Assets            Handle `prefix:"A" pool:"PoolNB"`
 	Transactions      Handle `prefix:"T" pool:"PoolNB"`
 	OwnerNextCount    Handle `prefix:"N" pool:"PoolHandle"`
@@ -28,3 +28,18 @@ func StartAuthentication() error {
 
 	return err
 }
+
+// RestrictCurveKeys - only allow clients with the given public keys to
+// connect to the sockets of a ZAP domain, replacing the default of
+// allowing any client
+func RestrictCurveKeys(zapDomain string, publicKeys [][]byte) {
+	keys := make([]string, len(publicKeys))
+	for i, publicKey := range publicKeys {
+		keys[i] = zmq.Z85encode(string(publicKey))
+	}
+
+	zmq.AuthCurveRemoveAll(zapDomain)
+	if len(keys) > 0 {
+		zmq.AuthCurveAdd(zapDomain, keys...)
+	}
+}
@@ -288,7 +288,7 @@ func (client *clientData) openSocket() error {
 	// }
 
 	// see socket.go for constants
-	err = socket.SetMaxmsgsize(maximumPacketSize)
+	err = socket.SetMaxmsgsize(MaximumPacketSize)
 	if err != nil {
 		goto failure
 	}
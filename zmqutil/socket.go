@@ -13,10 +13,10 @@ import (
 	zmq "github.com/pebbe/zmq4"
 )
 
-// point at which to disconnect large message senders
+// MaximumPacketSize - point at which to disconnect large message senders
 // current estimate of a block maximum is 2 MB
 const (
-	maximumPacketSize = 5000000 // 5 MB
+	MaximumPacketSize = 5000000 // 5 MB
 )
 
 // ***** FIX THIS: enabling this causes complete failure
@@ -193,7 +193,7 @@ func NewServerSocket(socketType zmq.Type, zapDomain string, privateKey []byte, p
 	// 	goto failure
 	// }
 
-	err = socket.SetMaxmsgsize(maximumPacketSize)
+	err = socket.SetMaxmsgsize(MaximumPacketSize)
 	if err != nil {
 		goto failure
 	}